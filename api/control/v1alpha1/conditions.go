@@ -26,6 +26,10 @@ type ConditionType string
 const (
 	TypeReady  ConditionType = "Ready"
 	TypeSynced ConditionType = "Synced"
+
+	TypeSpreadConstraintsSatisfied ConditionType = "SpreadConstraintsSatisfied"
+	TypeRolledOut                  ConditionType = "RolledOut"
+	TypeConflictFree               ConditionType = "ConflictFree"
 )
 
 type ConditionReason string
@@ -43,6 +47,22 @@ const (
 	ReasonReconcilePaused  ConditionReason = "ReconcilePaused"
 )
 
+const (
+	ReasonSpreadConstraintsMet   ConditionReason = "SpreadConstraintsMet"
+	ReasonSpreadConstraintsUnmet ConditionReason = "SpreadConstraintsUnmet"
+)
+
+const (
+	ReasonRolloutProgressing ConditionReason = "RolloutProgressing"
+	ReasonRolloutComplete    ConditionReason = "RolloutComplete"
+	ReasonRolloutHalted      ConditionReason = "RolloutHalted"
+)
+
+const (
+	ReasonNoConflicts      ConditionReason = "NoConflicts"
+	ReasonOverlapConflicts ConditionReason = "OverlapConflicts"
+)
+
 // BindingPolicyCondition describes the state of a bindingpolicy at a certain point.
 type BindingPolicyCondition struct {
 	Type               ConditionType          `json:"type"`
@@ -117,6 +137,18 @@ func AreConditionSlicesSame(c1, c2 []BindingPolicyCondition) bool {
 	return true
 }
 
+// RemoveCondition removes the conditions of the given type from
+// the given slice of conditions. Returns the updated slice of conditions.
+func RemoveCondition(conditions []BindingPolicyCondition, conditionType ConditionType) []BindingPolicyCondition {
+	kept := conditions[:0]
+	for _, condition := range conditions {
+		if condition.Type != conditionType {
+			kept = append(kept, condition)
+		}
+	}
+	return kept
+}
+
 func EnsureCondition(cp *BindingPolicy, newCondition BindingPolicyCondition) {
 	if cp.Status.Conditions == nil {
 		cp.Status.Conditions = []BindingPolicyCondition{}
@@ -195,3 +227,106 @@ func ConditionReconcileError(err error) BindingPolicyCondition {
 		Message:            err.Error(),
 	}
 }
+
+// ReconcilePaused returns a condition indicating that the bindingpolicy is
+// suspended, so its Binding is not being updated.
+func ConditionReconcilePaused() BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonReconcilePaused,
+		Message:            "spec.suspend is true",
+	}
+}
+
+// SpreadConstraintsMet returns a condition indicating that the destinations
+// meet all the spread constraints of the bindingpolicy.
+func ConditionSpreadConstraintsMet() BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeSpreadConstraintsSatisfied,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonSpreadConstraintsMet,
+	}
+}
+
+// SpreadConstraintsUnmet returns a condition indicating that the destinations
+// do not meet some spread constraints of the bindingpolicy, for the given reason.
+func ConditionSpreadConstraintsUnmet(message string) BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeSpreadConstraintsSatisfied,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonSpreadConstraintsUnmet,
+		Message:            message,
+	}
+}
+
+// RolloutProgressing returns a condition indicating that a rollout is under way,
+// as described by the given message.
+func ConditionRolloutProgressing(message string) BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeRolledOut,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonRolloutProgressing,
+		Message:            message,
+	}
+}
+
+// RolloutComplete returns a condition indicating that all the destinations
+// have been updated.
+func ConditionRolloutComplete() BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeRolledOut,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonRolloutComplete,
+	}
+}
+
+// RolloutHalted returns a condition indicating that a rollout has stopped
+// because its health check was not passed in time, for the given reason.
+func ConditionRolloutHalted(message string) BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeRolledOut,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonRolloutHalted,
+		Message:            message,
+	}
+}
+
+// ConditionNoConflicts returns a condition indicating that the bindingpolicy does not
+// disagree with any other about an object that they both send to the same WEC.
+// When the controller manager is sharded, only the bindingpolicies of the same replica are compared.
+func ConditionNoConflicts() BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeConflictFree,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonNoConflicts,
+	}
+}
+
+// ConditionOverlapConflicts returns a condition indicating that the bindingpolicy
+// sends some objects to some WECs that other bindingpolicies also send there
+// with different settings, as described by the given message.
+func ConditionOverlapConflicts(message string) BindingPolicyCondition {
+	return BindingPolicyCondition{
+		Type:               TypeConflictFree,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     metav1.Now(),
+		Reason:             ReasonOverlapConflicts,
+		Message:            message,
+	}
+}
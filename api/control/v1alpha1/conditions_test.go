@@ -103,6 +103,32 @@ func TestAreConditionSlicesSame(t *testing.T) {
 	}
 }
 
+func TestRemoveCondition(t *testing.T) {
+	conditions := []BindingPolicyCondition{
+		generateCondition("ConditionTypeA", "ReasonA", "MessageA",
+			corev1.ConditionFalse, metav1.Now(), metav1.Now()),
+		generateCondition("ConditionTypeB", "ReasonB", "MessageB",
+			corev1.ConditionTrue, metav1.Now(), addTime(2)),
+	}
+
+	expectedConditions := []BindingPolicyCondition{
+		generateCondition("ConditionTypeB", "ReasonB", "MessageB",
+			corev1.ConditionTrue, metav1.Now(), addTime(2)),
+	}
+
+	actualConditions := RemoveCondition(conditions, "ConditionTypeA")
+
+	if !AreConditionSlicesSame(actualConditions, expectedConditions) {
+		t.Errorf("RemoveCondition failed: expected %+v, but got %+v", expectedConditions, actualConditions)
+	}
+
+	actualConditions = RemoveCondition(actualConditions, "ConditionTypeC")
+
+	if !AreConditionSlicesSame(actualConditions, expectedConditions) {
+		t.Errorf("RemoveCondition failed: expected %+v, but got %+v", expectedConditions, actualConditions)
+	}
+}
+
 func generateCondition(ctype ConditionType, reason ConditionReason, message string, status corev1.ConditionStatus, ltt, ltu metav1.Time) BindingPolicyCondition {
 	return BindingPolicyCondition{
 		Type:               ctype,
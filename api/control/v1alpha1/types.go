@@ -53,6 +53,17 @@ import (
 
 const TemplateExpansionAnnotationKey string = "control.kubestellar.io/expand-templates"
 
+// SyncWaveAnnotationKey is the key of an annotation of a workload object in a WDS
+// whose value is an integer (possibly negative) that orders the delivery of the
+// objects of a Binding to a WEC. Objects in a lower wave are delivered before
+// objects in a higher wave. An object without this annotation is in wave 0.
+// Within a wave, objects are ordered by kind: Namespaces and CustomResourceDefinitions
+// first, then configuration (such as ServiceAccounts, RBAC objects, ConfigMaps and Secrets),
+// then workloads, and then all other kinds (such as custom resources).
+// When the transport asks for staged application, each wave is delivered in
+// separate wrapped objects.
+const SyncWaveAnnotationKey string = "kubestellar.io/sync-wave"
+
 // PropertyConfigMapNamespace is the namespace in the ITS that holds ConfigMap objects that provide
 // WEC properties to be used in customization.
 const PropertyConfigMapNamespace = "customization-properties"
@@ -61,6 +72,7 @@ const PropertyConfigMapNamespace = "customization-properties"
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
@@ -100,10 +112,17 @@ type BindingPolicySpec struct {
 	// and modulates their downsync.
 	// An object is selected if it matches at least one member of this list.
 	// When multiple DownsyncPolicyClause match the same workload object:
-	// the `createOnly` bits are ORed together, and the StatusCollector reference
+	// the `createOnly` bits are ORed together, the object is orphaned if any of
+	// their `deletionPolicy` says so, and the StatusCollector reference
 	// sets are combined by union.
 	Downsync []DownsyncPolicyClause `json:"downsync,omitempty"`
 
+	// `downsyncExclusions` removes objects from the selection made by `downsync`.
+	// An object that matches at least one member of `downsync` is nonetheless
+	// not selected if it matches at least one member of this list.
+	// +optional
+	DownsyncExclusions []DownsyncObjectTest `json:"downsyncExclusions,omitempty"`
+
 	// WantSingletonReportedState means that for objects that are distributed --- taking
 	// all BindingPolicies into account --- to exactly one WEC, the object's reported state
 	// from the WEC should be written to the object in its WDS.
@@ -112,6 +131,202 @@ type BindingPolicySpec struct {
 	// expectation is not met.
 	// +optional
 	WantSingletonReportedState bool `json:"wantSingletonReportedState,omitempty"`
+
+	// `deletionPolicy` is the default for the `deletionPolicy` of the members of `downsync`.
+	// It also applies to the dependencies brought in by `wantDependencies`.
+	// The default is Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// `scheduling` modulates how the destinations are chosen from
+	// the clusters that pass the `clusterSelectors`.
+	// When omitted, every cluster that passes the `clusterSelectors` is a destination.
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+
+	// `spreadConstraints` constrain how the destinations are spread across groups of
+	// clusters, where a group is the clusters that have the same value of a given label.
+	// The constraints are applied after the Score phase of `scheduling` and before its
+	// Select phase, in the order listed; each constraint narrows the result of the previous.
+	// When a constraint cannot be met, the destinations are as close to meeting it as
+	// possible without violating it, and the `SpreadConstraintsSatisfied` condition
+	// of the BindingPolicy is False and says why.
+	// +optional
+	SpreadConstraints []SpreadConstraint `json:"spreadConstraints,omitempty"`
+
+	// `suspend`, when true, freezes the Binding of this BindingPolicy, so that
+	// what is in the WECs does not change in response to changes in the workload
+	// objects, the clusters or this BindingPolicy.
+	// While suspended, the BindingPolicy's Ready condition is False with reason ReconcilePaused.
+	// When `suspend` is set back to false, the Binding is brought up to date in one step.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// `rolloutStrategy`, if given, makes a change to the Binding reach the destinations
+	// progressively, in batches, rather than all at once.
+	// The progress is reported in the `RolledOut` condition of the Binding and of this BindingPolicy.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// `maintenanceWindow`, if given, restricts when changes are propagated to the destinations.
+	// Outside of its window, a destination keeps what was last propagated to it
+	// and is listed among the `pendingDestinations` in the status of the Binding.
+	// A WEC can have its own maintenance window, given by the properties
+	// `maintenanceSchedule`, `maintenanceDuration` and `maintenanceTimeZone`
+	// (from the annotations of its inventory object or its property ConfigMap);
+	// for that WEC, its own window takes precedence over this one.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// `priority` settles which BindingPolicy delivers an object to a WEC when several
+	// BindingPolicies would deliver it there. Only the ones with the highest priority do;
+	// the Bindings of the others skip the object for that WEC (see `excludedDestinations`
+	// in the Binding). BindingPolicies with equal priority all deliver the object,
+	// and any disagreement among them is reported in their `ConflictFree` condition.
+	// The default is zero. Negative priorities are allowed.
+	// Priorities, and the `ConflictFree` condition, need the controller manager to run
+	// without `--sharding`: a sharded one compares each BindingPolicy only with the others
+	// handled by the same replica, so it ignores the priority and reports it in `errors`.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period of time during which changes may be propagated.
+type MaintenanceWindow struct {
+	// `schedule` says when each window opens, in the standard five-field cron format
+	// (minute, hour, day of month, month, day of week) or as a descriptor
+	// such as "@daily" or "@weekly". For example, "0 1 * * *" opens a window at 01:00 every day.
+	Schedule string `json:"schedule"`
+
+	// `duration` is how long each window stays open.
+	Duration metav1.Duration `json:"duration"`
+
+	// `timeZone` is the IANA name of the time zone in which `schedule` is interpreted,
+	// such as "Europe/Paris". The default is UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// RolloutStrategy says how a change to a Binding is rolled out to its destinations.
+// The destinations are updated in batches, in the order of their names.
+// The first batch is updated immediately. Each following batch is updated once
+// the `pause` has elapsed since the previous batch and the `healthCheck`, if any,
+// is passed by all the destinations updated so far.
+// If the `healthCheck` is not passed within its `timeout`, the rollout halts and
+// remains halted until the Binding changes again.
+type RolloutStrategy struct {
+	// `batchSize` is the number of destinations to update in each batch.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// `batchPercentage` is the percentage of the destinations to update in each batch,
+	// rounded up. It is used when `batchSize` is zero.
+	// When neither is positive, each batch has one destination.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BatchPercentage int32 `json:"batchPercentage,omitempty"`
+
+	// `pause` is the minimum time between batches.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+
+	// `healthCheck`, if given, must be passed before each batch after the first.
+	// +optional
+	HealthCheck *RolloutHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// RolloutHealthCheck judges the health of the destinations that a rollout has updated,
+// from the results of a StatusCollector.
+type RolloutHealthCheck struct {
+	// `statusCollector` is the name of a StatusCollector that is applied to the
+	// workload objects (see the `statusCollectors` of DownsyncPolicyClause).
+	// The StatusCollector must have a `select` or `groupBy` column that holds
+	// the name of the WEC, such as `inventory.name`, so that its rows can be
+	// attributed to destinations.
+	StatusCollector string `json:"statusCollector"`
+
+	// `clusterColumn` is the name of the column that holds the name of the WEC.
+	// The default is "cluster".
+	// +optional
+	ClusterColumn string `json:"clusterColumn,omitempty"`
+
+	// `condition` is evaluated for each row of the StatusCollector's results, in the
+	// CombinedStatus objects of this BindingPolicy, that is about an updated destination.
+	// The row is available in the variable `row`, a map from column name to value.
+	// For example: `row.readyReplicas == row.replicas`.
+	// It must evaluate to a boolean.
+	// The health check is passed when every updated destination has at least one such row
+	// and the condition is true for all of them.
+	Condition Expression `json:"condition"`
+
+	// `timeout` is how long to wait, after the `pause` following a batch,
+	// for the health check to pass before halting the rollout.
+	// When omitted, the rollout waits indefinitely.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// SpreadConstraint constrains the number of destinations in each group of clusters.
+// A group is the candidate clusters that have the same value of the `topologyKey` label;
+// candidate clusters that lack that label are not destinations.
+// Within a group, the most desirable clusters are chosen.
+type SpreadConstraint struct {
+	// `topologyKey` is the key of the cluster label whose values define the groups.
+	TopologyKey string `json:"topologyKey"`
+
+	// `perGroupCount`, when positive, is the number of destinations to choose in each group.
+	// It is not met by a group that has fewer clusters.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PerGroupCount int32 `json:"perGroupCount,omitempty"`
+
+	// `maxSkew`, when positive, is the maximum difference between the numbers of
+	// destinations in any two groups.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+}
+
+// SchedulingSpec configures the scheduling of a BindingPolicy, which chooses its destinations.
+// Scheduling proceeds in three phases, in the style of the kube-scheduler.
+// First, the Filter phase removes every cluster that does not pass the `clusterSelectors`
+// or does not pass one of the enabled Filter plugins.
+// Next, the Score phase ranks the remaining clusters by the weighted sum of the scores
+// from the enabled Score plugins; ties are broken by cluster name.
+// Finally, the Select phase passes the ranked list through the enabled Select plugins,
+// in the order listed, and the clusters that remain are the destinations.
+type SchedulingSpec struct {
+	// `plugins` lists the scheduling plugins to enable, in addition to the label match
+	// that is implied by the `clusterSelectors`.
+	// A plugin takes part in every phase that it implements.
+	// The built-in plugins are the following.
+	// - "Availability" (Filter) passes only clusters whose ManagedClusterConditionAvailable
+	//   condition is True.
+	// - "Capacity" (Filter, Score) considers the allocatable quantity of the resource named
+	//   by the `resource` argument (default "cpu"). It passes only clusters that have at least
+	//   the quantity in the `min` argument, if given, and scores clusters by that quantity.
+	// - "SpreadByLabel" (Select) reorders the ranked clusters to alternate among the values
+	//   of the cluster label named by the `labelKey` argument.
+	// - "MaxClusters" (Select) keeps only the first `count` clusters.
+	// +optional
+	Plugins []SchedulingPlugin `json:"plugins,omitempty"`
+}
+
+// SchedulingPlugin enables one scheduling plugin and supplies its arguments.
+type SchedulingPlugin struct {
+	// `name` identifies the plugin.
+	Name string `json:"name"`
+
+	// `weight` multiplies the scores from this plugin in the Score phase.
+	// Zero means 1.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// `args` holds the plugin-specific arguments.
+	// +optional
+	Args map[string]string `json:"args,omitempty"`
 }
 
 const (
@@ -153,22 +368,56 @@ type DownsyncPolicyClause struct {
 	// +optional
 	CreateOnly bool `json:"createOnly,omitempty"`
 
+	// `deletionPolicy` says what happens in a WEC to a selected object when it stops
+	// being downsynced there: because it leaves the Binding, the WEC stops being a
+	// destination, or the BindingPolicy is deleted.
+	// When omitted, the BindingPolicy's `deletionPolicy` applies.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	// statusCollectors is a list of StatusCollectors name references that are applied to the selected objects.
 	StatusCollectors []string `json:"statusCollectors,omitempty"`
+
+	// `wantDependencies` indicates that the objects that the selected objects depend on
+	// are also to be downsynced.
+	// The dependencies of an object are: its Namespace; the CustomResourceDefinition
+	// that defines its kind, if any; and the objects that it references, as determined
+	// by the registered dependency functions for its kind.
+	// For the built-in workload kinds, those are the ServiceAccount, image pull Secrets,
+	// and the ConfigMaps, Secrets and PersistentVolumeClaims in volumes, `env` and `envFrom`
+	// of its pod template (or pod spec, for a Pod).
+	// Dependencies are not themselves searched for further dependencies, and are
+	// downsynced without the `createOnly` and `statusCollectors` of this clause.
+	// +optional
+	WantDependencies bool `json:"wantDependencies,omitempty"`
 }
 
+// DeletionPolicy says what happens in a WEC to a downsynced object when it stops being downsynced there.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete means that the object is deleted from the WEC.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyOrphan means that the object is left in the WEC, no longer managed by KubeStellar.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
 // DownsyncObjectTest is a set of criteria that characterize matching objects.
 // An object matches if:
 // - the `apiGroup` criterion is satisfied;
 // - the `resources` criterion is satisfied;
 // - the `namespaces` criterion is satisfied;
 // - the `namespaceSelectors` criterion is satisfied;
-// - the `objectNames` criterion is satisfied; and
-// - the `objectSelectors` criterion is satisfied.
+// - the `objectNames` criterion is satisfied;
+// - the `objectSelectors` criterion is satisfied; and
+// - the `objectCELExpression` criterion is satisfied.
 // At least one of the fields must make some discrimination;
 // it is not valid for every field to match all objects.
 // Validation might not be fully checked by apiservers until the Kubernetes dependency is release 1.25;
-// in the meantime validation error messages will appear
+// in the meantime it is done by the validating admission webhook of the controller-manager, when enabled,
+// and otherwise validation error messages will appear
 // in annotations whose key is `validation-error.kubestellar.io/{number}`.
 type DownsyncObjectTest struct {
 	// `apiGroup` is the API group of the referenced object, empty string for the core API group.
@@ -206,11 +455,23 @@ type DownsyncObjectTest struct {
 	ObjectSelectors []metav1.LabelSelector `json:"objectSelectors,omitempty"`
 
 	// `objectNames` is a list of object names that match.
-	// An entry of `"*"` means that all match.
+	// An entry may be a glob pattern, in the syntax of Go's `path.Match`
+	// (e.g., `"*-local"`); an entry of `"*"` means that all match.
 	// If this list contains `"*"` then it should contain nothing else.
 	// Empty list is a special case, it matches every object.
 	// +optional
 	ObjectNames []string `json:"objectNames,omitempty"`
+
+	// `objectCELExpression` is a CEL expression that must evaluate to `true`
+	// for the object being tested. The object is the value of the variable `obj`,
+	// for example: `obj.metadata.annotations["team"] == "x"`
+	// or `size(obj.data) > 10`.
+	// An expression that fails to evaluate, or evaluates to something other than
+	// a boolean, does not match; an expression that fails to compile matches nothing
+	// and is reported in the status.errors of the BindingPolicy.
+	// Omitted or empty matches every object.
+	// +optional
+	ObjectCELExpression *Expression `json:"objectCELExpression,omitempty"`
 }
 
 // BindingPolicyStatus defines the observed state of BindingPolicy
@@ -236,6 +497,7 @@ type BindingPolicyList struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName={bdg}
 type Binding struct {
@@ -263,6 +525,19 @@ type BindingSpec struct {
 
 	// `destinations` is a list of cluster-identifiers that the objects should be propagated to.
 	Destinations []Destination `json:"destinations,omitempty"`
+
+	// `suspend` is true while the BindingPolicy is suspended. In that state the rest
+	// of this spec is frozen, and the transport does not change what is in the destinations.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// `rolloutStrategy` is copied from the BindingPolicy.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// `maintenanceWindow` is copied from the BindingPolicy.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
 
 // DownsyncObjectClauses defines the objects to be down-synced, grouping them by scope.
@@ -288,8 +563,19 @@ type NamespaceScopeDownsyncClause struct {
 	// +optional
 	CreateOnly bool `json:"createOnly,omitempty"`
 
+	// `deletionPolicy` says what happens to the object in a WEC when it stops
+	// being downsynced there. The default is Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	// `statusCollectors` is a list of StatusCollectors name references that are applied to the object.
 	StatusCollectors []string `json:"statusCollectors,omitempty"`
+
+	// `excludedDestinations` lists the clusterIds of the destinations to which the object
+	// is not to be delivered by this Binding, because a BindingPolicy of higher priority
+	// delivers it there.
+	// +optional
+	ExcludedDestinations []string `json:"excludedDestinations,omitempty"`
 }
 
 // NamespaceScopeDownsyncObject references a specific namespace-scoped object to downsync,
@@ -315,8 +601,19 @@ type ClusterScopeDownsyncClause struct {
 	// +optional
 	CreateOnly bool `json:"createOnly,omitempty"`
 
+	// `deletionPolicy` says what happens to the object in a WEC when it stops
+	// being downsynced there. The default is Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	// `statusCollectors` is a list of StatusCollectors name references that are applied to the object.
 	StatusCollectors []string `json:"statusCollectors,omitempty"`
+
+	// `excludedDestinations` lists the clusterIds of the destinations to which the object
+	// is not to be delivered by this Binding, because a BindingPolicy of higher priority
+	// delivers it there.
+	// +optional
+	ExcludedDestinations []string `json:"excludedDestinations,omitempty"`
 }
 
 // ClusterScopeDownsyncObject references a specific cluster-scoped object to downsync,
@@ -338,6 +635,24 @@ type Destination struct {
 type BindingStatus struct {
 	ObservedGeneration int64    `json:"observedGeneration"`
 	Errors             []string `json:"errors,omitempty"`
+
+	// `conditions` presently holds only the `RolledOut` condition,
+	// when the Binding has a `rolloutStrategy`.
+	// +optional
+	Conditions []BindingPolicyCondition `json:"conditions,omitempty"`
+
+	// `pendingDestinations` lists the destinations that are outside of their
+	// maintenance window and have changes waiting to be propagated to them.
+	// +optional
+	PendingDestinations []PendingDestination `json:"pendingDestinations,omitempty"`
+}
+
+// PendingDestination is a destination whose changes are held until its next maintenance window.
+type PendingDestination struct {
+	ClusterId string `json:"clusterId"`
+
+	// `pendingUntil` is when the next maintenance window of the destination opens.
+	PendingUntil metav1.Time `json:"pendingUntil"`
 }
 
 // BindingList is the API type for a list of Binding
@@ -359,6 +674,7 @@ type BindingList struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName={sc}
 type StatusCollector struct {
@@ -479,6 +795,7 @@ type StatusCollectorList struct {
 //
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:resource:shortName={cs}
 // +kubebuilder:printcolumn:name="SUBJECT_GROUP",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/api-group']"
 // +kubebuilder:printcolumn:name="SUBJECT_RSC",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/resource']"
@@ -561,6 +878,7 @@ type CombinedStatusList struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,shortName={ct},categories={all}
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="SUBJECT_GROUP",type="string",JSONPath=".spec.apiGroup"
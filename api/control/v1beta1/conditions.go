@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ConditionType string
+
+const (
+	TypeReady  ConditionType = "Ready"
+	TypeSynced ConditionType = "Synced"
+
+	TypeSpreadConstraintsSatisfied ConditionType = "SpreadConstraintsSatisfied"
+	TypeRolledOut                  ConditionType = "RolledOut"
+	TypeConflictFree               ConditionType = "ConflictFree"
+)
+
+type ConditionReason string
+
+const (
+	ReasonAvailable   ConditionReason = "Available"
+	ReasonUnavailable ConditionReason = "Unavailable"
+	ReasonCreating    ConditionReason = "Creating"
+	ReasonDeleting    ConditionReason = "Deleting"
+)
+
+const (
+	ReasonReconcileSuccess ConditionReason = "ReconcileSuccess"
+	ReasonReconcileError   ConditionReason = "ReconcileError"
+	ReasonReconcilePaused  ConditionReason = "ReconcilePaused"
+)
+
+const (
+	ReasonSpreadConstraintsMet   ConditionReason = "SpreadConstraintsMet"
+	ReasonSpreadConstraintsUnmet ConditionReason = "SpreadConstraintsUnmet"
+)
+
+const (
+	ReasonRolloutProgressing ConditionReason = "RolloutProgressing"
+	ReasonRolloutComplete    ConditionReason = "RolloutComplete"
+	ReasonRolloutHalted      ConditionReason = "RolloutHalted"
+)
+
+const (
+	ReasonNoConflicts      ConditionReason = "NoConflicts"
+	ReasonOverlapConflicts ConditionReason = "OverlapConflicts"
+)
+
+// BindingPolicyCondition describes the state of a bindingpolicy at a certain point.
+type BindingPolicyCondition struct {
+	Type               ConditionType          `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	LastUpdateTime     metav1.Time            `json:"lastUpdateTime"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime"`
+	Reason             ConditionReason        `json:"reason"`
+	Message            string                 `json:"message"`
+}
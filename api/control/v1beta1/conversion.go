@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// The kinds of this version are converted to and from v1alpha1, the hub.
+// Most of the work is done by the generated functions in zz_generated.conversion.go;
+// the functions below cover the fields whose shape differs between the versions.
+
+var (
+	_ ctrlconversion.Convertible = &BindingPolicy{}
+	_ ctrlconversion.Convertible = &Binding{}
+	_ ctrlconversion.Convertible = &CustomTransform{}
+	_ ctrlconversion.Convertible = &StatusCollector{}
+	_ ctrlconversion.Convertible = &CombinedStatus{}
+)
+
+// ConvertTo converts this BindingPolicy to the hub version.
+func (src *BindingPolicy) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_BindingPolicy_To_v1alpha1_BindingPolicy(src, dstRaw.(*v1alpha1.BindingPolicy), nil)
+}
+
+// ConvertFrom converts the hub version to this BindingPolicy.
+func (dst *BindingPolicy) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_BindingPolicy_To_v1beta1_BindingPolicy(srcRaw.(*v1alpha1.BindingPolicy), dst, nil)
+}
+
+// ConvertTo converts this Binding to the hub version.
+func (src *Binding) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_Binding_To_v1alpha1_Binding(src, dstRaw.(*v1alpha1.Binding), nil)
+}
+
+// ConvertFrom converts the hub version to this Binding.
+func (dst *Binding) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_Binding_To_v1beta1_Binding(srcRaw.(*v1alpha1.Binding), dst, nil)
+}
+
+// ConvertTo converts this CustomTransform to the hub version.
+func (src *CustomTransform) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_CustomTransform_To_v1alpha1_CustomTransform(src, dstRaw.(*v1alpha1.CustomTransform), nil)
+}
+
+// ConvertFrom converts the hub version to this CustomTransform.
+func (dst *CustomTransform) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_CustomTransform_To_v1beta1_CustomTransform(srcRaw.(*v1alpha1.CustomTransform), dst, nil)
+}
+
+// ConvertTo converts this StatusCollector to the hub version.
+func (src *StatusCollector) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_StatusCollector_To_v1alpha1_StatusCollector(src, dstRaw.(*v1alpha1.StatusCollector), nil)
+}
+
+// ConvertFrom converts the hub version to this StatusCollector.
+func (dst *StatusCollector) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_StatusCollector_To_v1beta1_StatusCollector(srcRaw.(*v1alpha1.StatusCollector), dst, nil)
+}
+
+// ConvertTo converts this CombinedStatus to the hub version.
+func (src *CombinedStatus) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_CombinedStatus_To_v1alpha1_CombinedStatus(src, dstRaw.(*v1alpha1.CombinedStatus), nil)
+}
+
+// ConvertFrom converts the hub version to this CombinedStatus.
+func (dst *CombinedStatus) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_CombinedStatus_To_v1beta1_CombinedStatus(srcRaw.(*v1alpha1.CombinedStatus), dst, nil)
+}
+
+// Convert_v1beta1_BindingSpec_To_v1alpha1_BindingSpec wraps each cluster name in a Destination.
+func Convert_v1beta1_BindingSpec_To_v1alpha1_BindingSpec(in *BindingSpec, out *v1alpha1.BindingSpec, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_BindingSpec_To_v1alpha1_BindingSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Destinations = nil
+	if in.Destinations != nil {
+		out.Destinations = make([]v1alpha1.Destination, len(in.Destinations))
+		for idx, cluster := range in.Destinations {
+			out.Destinations[idx] = v1alpha1.Destination{ClusterId: cluster}
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Destination_To_string unwraps the cluster name of a Destination.
+func Convert_v1alpha1_Destination_To_string(in *v1alpha1.Destination, out *string, s conversion.Scope) error {
+	*out = in.ClusterId
+	return nil
+}
+
+// Convert_v1beta1_PendingDestination_To_v1alpha1_PendingDestination renames `cluster` to `clusterId`.
+func Convert_v1beta1_PendingDestination_To_v1alpha1_PendingDestination(in *PendingDestination, out *v1alpha1.PendingDestination, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_PendingDestination_To_v1alpha1_PendingDestination(in, out, s); err != nil {
+		return err
+	}
+	out.ClusterId = in.Cluster
+	return nil
+}
+
+// Convert_v1alpha1_PendingDestination_To_v1beta1_PendingDestination renames `clusterId` to `cluster`.
+func Convert_v1alpha1_PendingDestination_To_v1beta1_PendingDestination(in *v1alpha1.PendingDestination, out *PendingDestination, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_PendingDestination_To_v1beta1_PendingDestination(in, out, s); err != nil {
+		return err
+	}
+	out.Cluster = in.ClusterId
+	return nil
+}
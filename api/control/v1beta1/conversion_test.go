@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestBindingConversion(t *testing.T) {
+	hub := &v1alpha1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "bp1"},
+		Spec: v1alpha1.BindingSpec{
+			Workload: v1alpha1.DownsyncObjectClauses{NamespaceScope: []v1alpha1.NamespaceScopeDownsyncClause{{
+				NamespaceScopeDownsyncObject: v1alpha1.NamespaceScopeDownsyncObject{
+					GroupVersionResource: metav1.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+					Namespace:            "ns1", Name: "cm1", ResourceVersion: "12"},
+				ExcludedDestinations: []string{"c2"},
+			}}},
+			Destinations: []v1alpha1.Destination{{ClusterId: "c1"}, {ClusterId: "c2"}},
+		},
+		Status: v1alpha1.BindingStatus{
+			PendingDestinations: []v1alpha1.PendingDestination{{ClusterId: "c1", PendingUntil: metav1.Unix(1700000000, 0)}},
+		},
+	}
+	spoke := &Binding{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("failed to convert from hub: %v", err)
+	}
+	if expected := []string{"c1", "c2"}; !apiequality.Semantic.DeepEqual(spoke.Spec.Destinations, expected) {
+		t.Errorf("expected destinations %v, got %v", expected, spoke.Spec.Destinations)
+	}
+	if len(spoke.Status.PendingDestinations) != 1 || spoke.Status.PendingDestinations[0].Cluster != "c1" {
+		t.Errorf("expected c1 to be pending, got %v", spoke.Status.PendingDestinations)
+	}
+	roundTripped := &v1alpha1.Binding{}
+	if err := spoke.ConvertTo(roundTripped); err != nil {
+		t.Fatalf("failed to convert to hub: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(hub, roundTripped) {
+		t.Errorf("round trip changed the Binding: expected %#v, got %#v", hub, roundTripped)
+	}
+}
+
+func TestCombinedStatusConversion(t *testing.T) {
+	number := "1.5"
+	hub := &v1alpha1.CombinedStatus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "uid1.uid2"},
+		Results: []v1alpha1.NamedStatusCombination{{
+			Name:        "replicas",
+			ColumnNames: []string{"avg"},
+			Rows:        []v1alpha1.StatusCombinationRow{{Columns: []v1alpha1.Value{{Type: v1alpha1.TypeNumber, Number: &number}}}},
+		}},
+	}
+	spoke := &CombinedStatus{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("failed to convert from hub: %v", err)
+	}
+	encoded, err := json.Marshal(spoke.Results[0].Rows[0].Columns[0])
+	if err != nil {
+		t.Fatalf("failed to marshal a Value: %v", err)
+	}
+	if !strings.Contains(string(encoded), `"number":"1.5"`) {
+		t.Errorf("expected the number in the `number` field, got %s", encoded)
+	}
+	roundTripped := &v1alpha1.CombinedStatus{}
+	if err := spoke.ConvertTo(roundTripped); err != nil {
+		t.Fatalf("failed to convert to hub: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(hub, roundTripped) {
+		t.Errorf("round trip changed the CombinedStatus: expected %#v, got %#v", hub, roundTripped)
+	}
+}
+
+func TestBindingPolicyConversion(t *testing.T) {
+	apps := "apps"
+	spoke := &BindingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "bp1"},
+		Spec: BindingPolicySpec{
+			ClusterSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"env": "prod"}}},
+			Downsync: []DownsyncPolicyClause{{
+				DownsyncObjectTest: DownsyncObjectTest{APIGroup: &apps, Resources: []string{"deployments"}},
+				DeletionPolicy:     DeletionPolicyOrphan,
+				StatusCollectors:   []string{"sc1"},
+			}},
+			Priority: 3,
+		},
+		Status: BindingPolicyStatus{
+			Conditions: []BindingPolicyCondition{{Type: TypeReady, Status: "True", Reason: ReasonReconcileSuccess}},
+		},
+	}
+	hub := &v1alpha1.BindingPolicy{}
+	if err := spoke.ConvertTo(hub); err != nil {
+		t.Fatalf("failed to convert to hub: %v", err)
+	}
+	if hub.Spec.Priority != 3 || hub.Spec.Downsync[0].DeletionPolicy != v1alpha1.DeletionPolicyOrphan {
+		t.Errorf("conversion to hub lost fields: %#v", hub.Spec)
+	}
+	roundTripped := &BindingPolicy{}
+	if err := roundTripped.ConvertFrom(hub); err != nil {
+		t.Fatalf("failed to convert from hub: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(spoke, roundTripped) {
+		t.Errorf("round trip changed the BindingPolicy: expected %#v, got %#v", spoke, roundTripped)
+	}
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 is the v1beta1 version of the control.kubestellar.io API.
+// It has the same kinds as v1alpha1, with a cleaned-up schema:
+// the destinations of a Binding are simply cluster names,
+// a pending destination names its cluster in `cluster`,
+// and a numeric Value in a CombinedStatus is in the field named `number`.
+// The objects are stored as v1alpha1, which is the hub of the conversions;
+// the apiserver reaches the conversion webhook of the controller-manager to serve v1beta1.
+//
+// +k8s:conversion-gen=github.com/kubestellar/kubestellar/api/control/v1alpha1
+// +groupName=control.kubestellar.io
+
+package v1beta1
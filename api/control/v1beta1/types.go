@@ -0,0 +1,839 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindingPolicy defines in which ways the workload objects ('what') and the destinations ('where') are bound together.
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,shortName={bp}
+type BindingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BindingPolicySpec   `json:"spec,omitempty"`
+	Status BindingPolicyStatus `json:"status,omitempty"`
+}
+
+// BindingPolicySpec defines the desired state of BindingPolicy
+type BindingPolicySpec struct {
+	// `clusterSelectors` identifies the relevant Cluster objects in terms of their labels.
+	// A Cluster is relevant if and only if it passes any of the LabelSelectors in this field.
+	ClusterSelectors []metav1.LabelSelector `json:"clusterSelectors,omitempty"`
+
+	// `downsync` selects the objects to bind with the selected WECs for downsync,
+	// and modulates their downsync.
+	// An object is selected if it matches at least one member of this list.
+	// When multiple DownsyncPolicyClause match the same workload object:
+	// the `createOnly` bits are ORed together, the object is orphaned if any of
+	// their `deletionPolicy` says so, and the StatusCollector reference
+	// sets are combined by union.
+	Downsync []DownsyncPolicyClause `json:"downsync,omitempty"`
+
+	// `downsyncExclusions` removes objects from the selection made by `downsync`.
+	// An object that matches at least one member of `downsync` is nonetheless
+	// not selected if it matches at least one member of this list.
+	// +optional
+	DownsyncExclusions []DownsyncObjectTest `json:"downsyncExclusions,omitempty"`
+
+	// WantSingletonReportedState means that for objects that are distributed --- taking
+	// all BindingPolicies into account --- to exactly one WEC, the object's reported state
+	// from the WEC should be written to the object in its WDS.
+	// WantSingletonReportedState connotes an expectation that indeed the object will
+	// propagate to exactly one WEC, but there is no guaranteed reaction when this
+	// expectation is not met.
+	// +optional
+	WantSingletonReportedState bool `json:"wantSingletonReportedState,omitempty"`
+
+	// `deletionPolicy` is the default for the `deletionPolicy` of the members of `downsync`.
+	// It also applies to the dependencies brought in by `wantDependencies`.
+	// The default is Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// `scheduling` modulates how the destinations are chosen from
+	// the clusters that pass the `clusterSelectors`.
+	// When omitted, every cluster that passes the `clusterSelectors` is a destination.
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+
+	// `spreadConstraints` constrain how the destinations are spread across groups of
+	// clusters, where a group is the clusters that have the same value of a given label.
+	// The constraints are applied after the Score phase of `scheduling` and before its
+	// Select phase, in the order listed; each constraint narrows the result of the previous.
+	// When a constraint cannot be met, the destinations are as close to meeting it as
+	// possible without violating it, and the `SpreadConstraintsSatisfied` condition
+	// of the BindingPolicy is False and says why.
+	// +optional
+	SpreadConstraints []SpreadConstraint `json:"spreadConstraints,omitempty"`
+
+	// `suspend`, when true, freezes the Binding of this BindingPolicy, so that
+	// what is in the WECs does not change in response to changes in the workload
+	// objects, the clusters or this BindingPolicy.
+	// While suspended, the BindingPolicy's Ready condition is False with reason ReconcilePaused.
+	// When `suspend` is set back to false, the Binding is brought up to date in one step.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// `rolloutStrategy`, if given, makes a change to the Binding reach the destinations
+	// progressively, in batches, rather than all at once.
+	// The progress is reported in the `RolledOut` condition of the Binding and of this BindingPolicy.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// `maintenanceWindow`, if given, restricts when changes are propagated to the destinations.
+	// Outside of its window, a destination keeps what was last propagated to it
+	// and is listed among the `pendingDestinations` in the status of the Binding.
+	// A WEC can have its own maintenance window, given by the properties
+	// `maintenanceSchedule`, `maintenanceDuration` and `maintenanceTimeZone`
+	// (from the annotations of its inventory object or its property ConfigMap);
+	// for that WEC, its own window takes precedence over this one.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// `priority` settles which BindingPolicy delivers an object to a WEC when several
+	// BindingPolicies would deliver it there. Only the ones with the highest priority do;
+	// the Bindings of the others skip the object for that WEC (see `excludedDestinations`
+	// in the Binding). BindingPolicies with equal priority all deliver the object,
+	// and any disagreement among them is reported in their `ConflictFree` condition.
+	// The default is zero. Negative priorities are allowed.
+	// Priorities, and the `ConflictFree` condition, need the controller manager to run
+	// without `--sharding`: a sharded one compares each BindingPolicy only with the others
+	// handled by the same replica, so it ignores the priority and reports it in `errors`.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period of time during which changes may be propagated.
+type MaintenanceWindow struct {
+	// `schedule` says when each window opens, in the standard five-field cron format
+	// (minute, hour, day of month, month, day of week) or as a descriptor
+	// such as "@daily" or "@weekly". For example, "0 1 * * *" opens a window at 01:00 every day.
+	Schedule string `json:"schedule"`
+
+	// `duration` is how long each window stays open.
+	Duration metav1.Duration `json:"duration"`
+
+	// `timeZone` is the IANA name of the time zone in which `schedule` is interpreted,
+	// such as "Europe/Paris". The default is UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// RolloutStrategy says how a change to a Binding is rolled out to its destinations.
+// The destinations are updated in batches, in the order of their names.
+// The first batch is updated immediately. Each following batch is updated once
+// the `pause` has elapsed since the previous batch and the `healthCheck`, if any,
+// is passed by all the destinations updated so far.
+// If the `healthCheck` is not passed within its `timeout`, the rollout halts and
+// remains halted until the Binding changes again.
+type RolloutStrategy struct {
+	// `batchSize` is the number of destinations to update in each batch.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// `batchPercentage` is the percentage of the destinations to update in each batch,
+	// rounded up. It is used when `batchSize` is zero.
+	// When neither is positive, each batch has one destination.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BatchPercentage int32 `json:"batchPercentage,omitempty"`
+
+	// `pause` is the minimum time between batches.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+
+	// `healthCheck`, if given, must be passed before each batch after the first.
+	// +optional
+	HealthCheck *RolloutHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// RolloutHealthCheck judges the health of the destinations that a rollout has updated,
+// from the results of a StatusCollector.
+type RolloutHealthCheck struct {
+	// `statusCollector` is the name of a StatusCollector that is applied to the
+	// workload objects (see the `statusCollectors` of DownsyncPolicyClause).
+	// The StatusCollector must have a `select` or `groupBy` column that holds
+	// the name of the WEC, such as `inventory.name`, so that its rows can be
+	// attributed to destinations.
+	StatusCollector string `json:"statusCollector"`
+
+	// `clusterColumn` is the name of the column that holds the name of the WEC.
+	// The default is "cluster".
+	// +optional
+	ClusterColumn string `json:"clusterColumn,omitempty"`
+
+	// `condition` is evaluated for each row of the StatusCollector's results, in the
+	// CombinedStatus objects of this BindingPolicy, that is about an updated destination.
+	// The row is available in the variable `row`, a map from column name to value.
+	// For example: `row.readyReplicas == row.replicas`.
+	// It must evaluate to a boolean.
+	// The health check is passed when every updated destination has at least one such row
+	// and the condition is true for all of them.
+	Condition Expression `json:"condition"`
+
+	// `timeout` is how long to wait, after the `pause` following a batch,
+	// for the health check to pass before halting the rollout.
+	// When omitted, the rollout waits indefinitely.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// SpreadConstraint constrains the number of destinations in each group of clusters.
+// A group is the candidate clusters that have the same value of the `topologyKey` label;
+// candidate clusters that lack that label are not destinations.
+// Within a group, the most desirable clusters are chosen.
+type SpreadConstraint struct {
+	// `topologyKey` is the key of the cluster label whose values define the groups.
+	TopologyKey string `json:"topologyKey"`
+
+	// `perGroupCount`, when positive, is the number of destinations to choose in each group.
+	// It is not met by a group that has fewer clusters.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PerGroupCount int32 `json:"perGroupCount,omitempty"`
+
+	// `maxSkew`, when positive, is the maximum difference between the numbers of
+	// destinations in any two groups.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+}
+
+// SchedulingSpec configures the scheduling of a BindingPolicy, which chooses its destinations.
+// Scheduling proceeds in three phases, in the style of the kube-scheduler.
+// First, the Filter phase removes every cluster that does not pass the `clusterSelectors`
+// or does not pass one of the enabled Filter plugins.
+// Next, the Score phase ranks the remaining clusters by the weighted sum of the scores
+// from the enabled Score plugins; ties are broken by cluster name.
+// Finally, the Select phase passes the ranked list through the enabled Select plugins,
+// in the order listed, and the clusters that remain are the destinations.
+type SchedulingSpec struct {
+	// `plugins` lists the scheduling plugins to enable, in addition to the label match
+	// that is implied by the `clusterSelectors`.
+	// A plugin takes part in every phase that it implements.
+	// The built-in plugins are the following.
+	// - "Availability" (Filter) passes only clusters whose ManagedClusterConditionAvailable
+	//   condition is True.
+	// - "Capacity" (Filter, Score) considers the allocatable quantity of the resource named
+	//   by the `resource` argument (default "cpu"). It passes only clusters that have at least
+	//   the quantity in the `min` argument, if given, and scores clusters by that quantity.
+	// - "SpreadByLabel" (Select) reorders the ranked clusters to alternate among the values
+	//   of the cluster label named by the `labelKey` argument.
+	// - "MaxClusters" (Select) keeps only the first `count` clusters.
+	// +optional
+	Plugins []SchedulingPlugin `json:"plugins,omitempty"`
+}
+
+// SchedulingPlugin enables one scheduling plugin and supplies its arguments.
+type SchedulingPlugin struct {
+	// `name` identifies the plugin.
+	Name string `json:"name"`
+
+	// `weight` multiplies the scores from this plugin in the Score phase.
+	// Zero means 1.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// `args` holds the plugin-specific arguments.
+	// +optional
+	Args map[string]string `json:"args,omitempty"`
+}
+
+// DownsyncPolicyClause identifies some objects (by a predicate)
+// and modulates how they are downsynced.
+// One modulation is specifying a set of StatusCollectors to apply
+// to returned status.
+// The other modulation is specifying whether the propagation from WDS to WEC
+// involves continual maintenance of the spec or the object is only created
+// if it is absent.
+type DownsyncPolicyClause struct {
+	DownsyncObjectTest `json:",inline"`
+
+	// `createOnly` indicates that in a given WEC, the object is not to be updated
+	// if it already exists.
+	// +optional
+	CreateOnly bool `json:"createOnly,omitempty"`
+
+	// `deletionPolicy` says what happens in a WEC to a selected object when it stops
+	// being downsynced there: because it leaves the Binding, the WEC stops being a
+	// destination, or the BindingPolicy is deleted.
+	// When omitted, the BindingPolicy's `deletionPolicy` applies.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// statusCollectors is a list of StatusCollectors name references that are applied to the selected objects.
+	StatusCollectors []string `json:"statusCollectors,omitempty"`
+
+	// `wantDependencies` indicates that the objects that the selected objects depend on
+	// are also to be downsynced.
+	// The dependencies of an object are: its Namespace; the CustomResourceDefinition
+	// that defines its kind, if any; and the objects that it references, as determined
+	// by the registered dependency functions for its kind.
+	// For the built-in workload kinds, those are the ServiceAccount, image pull Secrets,
+	// and the ConfigMaps, Secrets and PersistentVolumeClaims in volumes, `env` and `envFrom`
+	// of its pod template (or pod spec, for a Pod).
+	// Dependencies are not themselves searched for further dependencies, and are
+	// downsynced without the `createOnly` and `statusCollectors` of this clause.
+	// +optional
+	WantDependencies bool `json:"wantDependencies,omitempty"`
+}
+
+// DeletionPolicy says what happens in a WEC to a downsynced object when it stops being downsynced there.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete means that the object is deleted from the WEC.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyOrphan means that the object is left in the WEC, no longer managed by KubeStellar.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// DownsyncObjectTest is a set of criteria that characterize matching objects.
+// An object matches if:
+// - the `apiGroup` criterion is satisfied;
+// - the `resources` criterion is satisfied;
+// - the `namespaces` criterion is satisfied;
+// - the `namespaceSelectors` criterion is satisfied;
+// - the `objectNames` criterion is satisfied;
+// - the `objectSelectors` criterion is satisfied; and
+// - the `objectCELExpression` criterion is satisfied.
+// At least one of the fields must make some discrimination;
+// it is not valid for every field to match all objects.
+// Validation might not be fully checked by apiservers until the Kubernetes dependency is release 1.25;
+// in the meantime it is done by the validating admission webhook of the controller-manager, when enabled.
+type DownsyncObjectTest struct {
+	// `apiGroup` is the API group of the referenced object, empty string for the core API group.
+	// `nil` matches every API group.
+	// +optional
+	APIGroup *string `json:"apiGroup"`
+
+	// `resources` is a list of lowercase plural names for the sorts of objects to match.
+	// An entry of `"*"` means that all match.
+	// If this list contains `"*"` then it should contain nothing else.
+	// Empty list is a special case, it matches every object.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// `namespaces` is a list of acceptable names for the object's namespace.
+	// An entry of `"*"` means that any namespace is acceptable;
+	// this is the only way to match a cluster-scoped object.
+	// If this list contains `"*"` then it should contain nothing else.
+	// Empty list is a special case, it matches every object.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// `namespaceSelectors` a list of label selectors.
+	// For a namespaced object, at least one of these label selectors has to match
+	// the labels of the Namespace object that defines the namespace of the object that this DownsyncObjectTest is testing.
+	// For a cluster-scoped object, at least one of these label selectors must be `{}`.
+	// Empty list is a special case, it matches every object.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+
+	// `objectSelectors` is a list of label selectors.
+	// At least one of them must match the labels of the object being tested.
+	// Empty list is a special case, it matches every object.
+	// +optional
+	ObjectSelectors []metav1.LabelSelector `json:"objectSelectors,omitempty"`
+
+	// `objectNames` is a list of object names that match.
+	// An entry may be a glob pattern, in the syntax of Go's `path.Match`
+	// (e.g., `"*-local"`); an entry of `"*"` means that all match.
+	// If this list contains `"*"` then it should contain nothing else.
+	// Empty list is a special case, it matches every object.
+	// +optional
+	ObjectNames []string `json:"objectNames,omitempty"`
+
+	// `objectCELExpression` is a CEL expression that must evaluate to `true`
+	// for the object being tested. The object is the value of the variable `obj`,
+	// for example: `obj.metadata.annotations["team"] == "x"`
+	// or `size(obj.data) > 10`.
+	// An expression that fails to evaluate, or evaluates to something other than
+	// a boolean, does not match; an expression that fails to compile matches nothing
+	// and is reported in the status.errors of the BindingPolicy.
+	// Omitted or empty matches every object.
+	// +optional
+	ObjectCELExpression *Expression `json:"objectCELExpression,omitempty"`
+}
+
+// BindingPolicyStatus defines the observed state of BindingPolicy
+type BindingPolicyStatus struct {
+	Conditions         []BindingPolicyCondition `json:"conditions"`
+	ObservedGeneration int64                    `json:"observedGeneration"`
+	Errors             []string                 `json:"errors,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BindingPolicyList contains a list of BindingPolicies
+type BindingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BindingPolicy `json:"items"`
+}
+
+// Binding is mapped 1:1 to a single BindingPolicy object.
+// Binding reflects the resolution of the BindingPolicy's selectors,
+// and explicitly reflects which objects should go to what destinations.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName={bdg}
+type Binding struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// `spec` explicitly describes a desired binding between workloads and Locations.
+	// It reflects the resolution of a BindingPolicy's selectors.
+	Spec BindingSpec `json:"spec,omitempty"`
+
+	Status BindingStatus `json:"status,omitempty"`
+}
+
+// BindingSpec holds a list of object references with their associated resource versions,
+// and a list of destinations which are the resolution of a BindingPolicy's `what` and `where`:
+// what objects to propagate and to where.
+// All objects referenced in this spec are propagated to all destinations present.
+type BindingSpec struct {
+	// `workload` is a collection of namespaced and cluster scoped object references and their associated
+	// data - resource versions, create-only bits, and statuscollectors - to be propagated to destination clusters.
+	Workload DownsyncObjectClauses `json:"workload,omitempty"`
+
+	// `destinations` is a list of the names of the clusters that the objects should be propagated to.
+	// +k8s:conversion-gen=false
+	Destinations []string `json:"destinations,omitempty"`
+
+	// `suspend` is true while the BindingPolicy is suspended. In that state the rest
+	// of this spec is frozen, and the transport does not change what is in the destinations.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// `rolloutStrategy` is copied from the BindingPolicy.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// `maintenanceWindow` is copied from the BindingPolicy.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// DownsyncObjectClauses defines the objects to be down-synced, grouping them by scope.
+// It specifies a set of object references with their associated resource versions, to be downsynced.
+// Each object reference is associated with a set of statuscollectors that should be applied to it.
+type DownsyncObjectClauses struct {
+	// `clusterScope` holds a list of references to cluster-scoped objects to downsync and how the
+	// downsync is to be modulated.
+	ClusterScope []ClusterScopeDownsyncClause `json:"clusterScope,omitempty"`
+
+	// `namespaceScope` holds a list of references to namsepace-scoped objects to downsync and how the
+	// downsync is to be modulated.
+	NamespaceScope []NamespaceScopeDownsyncClause `json:"namespaceScope,omitempty"`
+}
+
+// NamespaceScopeDownsyncClause references a specific namespace-scoped object to downsync,
+// and the status collectors that should be applied to it.
+type NamespaceScopeDownsyncClause struct {
+	NamespaceScopeDownsyncObject `json:",inline"`
+
+	// `createOnly` indicates that in a given WEC, the object is not to be updated
+	// if it already exists.
+	// +optional
+	CreateOnly bool `json:"createOnly,omitempty"`
+
+	// `deletionPolicy` says what happens to the object in a WEC when it stops
+	// being downsynced there. The default is Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// `statusCollectors` is a list of StatusCollectors name references that are applied to the object.
+	StatusCollectors []string `json:"statusCollectors,omitempty"`
+
+	// `excludedDestinations` lists the names of the destinations to which the object
+	// is not to be delivered by this Binding, because a BindingPolicy of higher priority
+	// delivers it there.
+	// +optional
+	ExcludedDestinations []string `json:"excludedDestinations,omitempty"`
+}
+
+// NamespaceScopeDownsyncObject references a specific namespace-scoped object to downsync,
+// identified by its GroupVersionResource, namespace, and name. The ResourceVersion specifies
+// the exact version of the object to downsync.
+type NamespaceScopeDownsyncObject struct {
+	metav1.GroupVersionResource `json:",inline"`
+	// `namespace` of the object to downsync.
+	Namespace string `json:"namespace"`
+	// `name` of the object to downsync.
+	Name string `json:"name"`
+	// `resourceVersion` is the version of the resource to downsync.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// ClusterScopeDownsyncClause references a specific cluster-scoped object to downsync,
+// and the status collectors that should be applied to it.
+type ClusterScopeDownsyncClause struct {
+	ClusterScopeDownsyncObject `json:",inline"`
+
+	// `createOnly` indicates that in a given WEC, the object is not to be updated
+	// if it already exists.
+	// +optional
+	CreateOnly bool `json:"createOnly,omitempty"`
+
+	// `deletionPolicy` says what happens to the object in a WEC when it stops
+	// being downsynced there. The default is Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// `statusCollectors` is a list of StatusCollectors name references that are applied to the object.
+	StatusCollectors []string `json:"statusCollectors,omitempty"`
+
+	// `excludedDestinations` lists the names of the destinations to which the object
+	// is not to be delivered by this Binding, because a BindingPolicy of higher priority
+	// delivers it there.
+	// +optional
+	ExcludedDestinations []string `json:"excludedDestinations,omitempty"`
+}
+
+// ClusterScopeDownsyncObject references a specific cluster-scoped object to downsync,
+// identified by its GroupVersionResource and name. The ResourceVersion specifies the
+// exact version of the object to downsync.
+type ClusterScopeDownsyncObject struct {
+	metav1.GroupVersionResource `json:",inline"`
+	// `name` of the object to downsync.
+	Name string `json:"name"`
+	// `resourceVersion` is the version of the resource to downsync.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+type BindingStatus struct {
+	ObservedGeneration int64    `json:"observedGeneration"`
+	Errors             []string `json:"errors,omitempty"`
+
+	// `conditions` presently holds only the `RolledOut` condition,
+	// when the Binding has a `rolloutStrategy`.
+	// +optional
+	Conditions []BindingPolicyCondition `json:"conditions,omitempty"`
+
+	// `pendingDestinations` lists the destinations that are outside of their
+	// maintenance window and have changes waiting to be propagated to them.
+	// +optional
+	PendingDestinations []PendingDestination `json:"pendingDestinations,omitempty"`
+}
+
+// PendingDestination is a destination whose changes are held until its next maintenance window.
+type PendingDestination struct {
+	// `cluster` is the name of the destination.
+	Cluster string `json:"cluster"`
+
+	// `pendingUntil` is when the next maintenance window of the destination opens.
+	PendingUntil metav1.Time `json:"pendingUntil"`
+}
+
+// BindingList is the API type for a list of Binding
+//
+// +kubebuilder:object:root=true
+type BindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Binding `json:"items"`
+}
+
+// StatusCollector defines one way to collect status about a given workload object from
+// the set of WECs that it propagates to.
+// This is modeled after an SQL SELECT statement that does aggregation.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName={sc}
+type StatusCollector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StatusCollectorSpec   `json:"spec,omitempty"`
+	Status StatusCollectorStatus `json:"status,omitempty"`
+}
+
+// StatusCollectorSpec defines the desired state of StatusCollector.
+type StatusCollectorSpec struct {
+	// `filter`, if given, is applied first.
+	// It must evaluate to a boolean or null (which is treated as false).
+	// This is like the WHERE clause in an SQL SELECT statement.
+	// +optional
+	Filter *Expression `json:"filter,omitempty"`
+
+	// `groupBy` says how to group workload objects for aggregation (if there is any).
+	// Each expression must evaluate to an atomic value.
+	// `groupBy` must be empty if `combinedFields` is.
+	// +optional
+	GroupBy []NamedExpression `json:"groupBy,omitempty"`
+
+	// `combinedFields` defines the aggregations to do, if any.
+	// `combinedFields` must be empty if `select` is not.
+	// +optional
+	CombinedFields []NamedAggregator `json:"combinedFields,omitempty"`
+
+	// `select` defines named values to extract from each object.
+	// `select` must be empty when `combinedFields` is not.
+	// +optional
+	Select []NamedExpression `json:"select,omitempty"`
+
+	// `limit` limits the number of rows returned.
+	// The default value is 20.
+	Limit int64 `json:"limit"`
+}
+
+// NamedExpression pairs a name with a way of extracting a value from a JSON object.
+type NamedExpression struct {
+	Name string     `json:"name"`
+	Def  Expression `json:"def"`
+}
+
+// NamedAggregator pairs a name with a way to aggregate over some objects.
+//
+// - For `type=="COUNT"`, `subject` is omitted and the aggregate is the count
+// of those objects that are not `null`.
+//
+// - For the other types, `subject` is required and SHOULD
+// evaluate to a numeric value; exceptions are handled as follows.
+// For a string value: if it parses as an int64 or float64 then that is used.
+// Otherwise this is an error condition: a value of 0 is used, and the error
+// is reported in the BindingPolicyStatus.Errors (not necessarily repeated for each WEC).
+type NamedAggregator struct {
+	Name string         `json:"name"`
+	Type AggregatorType `json:"type"`
+
+	// +optional
+	Subject *Expression `json:"subject,omitempty"`
+}
+
+// AggregatorType indicates what sort of aggregation is to be done.
+type AggregatorType string
+
+const (
+	AggregatorTypeCount AggregatorType = "COUNT"
+	AggregatorTypeSum   AggregatorType = "SUM"
+	AggregatorTypeAvg   AggregatorType = "AVG"
+	AggregatorTypeMin   AggregatorType = "MIN"
+	AggregatorTypeMax   AggregatorType = "MAX"
+)
+
+// Expression is written in the [Common Expression Language](https://cel.dev/).
+// See github.com/google/cel-go for the Go implementation used in Kubernetes,
+// and https://kubernetes.io/docs/reference/using-api/cel/ about CEL's uses in Kubernetes.
+// The expression will be type-checked against the schema for the object type at hand,
+// using the Kubernetes library code for converting an OpenAPI schema to a CEL type
+// (e.g., https://github.com/kubernetes/apiserver/blob/v0.28.2/pkg/cel/common/schemas.go#L40).
+// Parsing errors are posted to the status.Errors of the StatusCollector.
+// Type checking errors are posted to the status.Errors of the Binding and BindingPolicy.
+type Expression string
+
+// StatusCollectorStatus defines the observed state of StatusCollector.
+type StatusCollectorStatus struct {
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+}
+
+// StatusCollectorList is the API type for a list of StatusCollector.
+//
+// +kubebuilder:object:root=true
+type StatusCollectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StatusCollector `json:"items"`
+}
+
+// CombinedStatus holds the combined status from the WECs for one particular (workload object, BindingPolicy) pair.
+// The namespace of the CombinedStatus object is the namespace of the workload object,
+// or "kubestellar-report" if the workload object has no namespace.
+// The name of the CombinedStatus object is the concatenation of:
+// - the UID of the workload object
+// - the string "."
+// - the UID of the BindingPolicy object.
+// The CombinedStatus object has the following labels:
+// - "status.kubestellar.io/api-group" holding the API Group (not verison) of the workload object;
+// - "status.kubestellar.io/resource" holding the resource (lowercase plural) of the workload object;
+// - "status.kubestellar.io/namespace" holding the namespace of the workload object;
+// - "status.kubestellar.io/name" holding the name of the workload object;
+// - "status.kubestellar.io/binding-policy" holding the name of the BindingPolicy object.
+//
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName={cs}
+// +kubebuilder:printcolumn:name="SUBJECT_GROUP",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/api-group']"
+// +kubebuilder:printcolumn:name="SUBJECT_RSC",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/resource']"
+// +kubebuilder:printcolumn:name="SUBJECT_NS",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/namespace']"
+// +kubebuilder:printcolumn:name="SUBJECT_NAME",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/name']"
+// +kubebuilder:printcolumn:name="BINDINGPOLICY",type="string",JSONPath=".metadata.labels['status\\.kubestellar\\.io/policy']"
+type CombinedStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// `results` has an entry for every applicable StatusCollector.
+	// +optional
+	Results []NamedStatusCombination `json:"results,omitempty"`
+}
+
+// NamedStatusCombination holds the rows that come from evaluating one StatusCollector.
+type NamedStatusCombination struct {
+	Name string `json:"name"`
+
+	ColumnNames []string `json:"columnNames"`
+
+	// +optional
+	Rows []StatusCombinationRow `json:"rows,omitempty"`
+}
+
+type StatusCombinationRow struct {
+	Columns []Value `json:"columns"`
+}
+
+// Value holds a JSON value. This is a union type.
+type Value struct {
+	Type ValueType `json:"type"`
+
+	// +optional
+	String *string `json:"string,omitempty"`
+
+	// `number` is an integer or floating-point number, written in JavaScript Object Notation
+	// so that no precision is lost.
+	// +optional
+	Number *string `json:"number,omitempty"`
+
+	// +optional
+	Bool *bool `json:"bool,omitempty"`
+
+	// +optional
+	Object *v1.JSON `json:"object,omitempty"`
+
+	// +optional
+	Array *v1.JSON `json:"array,omitempty"`
+}
+
+type ValueType string
+
+const (
+	TypeString ValueType = "String"
+	TypeNumber ValueType = "Number"
+	TypeBool   ValueType = "Bool"
+	TypeNull   ValueType = "Null"
+	TypeObject ValueType = "Object"
+	TypeArray  ValueType = "Array"
+)
+
+// CombinedStatusList is the API type for a list of CombinedStatus.
+//
+// +kubebuilder:object:root=true
+type CombinedStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CombinedStatus `json:"items"`
+}
+
+// CustomTransform describes how to select and transform some objects
+// on their way from WDS to WEC, without regard to the WEC (i.e.,
+// not changes that are specific to the individual WEC).
+// The transformation specified here is in addition to, and follows,
+// whatever is built into KubeStellar for that object.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName={ct},categories={all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SUBJECT_GROUP",type="string",JSONPath=".spec.apiGroup"
+// +kubebuilder:printcolumn:name="SUBJECT_RESOURCE",type="string",JSONPath=".spec.resource"
+type CustomTransform struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomTransformSpec   `json:"spec,omitempty"`
+	Status CustomTransformStatus `json:"status,omitempty"`
+}
+
+// CustomTransformSpec selects some objects and describes how to transform them.
+// The selected objects are those that match the `apiGroup` and `resource` fields.
+type CustomTransformSpec struct {
+	// `apiGroup` holds just the group, not also the version
+	APIGroup string `json:"apiGroup"`
+
+	// `resource` is the lowercase plural way of identifying a sort of object.
+	// "subresources" can not be directly bound to, only whole (top-level) objects.
+	Resource string `json:"resource"`
+
+	// `remove` is a list of JSONPath expressions (https://goessner.net/articles/JsonPath/)
+	// that identify part of the object to remove if present.
+	// Only a subset of JSONPath is supported.
+	// The expression used in a filter must be a conjunction of field == literal tests.
+	// Examples:
+	// - "$.spec.resources.GenericItems[*].generictemplate.metadata.resourceVersion"
+	// - "$.store.book[?(@.author == 'Kilgore Trout' && @.category == 'fiction')].price"
+	// +optional
+	Remove []string `json:"remove,omitempty"`
+}
+
+type CustomTransformStatus struct {
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// CustomTransformList is the API type for a list of CustomTransform
+//
+// +kubebuilder:object:root=true
+type CustomTransformList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomTransform `json:"items"`
+}
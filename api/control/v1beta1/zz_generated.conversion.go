@@ -0,0 +1,1460 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	unsafe "unsafe"
+
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+
+	v1alpha1 "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Binding)(nil), (*v1alpha1.Binding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Binding_To_v1alpha1_Binding(a.(*Binding), b.(*v1alpha1.Binding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Binding)(nil), (*Binding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Binding_To_v1beta1_Binding(a.(*v1alpha1.Binding), b.(*Binding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingList)(nil), (*v1alpha1.BindingList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingList_To_v1alpha1_BindingList(a.(*BindingList), b.(*v1alpha1.BindingList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingList)(nil), (*BindingList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingList_To_v1beta1_BindingList(a.(*v1alpha1.BindingList), b.(*BindingList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingPolicy)(nil), (*v1alpha1.BindingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingPolicy_To_v1alpha1_BindingPolicy(a.(*BindingPolicy), b.(*v1alpha1.BindingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingPolicy)(nil), (*BindingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingPolicy_To_v1beta1_BindingPolicy(a.(*v1alpha1.BindingPolicy), b.(*BindingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingPolicyCondition)(nil), (*v1alpha1.BindingPolicyCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingPolicyCondition_To_v1alpha1_BindingPolicyCondition(a.(*BindingPolicyCondition), b.(*v1alpha1.BindingPolicyCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingPolicyCondition)(nil), (*BindingPolicyCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingPolicyCondition_To_v1beta1_BindingPolicyCondition(a.(*v1alpha1.BindingPolicyCondition), b.(*BindingPolicyCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingPolicyList)(nil), (*v1alpha1.BindingPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingPolicyList_To_v1alpha1_BindingPolicyList(a.(*BindingPolicyList), b.(*v1alpha1.BindingPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingPolicyList)(nil), (*BindingPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingPolicyList_To_v1beta1_BindingPolicyList(a.(*v1alpha1.BindingPolicyList), b.(*BindingPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingPolicySpec)(nil), (*v1alpha1.BindingPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingPolicySpec_To_v1alpha1_BindingPolicySpec(a.(*BindingPolicySpec), b.(*v1alpha1.BindingPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingPolicySpec)(nil), (*BindingPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingPolicySpec_To_v1beta1_BindingPolicySpec(a.(*v1alpha1.BindingPolicySpec), b.(*BindingPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingPolicyStatus)(nil), (*v1alpha1.BindingPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingPolicyStatus_To_v1alpha1_BindingPolicyStatus(a.(*BindingPolicyStatus), b.(*v1alpha1.BindingPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingPolicyStatus)(nil), (*BindingPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingPolicyStatus_To_v1beta1_BindingPolicyStatus(a.(*v1alpha1.BindingPolicyStatus), b.(*BindingPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingSpec)(nil), (*BindingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingSpec_To_v1beta1_BindingSpec(a.(*v1alpha1.BindingSpec), b.(*BindingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BindingStatus)(nil), (*v1alpha1.BindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingStatus_To_v1alpha1_BindingStatus(a.(*BindingStatus), b.(*v1alpha1.BindingStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BindingStatus)(nil), (*BindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BindingStatus_To_v1beta1_BindingStatus(a.(*v1alpha1.BindingStatus), b.(*BindingStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterScopeDownsyncClause)(nil), (*v1alpha1.ClusterScopeDownsyncClause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterScopeDownsyncClause_To_v1alpha1_ClusterScopeDownsyncClause(a.(*ClusterScopeDownsyncClause), b.(*v1alpha1.ClusterScopeDownsyncClause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ClusterScopeDownsyncClause)(nil), (*ClusterScopeDownsyncClause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterScopeDownsyncClause_To_v1beta1_ClusterScopeDownsyncClause(a.(*v1alpha1.ClusterScopeDownsyncClause), b.(*ClusterScopeDownsyncClause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterScopeDownsyncObject)(nil), (*v1alpha1.ClusterScopeDownsyncObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterScopeDownsyncObject_To_v1alpha1_ClusterScopeDownsyncObject(a.(*ClusterScopeDownsyncObject), b.(*v1alpha1.ClusterScopeDownsyncObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ClusterScopeDownsyncObject)(nil), (*ClusterScopeDownsyncObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterScopeDownsyncObject_To_v1beta1_ClusterScopeDownsyncObject(a.(*v1alpha1.ClusterScopeDownsyncObject), b.(*ClusterScopeDownsyncObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CombinedStatus)(nil), (*v1alpha1.CombinedStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CombinedStatus_To_v1alpha1_CombinedStatus(a.(*CombinedStatus), b.(*v1alpha1.CombinedStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CombinedStatus)(nil), (*CombinedStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CombinedStatus_To_v1beta1_CombinedStatus(a.(*v1alpha1.CombinedStatus), b.(*CombinedStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CombinedStatusList)(nil), (*v1alpha1.CombinedStatusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CombinedStatusList_To_v1alpha1_CombinedStatusList(a.(*CombinedStatusList), b.(*v1alpha1.CombinedStatusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CombinedStatusList)(nil), (*CombinedStatusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CombinedStatusList_To_v1beta1_CombinedStatusList(a.(*v1alpha1.CombinedStatusList), b.(*CombinedStatusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomTransform)(nil), (*v1alpha1.CustomTransform)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomTransform_To_v1alpha1_CustomTransform(a.(*CustomTransform), b.(*v1alpha1.CustomTransform), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CustomTransform)(nil), (*CustomTransform)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomTransform_To_v1beta1_CustomTransform(a.(*v1alpha1.CustomTransform), b.(*CustomTransform), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomTransformList)(nil), (*v1alpha1.CustomTransformList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomTransformList_To_v1alpha1_CustomTransformList(a.(*CustomTransformList), b.(*v1alpha1.CustomTransformList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CustomTransformList)(nil), (*CustomTransformList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomTransformList_To_v1beta1_CustomTransformList(a.(*v1alpha1.CustomTransformList), b.(*CustomTransformList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomTransformSpec)(nil), (*v1alpha1.CustomTransformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomTransformSpec_To_v1alpha1_CustomTransformSpec(a.(*CustomTransformSpec), b.(*v1alpha1.CustomTransformSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CustomTransformSpec)(nil), (*CustomTransformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomTransformSpec_To_v1beta1_CustomTransformSpec(a.(*v1alpha1.CustomTransformSpec), b.(*CustomTransformSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomTransformStatus)(nil), (*v1alpha1.CustomTransformStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomTransformStatus_To_v1alpha1_CustomTransformStatus(a.(*CustomTransformStatus), b.(*v1alpha1.CustomTransformStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CustomTransformStatus)(nil), (*CustomTransformStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomTransformStatus_To_v1beta1_CustomTransformStatus(a.(*v1alpha1.CustomTransformStatus), b.(*CustomTransformStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DownsyncObjectClauses)(nil), (*v1alpha1.DownsyncObjectClauses)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DownsyncObjectClauses_To_v1alpha1_DownsyncObjectClauses(a.(*DownsyncObjectClauses), b.(*v1alpha1.DownsyncObjectClauses), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DownsyncObjectClauses)(nil), (*DownsyncObjectClauses)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DownsyncObjectClauses_To_v1beta1_DownsyncObjectClauses(a.(*v1alpha1.DownsyncObjectClauses), b.(*DownsyncObjectClauses), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DownsyncObjectTest)(nil), (*v1alpha1.DownsyncObjectTest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DownsyncObjectTest_To_v1alpha1_DownsyncObjectTest(a.(*DownsyncObjectTest), b.(*v1alpha1.DownsyncObjectTest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DownsyncObjectTest)(nil), (*DownsyncObjectTest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DownsyncObjectTest_To_v1beta1_DownsyncObjectTest(a.(*v1alpha1.DownsyncObjectTest), b.(*DownsyncObjectTest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DownsyncPolicyClause)(nil), (*v1alpha1.DownsyncPolicyClause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DownsyncPolicyClause_To_v1alpha1_DownsyncPolicyClause(a.(*DownsyncPolicyClause), b.(*v1alpha1.DownsyncPolicyClause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DownsyncPolicyClause)(nil), (*DownsyncPolicyClause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DownsyncPolicyClause_To_v1beta1_DownsyncPolicyClause(a.(*v1alpha1.DownsyncPolicyClause), b.(*DownsyncPolicyClause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*v1alpha1.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*MaintenanceWindow), b.(*v1alpha1.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_v1beta1_MaintenanceWindow(a.(*v1alpha1.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedAggregator)(nil), (*v1alpha1.NamedAggregator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamedAggregator_To_v1alpha1_NamedAggregator(a.(*NamedAggregator), b.(*v1alpha1.NamedAggregator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NamedAggregator)(nil), (*NamedAggregator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedAggregator_To_v1beta1_NamedAggregator(a.(*v1alpha1.NamedAggregator), b.(*NamedAggregator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedExpression)(nil), (*v1alpha1.NamedExpression)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamedExpression_To_v1alpha1_NamedExpression(a.(*NamedExpression), b.(*v1alpha1.NamedExpression), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NamedExpression)(nil), (*NamedExpression)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedExpression_To_v1beta1_NamedExpression(a.(*v1alpha1.NamedExpression), b.(*NamedExpression), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedStatusCombination)(nil), (*v1alpha1.NamedStatusCombination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamedStatusCombination_To_v1alpha1_NamedStatusCombination(a.(*NamedStatusCombination), b.(*v1alpha1.NamedStatusCombination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NamedStatusCombination)(nil), (*NamedStatusCombination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedStatusCombination_To_v1beta1_NamedStatusCombination(a.(*v1alpha1.NamedStatusCombination), b.(*NamedStatusCombination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceScopeDownsyncClause)(nil), (*v1alpha1.NamespaceScopeDownsyncClause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespaceScopeDownsyncClause_To_v1alpha1_NamespaceScopeDownsyncClause(a.(*NamespaceScopeDownsyncClause), b.(*v1alpha1.NamespaceScopeDownsyncClause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NamespaceScopeDownsyncClause)(nil), (*NamespaceScopeDownsyncClause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceScopeDownsyncClause_To_v1beta1_NamespaceScopeDownsyncClause(a.(*v1alpha1.NamespaceScopeDownsyncClause), b.(*NamespaceScopeDownsyncClause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceScopeDownsyncObject)(nil), (*v1alpha1.NamespaceScopeDownsyncObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespaceScopeDownsyncObject_To_v1alpha1_NamespaceScopeDownsyncObject(a.(*NamespaceScopeDownsyncObject), b.(*v1alpha1.NamespaceScopeDownsyncObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NamespaceScopeDownsyncObject)(nil), (*NamespaceScopeDownsyncObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceScopeDownsyncObject_To_v1beta1_NamespaceScopeDownsyncObject(a.(*v1alpha1.NamespaceScopeDownsyncObject), b.(*NamespaceScopeDownsyncObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutHealthCheck)(nil), (*v1alpha1.RolloutHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutHealthCheck_To_v1alpha1_RolloutHealthCheck(a.(*RolloutHealthCheck), b.(*v1alpha1.RolloutHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutHealthCheck)(nil), (*RolloutHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutHealthCheck_To_v1beta1_RolloutHealthCheck(a.(*v1alpha1.RolloutHealthCheck), b.(*RolloutHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStrategy)(nil), (*v1alpha1.RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(a.(*RolloutStrategy), b.(*v1alpha1.RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutStrategy)(nil), (*RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(a.(*v1alpha1.RolloutStrategy), b.(*RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingPlugin)(nil), (*v1alpha1.SchedulingPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulingPlugin_To_v1alpha1_SchedulingPlugin(a.(*SchedulingPlugin), b.(*v1alpha1.SchedulingPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SchedulingPlugin)(nil), (*SchedulingPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingPlugin_To_v1beta1_SchedulingPlugin(a.(*v1alpha1.SchedulingPlugin), b.(*SchedulingPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingSpec)(nil), (*v1alpha1.SchedulingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulingSpec_To_v1alpha1_SchedulingSpec(a.(*SchedulingSpec), b.(*v1alpha1.SchedulingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SchedulingSpec)(nil), (*SchedulingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingSpec_To_v1beta1_SchedulingSpec(a.(*v1alpha1.SchedulingSpec), b.(*SchedulingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SpreadConstraint)(nil), (*v1alpha1.SpreadConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SpreadConstraint_To_v1alpha1_SpreadConstraint(a.(*SpreadConstraint), b.(*v1alpha1.SpreadConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SpreadConstraint)(nil), (*SpreadConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpreadConstraint_To_v1beta1_SpreadConstraint(a.(*v1alpha1.SpreadConstraint), b.(*SpreadConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatusCollector)(nil), (*v1alpha1.StatusCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StatusCollector_To_v1alpha1_StatusCollector(a.(*StatusCollector), b.(*v1alpha1.StatusCollector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StatusCollector)(nil), (*StatusCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatusCollector_To_v1beta1_StatusCollector(a.(*v1alpha1.StatusCollector), b.(*StatusCollector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatusCollectorList)(nil), (*v1alpha1.StatusCollectorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StatusCollectorList_To_v1alpha1_StatusCollectorList(a.(*StatusCollectorList), b.(*v1alpha1.StatusCollectorList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StatusCollectorList)(nil), (*StatusCollectorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatusCollectorList_To_v1beta1_StatusCollectorList(a.(*v1alpha1.StatusCollectorList), b.(*StatusCollectorList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatusCollectorSpec)(nil), (*v1alpha1.StatusCollectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StatusCollectorSpec_To_v1alpha1_StatusCollectorSpec(a.(*StatusCollectorSpec), b.(*v1alpha1.StatusCollectorSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StatusCollectorSpec)(nil), (*StatusCollectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatusCollectorSpec_To_v1beta1_StatusCollectorSpec(a.(*v1alpha1.StatusCollectorSpec), b.(*StatusCollectorSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatusCollectorStatus)(nil), (*v1alpha1.StatusCollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StatusCollectorStatus_To_v1alpha1_StatusCollectorStatus(a.(*StatusCollectorStatus), b.(*v1alpha1.StatusCollectorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StatusCollectorStatus)(nil), (*StatusCollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatusCollectorStatus_To_v1beta1_StatusCollectorStatus(a.(*v1alpha1.StatusCollectorStatus), b.(*StatusCollectorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatusCombinationRow)(nil), (*v1alpha1.StatusCombinationRow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StatusCombinationRow_To_v1alpha1_StatusCombinationRow(a.(*StatusCombinationRow), b.(*v1alpha1.StatusCombinationRow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StatusCombinationRow)(nil), (*StatusCombinationRow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatusCombinationRow_To_v1beta1_StatusCombinationRow(a.(*v1alpha1.StatusCombinationRow), b.(*StatusCombinationRow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Value)(nil), (*v1alpha1.Value)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Value_To_v1alpha1_Value(a.(*Value), b.(*v1alpha1.Value), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Value)(nil), (*Value)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Value_To_v1beta1_Value(a.(*v1alpha1.Value), b.(*Value), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.Destination)(nil), (*string)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Destination_To_string(a.(*v1alpha1.Destination), b.(*string), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.PendingDestination)(nil), (*PendingDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PendingDestination_To_v1beta1_PendingDestination(a.(*v1alpha1.PendingDestination), b.(*PendingDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*BindingSpec)(nil), (*v1alpha1.BindingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BindingSpec_To_v1alpha1_BindingSpec(a.(*BindingSpec), b.(*v1alpha1.BindingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*PendingDestination)(nil), (*v1alpha1.PendingDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PendingDestination_To_v1alpha1_PendingDestination(a.(*PendingDestination), b.(*v1alpha1.PendingDestination), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_Binding_To_v1alpha1_Binding(in *Binding, out *v1alpha1.Binding, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_BindingSpec_To_v1alpha1_BindingSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_BindingStatus_To_v1alpha1_BindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Binding_To_v1alpha1_Binding is an autogenerated conversion function.
+func Convert_v1beta1_Binding_To_v1alpha1_Binding(in *Binding, out *v1alpha1.Binding, s conversion.Scope) error {
+	return autoConvert_v1beta1_Binding_To_v1alpha1_Binding(in, out, s)
+}
+
+func autoConvert_v1alpha1_Binding_To_v1beta1_Binding(in *v1alpha1.Binding, out *Binding, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_BindingSpec_To_v1beta1_BindingSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_BindingStatus_To_v1beta1_BindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Binding_To_v1beta1_Binding is an autogenerated conversion function.
+func Convert_v1alpha1_Binding_To_v1beta1_Binding(in *v1alpha1.Binding, out *Binding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Binding_To_v1beta1_Binding(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingList_To_v1alpha1_BindingList(in *BindingList, out *v1alpha1.BindingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.Binding, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Binding_To_v1alpha1_Binding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_BindingList_To_v1alpha1_BindingList is an autogenerated conversion function.
+func Convert_v1beta1_BindingList_To_v1alpha1_BindingList(in *BindingList, out *v1alpha1.BindingList, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingList_To_v1alpha1_BindingList(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingList_To_v1beta1_BindingList(in *v1alpha1.BindingList, out *BindingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Binding, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Binding_To_v1beta1_Binding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BindingList_To_v1beta1_BindingList is an autogenerated conversion function.
+func Convert_v1alpha1_BindingList_To_v1beta1_BindingList(in *v1alpha1.BindingList, out *BindingList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingList_To_v1beta1_BindingList(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingPolicy_To_v1alpha1_BindingPolicy(in *BindingPolicy, out *v1alpha1.BindingPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_BindingPolicySpec_To_v1alpha1_BindingPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_BindingPolicyStatus_To_v1alpha1_BindingPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_BindingPolicy_To_v1alpha1_BindingPolicy is an autogenerated conversion function.
+func Convert_v1beta1_BindingPolicy_To_v1alpha1_BindingPolicy(in *BindingPolicy, out *v1alpha1.BindingPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingPolicy_To_v1alpha1_BindingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingPolicy_To_v1beta1_BindingPolicy(in *v1alpha1.BindingPolicy, out *BindingPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_BindingPolicySpec_To_v1beta1_BindingPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_BindingPolicyStatus_To_v1beta1_BindingPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BindingPolicy_To_v1beta1_BindingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_BindingPolicy_To_v1beta1_BindingPolicy(in *v1alpha1.BindingPolicy, out *BindingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingPolicy_To_v1beta1_BindingPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingPolicyCondition_To_v1alpha1_BindingPolicyCondition(in *BindingPolicyCondition, out *v1alpha1.BindingPolicyCondition, s conversion.Scope) error {
+	out.Type = v1alpha1.ConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = v1alpha1.ConditionReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_BindingPolicyCondition_To_v1alpha1_BindingPolicyCondition is an autogenerated conversion function.
+func Convert_v1beta1_BindingPolicyCondition_To_v1alpha1_BindingPolicyCondition(in *BindingPolicyCondition, out *v1alpha1.BindingPolicyCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingPolicyCondition_To_v1alpha1_BindingPolicyCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingPolicyCondition_To_v1beta1_BindingPolicyCondition(in *v1alpha1.BindingPolicyCondition, out *BindingPolicyCondition, s conversion.Scope) error {
+	out.Type = ConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = ConditionReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_BindingPolicyCondition_To_v1beta1_BindingPolicyCondition is an autogenerated conversion function.
+func Convert_v1alpha1_BindingPolicyCondition_To_v1beta1_BindingPolicyCondition(in *v1alpha1.BindingPolicyCondition, out *BindingPolicyCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingPolicyCondition_To_v1beta1_BindingPolicyCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingPolicyList_To_v1alpha1_BindingPolicyList(in *BindingPolicyList, out *v1alpha1.BindingPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.BindingPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_BindingPolicyList_To_v1alpha1_BindingPolicyList is an autogenerated conversion function.
+func Convert_v1beta1_BindingPolicyList_To_v1alpha1_BindingPolicyList(in *BindingPolicyList, out *v1alpha1.BindingPolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingPolicyList_To_v1alpha1_BindingPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingPolicyList_To_v1beta1_BindingPolicyList(in *v1alpha1.BindingPolicyList, out *BindingPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]BindingPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_BindingPolicyList_To_v1beta1_BindingPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_BindingPolicyList_To_v1beta1_BindingPolicyList(in *v1alpha1.BindingPolicyList, out *BindingPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingPolicyList_To_v1beta1_BindingPolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingPolicySpec_To_v1alpha1_BindingPolicySpec(in *BindingPolicySpec, out *v1alpha1.BindingPolicySpec, s conversion.Scope) error {
+	out.ClusterSelectors = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.ClusterSelectors))
+	out.Downsync = *(*[]v1alpha1.DownsyncPolicyClause)(unsafe.Pointer(&in.Downsync))
+	out.DownsyncExclusions = *(*[]v1alpha1.DownsyncObjectTest)(unsafe.Pointer(&in.DownsyncExclusions))
+	out.WantSingletonReportedState = in.WantSingletonReportedState
+	out.DeletionPolicy = v1alpha1.DeletionPolicy(in.DeletionPolicy)
+	out.Scheduling = (*v1alpha1.SchedulingSpec)(unsafe.Pointer(in.Scheduling))
+	out.SpreadConstraints = *(*[]v1alpha1.SpreadConstraint)(unsafe.Pointer(&in.SpreadConstraints))
+	out.Suspend = in.Suspend
+	out.RolloutStrategy = (*v1alpha1.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.MaintenanceWindow = (*v1alpha1.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1beta1_BindingPolicySpec_To_v1alpha1_BindingPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_BindingPolicySpec_To_v1alpha1_BindingPolicySpec(in *BindingPolicySpec, out *v1alpha1.BindingPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingPolicySpec_To_v1alpha1_BindingPolicySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingPolicySpec_To_v1beta1_BindingPolicySpec(in *v1alpha1.BindingPolicySpec, out *BindingPolicySpec, s conversion.Scope) error {
+	out.ClusterSelectors = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.ClusterSelectors))
+	out.Downsync = *(*[]DownsyncPolicyClause)(unsafe.Pointer(&in.Downsync))
+	out.DownsyncExclusions = *(*[]DownsyncObjectTest)(unsafe.Pointer(&in.DownsyncExclusions))
+	out.WantSingletonReportedState = in.WantSingletonReportedState
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.Scheduling = (*SchedulingSpec)(unsafe.Pointer(in.Scheduling))
+	out.SpreadConstraints = *(*[]SpreadConstraint)(unsafe.Pointer(&in.SpreadConstraints))
+	out.Suspend = in.Suspend
+	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1alpha1_BindingPolicySpec_To_v1beta1_BindingPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_BindingPolicySpec_To_v1beta1_BindingPolicySpec(in *v1alpha1.BindingPolicySpec, out *BindingPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingPolicySpec_To_v1beta1_BindingPolicySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingPolicyStatus_To_v1alpha1_BindingPolicyStatus(in *BindingPolicyStatus, out *v1alpha1.BindingPolicyStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1alpha1.BindingPolicyCondition)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	return nil
+}
+
+// Convert_v1beta1_BindingPolicyStatus_To_v1alpha1_BindingPolicyStatus is an autogenerated conversion function.
+func Convert_v1beta1_BindingPolicyStatus_To_v1alpha1_BindingPolicyStatus(in *BindingPolicyStatus, out *v1alpha1.BindingPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingPolicyStatus_To_v1alpha1_BindingPolicyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingPolicyStatus_To_v1beta1_BindingPolicyStatus(in *v1alpha1.BindingPolicyStatus, out *BindingPolicyStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]BindingPolicyCondition)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	return nil
+}
+
+// Convert_v1alpha1_BindingPolicyStatus_To_v1beta1_BindingPolicyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BindingPolicyStatus_To_v1beta1_BindingPolicyStatus(in *v1alpha1.BindingPolicyStatus, out *BindingPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingPolicyStatus_To_v1beta1_BindingPolicyStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingSpec_To_v1alpha1_BindingSpec(in *BindingSpec, out *v1alpha1.BindingSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_DownsyncObjectClauses_To_v1alpha1_DownsyncObjectClauses(&in.Workload, &out.Workload, s); err != nil {
+		return err
+	}
+	// INFO: in.Destinations opted out of conversion generation
+	out.Suspend = in.Suspend
+	out.RolloutStrategy = (*v1alpha1.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.MaintenanceWindow = (*v1alpha1.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	return nil
+}
+
+func autoConvert_v1alpha1_BindingSpec_To_v1beta1_BindingSpec(in *v1alpha1.BindingSpec, out *BindingSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_DownsyncObjectClauses_To_v1beta1_DownsyncObjectClauses(&in.Workload, &out.Workload, s); err != nil {
+		return err
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]string, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Destination_To_string(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
+	out.Suspend = in.Suspend
+	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	return nil
+}
+
+// Convert_v1alpha1_BindingSpec_To_v1beta1_BindingSpec is an autogenerated conversion function.
+func Convert_v1alpha1_BindingSpec_To_v1beta1_BindingSpec(in *v1alpha1.BindingSpec, out *BindingSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingSpec_To_v1beta1_BindingSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_BindingStatus_To_v1alpha1_BindingStatus(in *BindingStatus, out *v1alpha1.BindingStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	out.Conditions = *(*[]v1alpha1.BindingPolicyCondition)(unsafe.Pointer(&in.Conditions))
+	if in.PendingDestinations != nil {
+		in, out := &in.PendingDestinations, &out.PendingDestinations
+		*out = make([]v1alpha1.PendingDestination, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_PendingDestination_To_v1alpha1_PendingDestination(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PendingDestinations = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_BindingStatus_To_v1alpha1_BindingStatus is an autogenerated conversion function.
+func Convert_v1beta1_BindingStatus_To_v1alpha1_BindingStatus(in *BindingStatus, out *v1alpha1.BindingStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BindingStatus_To_v1alpha1_BindingStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BindingStatus_To_v1beta1_BindingStatus(in *v1alpha1.BindingStatus, out *BindingStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	out.Conditions = *(*[]BindingPolicyCondition)(unsafe.Pointer(&in.Conditions))
+	if in.PendingDestinations != nil {
+		in, out := &in.PendingDestinations, &out.PendingDestinations
+		*out = make([]PendingDestination, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_PendingDestination_To_v1beta1_PendingDestination(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PendingDestinations = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BindingStatus_To_v1beta1_BindingStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BindingStatus_To_v1beta1_BindingStatus(in *v1alpha1.BindingStatus, out *BindingStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BindingStatus_To_v1beta1_BindingStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterScopeDownsyncClause_To_v1alpha1_ClusterScopeDownsyncClause(in *ClusterScopeDownsyncClause, out *v1alpha1.ClusterScopeDownsyncClause, s conversion.Scope) error {
+	if err := Convert_v1beta1_ClusterScopeDownsyncObject_To_v1alpha1_ClusterScopeDownsyncObject(&in.ClusterScopeDownsyncObject, &out.ClusterScopeDownsyncObject, s); err != nil {
+		return err
+	}
+	out.CreateOnly = in.CreateOnly
+	out.DeletionPolicy = v1alpha1.DeletionPolicy(in.DeletionPolicy)
+	out.StatusCollectors = *(*[]string)(unsafe.Pointer(&in.StatusCollectors))
+	out.ExcludedDestinations = *(*[]string)(unsafe.Pointer(&in.ExcludedDestinations))
+	return nil
+}
+
+// Convert_v1beta1_ClusterScopeDownsyncClause_To_v1alpha1_ClusterScopeDownsyncClause is an autogenerated conversion function.
+func Convert_v1beta1_ClusterScopeDownsyncClause_To_v1alpha1_ClusterScopeDownsyncClause(in *ClusterScopeDownsyncClause, out *v1alpha1.ClusterScopeDownsyncClause, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterScopeDownsyncClause_To_v1alpha1_ClusterScopeDownsyncClause(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterScopeDownsyncClause_To_v1beta1_ClusterScopeDownsyncClause(in *v1alpha1.ClusterScopeDownsyncClause, out *ClusterScopeDownsyncClause, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ClusterScopeDownsyncObject_To_v1beta1_ClusterScopeDownsyncObject(&in.ClusterScopeDownsyncObject, &out.ClusterScopeDownsyncObject, s); err != nil {
+		return err
+	}
+	out.CreateOnly = in.CreateOnly
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.StatusCollectors = *(*[]string)(unsafe.Pointer(&in.StatusCollectors))
+	out.ExcludedDestinations = *(*[]string)(unsafe.Pointer(&in.ExcludedDestinations))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterScopeDownsyncClause_To_v1beta1_ClusterScopeDownsyncClause is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterScopeDownsyncClause_To_v1beta1_ClusterScopeDownsyncClause(in *v1alpha1.ClusterScopeDownsyncClause, out *ClusterScopeDownsyncClause, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterScopeDownsyncClause_To_v1beta1_ClusterScopeDownsyncClause(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterScopeDownsyncObject_To_v1alpha1_ClusterScopeDownsyncObject(in *ClusterScopeDownsyncObject, out *v1alpha1.ClusterScopeDownsyncObject, s conversion.Scope) error {
+	out.GroupVersionResource = in.GroupVersionResource
+	out.Name = in.Name
+	out.ResourceVersion = in.ResourceVersion
+	return nil
+}
+
+// Convert_v1beta1_ClusterScopeDownsyncObject_To_v1alpha1_ClusterScopeDownsyncObject is an autogenerated conversion function.
+func Convert_v1beta1_ClusterScopeDownsyncObject_To_v1alpha1_ClusterScopeDownsyncObject(in *ClusterScopeDownsyncObject, out *v1alpha1.ClusterScopeDownsyncObject, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterScopeDownsyncObject_To_v1alpha1_ClusterScopeDownsyncObject(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterScopeDownsyncObject_To_v1beta1_ClusterScopeDownsyncObject(in *v1alpha1.ClusterScopeDownsyncObject, out *ClusterScopeDownsyncObject, s conversion.Scope) error {
+	out.GroupVersionResource = in.GroupVersionResource
+	out.Name = in.Name
+	out.ResourceVersion = in.ResourceVersion
+	return nil
+}
+
+// Convert_v1alpha1_ClusterScopeDownsyncObject_To_v1beta1_ClusterScopeDownsyncObject is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterScopeDownsyncObject_To_v1beta1_ClusterScopeDownsyncObject(in *v1alpha1.ClusterScopeDownsyncObject, out *ClusterScopeDownsyncObject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterScopeDownsyncObject_To_v1beta1_ClusterScopeDownsyncObject(in, out, s)
+}
+
+func autoConvert_v1beta1_CombinedStatus_To_v1alpha1_CombinedStatus(in *CombinedStatus, out *v1alpha1.CombinedStatus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Results = *(*[]v1alpha1.NamedStatusCombination)(unsafe.Pointer(&in.Results))
+	return nil
+}
+
+// Convert_v1beta1_CombinedStatus_To_v1alpha1_CombinedStatus is an autogenerated conversion function.
+func Convert_v1beta1_CombinedStatus_To_v1alpha1_CombinedStatus(in *CombinedStatus, out *v1alpha1.CombinedStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CombinedStatus_To_v1alpha1_CombinedStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CombinedStatus_To_v1beta1_CombinedStatus(in *v1alpha1.CombinedStatus, out *CombinedStatus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Results = *(*[]NamedStatusCombination)(unsafe.Pointer(&in.Results))
+	return nil
+}
+
+// Convert_v1alpha1_CombinedStatus_To_v1beta1_CombinedStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CombinedStatus_To_v1beta1_CombinedStatus(in *v1alpha1.CombinedStatus, out *CombinedStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CombinedStatus_To_v1beta1_CombinedStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_CombinedStatusList_To_v1alpha1_CombinedStatusList(in *CombinedStatusList, out *v1alpha1.CombinedStatusList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.CombinedStatus)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_CombinedStatusList_To_v1alpha1_CombinedStatusList is an autogenerated conversion function.
+func Convert_v1beta1_CombinedStatusList_To_v1alpha1_CombinedStatusList(in *CombinedStatusList, out *v1alpha1.CombinedStatusList, s conversion.Scope) error {
+	return autoConvert_v1beta1_CombinedStatusList_To_v1alpha1_CombinedStatusList(in, out, s)
+}
+
+func autoConvert_v1alpha1_CombinedStatusList_To_v1beta1_CombinedStatusList(in *v1alpha1.CombinedStatusList, out *CombinedStatusList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]CombinedStatus)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_CombinedStatusList_To_v1beta1_CombinedStatusList is an autogenerated conversion function.
+func Convert_v1alpha1_CombinedStatusList_To_v1beta1_CombinedStatusList(in *v1alpha1.CombinedStatusList, out *CombinedStatusList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CombinedStatusList_To_v1beta1_CombinedStatusList(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomTransform_To_v1alpha1_CustomTransform(in *CustomTransform, out *v1alpha1.CustomTransform, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_CustomTransformSpec_To_v1alpha1_CustomTransformSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_CustomTransformStatus_To_v1alpha1_CustomTransformStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CustomTransform_To_v1alpha1_CustomTransform is an autogenerated conversion function.
+func Convert_v1beta1_CustomTransform_To_v1alpha1_CustomTransform(in *CustomTransform, out *v1alpha1.CustomTransform, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomTransform_To_v1alpha1_CustomTransform(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomTransform_To_v1beta1_CustomTransform(in *v1alpha1.CustomTransform, out *CustomTransform, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_CustomTransformSpec_To_v1beta1_CustomTransformSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CustomTransformStatus_To_v1beta1_CustomTransformStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CustomTransform_To_v1beta1_CustomTransform is an autogenerated conversion function.
+func Convert_v1alpha1_CustomTransform_To_v1beta1_CustomTransform(in *v1alpha1.CustomTransform, out *CustomTransform, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomTransform_To_v1beta1_CustomTransform(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomTransformList_To_v1alpha1_CustomTransformList(in *CustomTransformList, out *v1alpha1.CustomTransformList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.CustomTransform)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_CustomTransformList_To_v1alpha1_CustomTransformList is an autogenerated conversion function.
+func Convert_v1beta1_CustomTransformList_To_v1alpha1_CustomTransformList(in *CustomTransformList, out *v1alpha1.CustomTransformList, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomTransformList_To_v1alpha1_CustomTransformList(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomTransformList_To_v1beta1_CustomTransformList(in *v1alpha1.CustomTransformList, out *CustomTransformList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]CustomTransform)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_CustomTransformList_To_v1beta1_CustomTransformList is an autogenerated conversion function.
+func Convert_v1alpha1_CustomTransformList_To_v1beta1_CustomTransformList(in *v1alpha1.CustomTransformList, out *CustomTransformList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomTransformList_To_v1beta1_CustomTransformList(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomTransformSpec_To_v1alpha1_CustomTransformSpec(in *CustomTransformSpec, out *v1alpha1.CustomTransformSpec, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Resource = in.Resource
+	out.Remove = *(*[]string)(unsafe.Pointer(&in.Remove))
+	return nil
+}
+
+// Convert_v1beta1_CustomTransformSpec_To_v1alpha1_CustomTransformSpec is an autogenerated conversion function.
+func Convert_v1beta1_CustomTransformSpec_To_v1alpha1_CustomTransformSpec(in *CustomTransformSpec, out *v1alpha1.CustomTransformSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomTransformSpec_To_v1alpha1_CustomTransformSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomTransformSpec_To_v1beta1_CustomTransformSpec(in *v1alpha1.CustomTransformSpec, out *CustomTransformSpec, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Resource = in.Resource
+	out.Remove = *(*[]string)(unsafe.Pointer(&in.Remove))
+	return nil
+}
+
+// Convert_v1alpha1_CustomTransformSpec_To_v1beta1_CustomTransformSpec is an autogenerated conversion function.
+func Convert_v1alpha1_CustomTransformSpec_To_v1beta1_CustomTransformSpec(in *v1alpha1.CustomTransformSpec, out *CustomTransformSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomTransformSpec_To_v1beta1_CustomTransformSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomTransformStatus_To_v1alpha1_CustomTransformStatus(in *CustomTransformStatus, out *v1alpha1.CustomTransformStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v1beta1_CustomTransformStatus_To_v1alpha1_CustomTransformStatus is an autogenerated conversion function.
+func Convert_v1beta1_CustomTransformStatus_To_v1alpha1_CustomTransformStatus(in *CustomTransformStatus, out *v1alpha1.CustomTransformStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomTransformStatus_To_v1alpha1_CustomTransformStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomTransformStatus_To_v1beta1_CustomTransformStatus(in *v1alpha1.CustomTransformStatus, out *CustomTransformStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v1alpha1_CustomTransformStatus_To_v1beta1_CustomTransformStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CustomTransformStatus_To_v1beta1_CustomTransformStatus(in *v1alpha1.CustomTransformStatus, out *CustomTransformStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomTransformStatus_To_v1beta1_CustomTransformStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_DownsyncObjectClauses_To_v1alpha1_DownsyncObjectClauses(in *DownsyncObjectClauses, out *v1alpha1.DownsyncObjectClauses, s conversion.Scope) error {
+	out.ClusterScope = *(*[]v1alpha1.ClusterScopeDownsyncClause)(unsafe.Pointer(&in.ClusterScope))
+	out.NamespaceScope = *(*[]v1alpha1.NamespaceScopeDownsyncClause)(unsafe.Pointer(&in.NamespaceScope))
+	return nil
+}
+
+// Convert_v1beta1_DownsyncObjectClauses_To_v1alpha1_DownsyncObjectClauses is an autogenerated conversion function.
+func Convert_v1beta1_DownsyncObjectClauses_To_v1alpha1_DownsyncObjectClauses(in *DownsyncObjectClauses, out *v1alpha1.DownsyncObjectClauses, s conversion.Scope) error {
+	return autoConvert_v1beta1_DownsyncObjectClauses_To_v1alpha1_DownsyncObjectClauses(in, out, s)
+}
+
+func autoConvert_v1alpha1_DownsyncObjectClauses_To_v1beta1_DownsyncObjectClauses(in *v1alpha1.DownsyncObjectClauses, out *DownsyncObjectClauses, s conversion.Scope) error {
+	out.ClusterScope = *(*[]ClusterScopeDownsyncClause)(unsafe.Pointer(&in.ClusterScope))
+	out.NamespaceScope = *(*[]NamespaceScopeDownsyncClause)(unsafe.Pointer(&in.NamespaceScope))
+	return nil
+}
+
+// Convert_v1alpha1_DownsyncObjectClauses_To_v1beta1_DownsyncObjectClauses is an autogenerated conversion function.
+func Convert_v1alpha1_DownsyncObjectClauses_To_v1beta1_DownsyncObjectClauses(in *v1alpha1.DownsyncObjectClauses, out *DownsyncObjectClauses, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DownsyncObjectClauses_To_v1beta1_DownsyncObjectClauses(in, out, s)
+}
+
+func autoConvert_v1beta1_DownsyncObjectTest_To_v1alpha1_DownsyncObjectTest(in *DownsyncObjectTest, out *v1alpha1.DownsyncObjectTest, s conversion.Scope) error {
+	out.APIGroup = (*string)(unsafe.Pointer(in.APIGroup))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelectors = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.NamespaceSelectors))
+	out.ObjectSelectors = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.ObjectSelectors))
+	out.ObjectNames = *(*[]string)(unsafe.Pointer(&in.ObjectNames))
+	out.ObjectCELExpression = (*v1alpha1.Expression)(unsafe.Pointer(in.ObjectCELExpression))
+	return nil
+}
+
+// Convert_v1beta1_DownsyncObjectTest_To_v1alpha1_DownsyncObjectTest is an autogenerated conversion function.
+func Convert_v1beta1_DownsyncObjectTest_To_v1alpha1_DownsyncObjectTest(in *DownsyncObjectTest, out *v1alpha1.DownsyncObjectTest, s conversion.Scope) error {
+	return autoConvert_v1beta1_DownsyncObjectTest_To_v1alpha1_DownsyncObjectTest(in, out, s)
+}
+
+func autoConvert_v1alpha1_DownsyncObjectTest_To_v1beta1_DownsyncObjectTest(in *v1alpha1.DownsyncObjectTest, out *DownsyncObjectTest, s conversion.Scope) error {
+	out.APIGroup = (*string)(unsafe.Pointer(in.APIGroup))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelectors = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.NamespaceSelectors))
+	out.ObjectSelectors = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.ObjectSelectors))
+	out.ObjectNames = *(*[]string)(unsafe.Pointer(&in.ObjectNames))
+	out.ObjectCELExpression = (*Expression)(unsafe.Pointer(in.ObjectCELExpression))
+	return nil
+}
+
+// Convert_v1alpha1_DownsyncObjectTest_To_v1beta1_DownsyncObjectTest is an autogenerated conversion function.
+func Convert_v1alpha1_DownsyncObjectTest_To_v1beta1_DownsyncObjectTest(in *v1alpha1.DownsyncObjectTest, out *DownsyncObjectTest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DownsyncObjectTest_To_v1beta1_DownsyncObjectTest(in, out, s)
+}
+
+func autoConvert_v1beta1_DownsyncPolicyClause_To_v1alpha1_DownsyncPolicyClause(in *DownsyncPolicyClause, out *v1alpha1.DownsyncPolicyClause, s conversion.Scope) error {
+	if err := Convert_v1beta1_DownsyncObjectTest_To_v1alpha1_DownsyncObjectTest(&in.DownsyncObjectTest, &out.DownsyncObjectTest, s); err != nil {
+		return err
+	}
+	out.CreateOnly = in.CreateOnly
+	out.DeletionPolicy = v1alpha1.DeletionPolicy(in.DeletionPolicy)
+	out.StatusCollectors = *(*[]string)(unsafe.Pointer(&in.StatusCollectors))
+	out.WantDependencies = in.WantDependencies
+	return nil
+}
+
+// Convert_v1beta1_DownsyncPolicyClause_To_v1alpha1_DownsyncPolicyClause is an autogenerated conversion function.
+func Convert_v1beta1_DownsyncPolicyClause_To_v1alpha1_DownsyncPolicyClause(in *DownsyncPolicyClause, out *v1alpha1.DownsyncPolicyClause, s conversion.Scope) error {
+	return autoConvert_v1beta1_DownsyncPolicyClause_To_v1alpha1_DownsyncPolicyClause(in, out, s)
+}
+
+func autoConvert_v1alpha1_DownsyncPolicyClause_To_v1beta1_DownsyncPolicyClause(in *v1alpha1.DownsyncPolicyClause, out *DownsyncPolicyClause, s conversion.Scope) error {
+	if err := Convert_v1alpha1_DownsyncObjectTest_To_v1beta1_DownsyncObjectTest(&in.DownsyncObjectTest, &out.DownsyncObjectTest, s); err != nil {
+		return err
+	}
+	out.CreateOnly = in.CreateOnly
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.StatusCollectors = *(*[]string)(unsafe.Pointer(&in.StatusCollectors))
+	out.WantDependencies = in.WantDependencies
+	return nil
+}
+
+// Convert_v1alpha1_DownsyncPolicyClause_To_v1beta1_DownsyncPolicyClause is an autogenerated conversion function.
+func Convert_v1alpha1_DownsyncPolicyClause_To_v1beta1_DownsyncPolicyClause(in *v1alpha1.DownsyncPolicyClause, out *DownsyncPolicyClause, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DownsyncPolicyClause_To_v1beta1_DownsyncPolicyClause(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *MaintenanceWindow, out *v1alpha1.MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *MaintenanceWindow, out *v1alpha1.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in *v1alpha1.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_v1beta1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in *v1alpha1.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_NamedAggregator_To_v1alpha1_NamedAggregator(in *NamedAggregator, out *v1alpha1.NamedAggregator, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = v1alpha1.AggregatorType(in.Type)
+	out.Subject = (*v1alpha1.Expression)(unsafe.Pointer(in.Subject))
+	return nil
+}
+
+// Convert_v1beta1_NamedAggregator_To_v1alpha1_NamedAggregator is an autogenerated conversion function.
+func Convert_v1beta1_NamedAggregator_To_v1alpha1_NamedAggregator(in *NamedAggregator, out *v1alpha1.NamedAggregator, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamedAggregator_To_v1alpha1_NamedAggregator(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedAggregator_To_v1beta1_NamedAggregator(in *v1alpha1.NamedAggregator, out *NamedAggregator, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = AggregatorType(in.Type)
+	out.Subject = (*Expression)(unsafe.Pointer(in.Subject))
+	return nil
+}
+
+// Convert_v1alpha1_NamedAggregator_To_v1beta1_NamedAggregator is an autogenerated conversion function.
+func Convert_v1alpha1_NamedAggregator_To_v1beta1_NamedAggregator(in *v1alpha1.NamedAggregator, out *NamedAggregator, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedAggregator_To_v1beta1_NamedAggregator(in, out, s)
+}
+
+func autoConvert_v1beta1_NamedExpression_To_v1alpha1_NamedExpression(in *NamedExpression, out *v1alpha1.NamedExpression, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Def = v1alpha1.Expression(in.Def)
+	return nil
+}
+
+// Convert_v1beta1_NamedExpression_To_v1alpha1_NamedExpression is an autogenerated conversion function.
+func Convert_v1beta1_NamedExpression_To_v1alpha1_NamedExpression(in *NamedExpression, out *v1alpha1.NamedExpression, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamedExpression_To_v1alpha1_NamedExpression(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedExpression_To_v1beta1_NamedExpression(in *v1alpha1.NamedExpression, out *NamedExpression, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Def = Expression(in.Def)
+	return nil
+}
+
+// Convert_v1alpha1_NamedExpression_To_v1beta1_NamedExpression is an autogenerated conversion function.
+func Convert_v1alpha1_NamedExpression_To_v1beta1_NamedExpression(in *v1alpha1.NamedExpression, out *NamedExpression, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedExpression_To_v1beta1_NamedExpression(in, out, s)
+}
+
+func autoConvert_v1beta1_NamedStatusCombination_To_v1alpha1_NamedStatusCombination(in *NamedStatusCombination, out *v1alpha1.NamedStatusCombination, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ColumnNames = *(*[]string)(unsafe.Pointer(&in.ColumnNames))
+	out.Rows = *(*[]v1alpha1.StatusCombinationRow)(unsafe.Pointer(&in.Rows))
+	return nil
+}
+
+// Convert_v1beta1_NamedStatusCombination_To_v1alpha1_NamedStatusCombination is an autogenerated conversion function.
+func Convert_v1beta1_NamedStatusCombination_To_v1alpha1_NamedStatusCombination(in *NamedStatusCombination, out *v1alpha1.NamedStatusCombination, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamedStatusCombination_To_v1alpha1_NamedStatusCombination(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedStatusCombination_To_v1beta1_NamedStatusCombination(in *v1alpha1.NamedStatusCombination, out *NamedStatusCombination, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ColumnNames = *(*[]string)(unsafe.Pointer(&in.ColumnNames))
+	out.Rows = *(*[]StatusCombinationRow)(unsafe.Pointer(&in.Rows))
+	return nil
+}
+
+// Convert_v1alpha1_NamedStatusCombination_To_v1beta1_NamedStatusCombination is an autogenerated conversion function.
+func Convert_v1alpha1_NamedStatusCombination_To_v1beta1_NamedStatusCombination(in *v1alpha1.NamedStatusCombination, out *NamedStatusCombination, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedStatusCombination_To_v1beta1_NamedStatusCombination(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespaceScopeDownsyncClause_To_v1alpha1_NamespaceScopeDownsyncClause(in *NamespaceScopeDownsyncClause, out *v1alpha1.NamespaceScopeDownsyncClause, s conversion.Scope) error {
+	if err := Convert_v1beta1_NamespaceScopeDownsyncObject_To_v1alpha1_NamespaceScopeDownsyncObject(&in.NamespaceScopeDownsyncObject, &out.NamespaceScopeDownsyncObject, s); err != nil {
+		return err
+	}
+	out.CreateOnly = in.CreateOnly
+	out.DeletionPolicy = v1alpha1.DeletionPolicy(in.DeletionPolicy)
+	out.StatusCollectors = *(*[]string)(unsafe.Pointer(&in.StatusCollectors))
+	out.ExcludedDestinations = *(*[]string)(unsafe.Pointer(&in.ExcludedDestinations))
+	return nil
+}
+
+// Convert_v1beta1_NamespaceScopeDownsyncClause_To_v1alpha1_NamespaceScopeDownsyncClause is an autogenerated conversion function.
+func Convert_v1beta1_NamespaceScopeDownsyncClause_To_v1alpha1_NamespaceScopeDownsyncClause(in *NamespaceScopeDownsyncClause, out *v1alpha1.NamespaceScopeDownsyncClause, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespaceScopeDownsyncClause_To_v1alpha1_NamespaceScopeDownsyncClause(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceScopeDownsyncClause_To_v1beta1_NamespaceScopeDownsyncClause(in *v1alpha1.NamespaceScopeDownsyncClause, out *NamespaceScopeDownsyncClause, s conversion.Scope) error {
+	if err := Convert_v1alpha1_NamespaceScopeDownsyncObject_To_v1beta1_NamespaceScopeDownsyncObject(&in.NamespaceScopeDownsyncObject, &out.NamespaceScopeDownsyncObject, s); err != nil {
+		return err
+	}
+	out.CreateOnly = in.CreateOnly
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.StatusCollectors = *(*[]string)(unsafe.Pointer(&in.StatusCollectors))
+	out.ExcludedDestinations = *(*[]string)(unsafe.Pointer(&in.ExcludedDestinations))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceScopeDownsyncClause_To_v1beta1_NamespaceScopeDownsyncClause is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceScopeDownsyncClause_To_v1beta1_NamespaceScopeDownsyncClause(in *v1alpha1.NamespaceScopeDownsyncClause, out *NamespaceScopeDownsyncClause, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceScopeDownsyncClause_To_v1beta1_NamespaceScopeDownsyncClause(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespaceScopeDownsyncObject_To_v1alpha1_NamespaceScopeDownsyncObject(in *NamespaceScopeDownsyncObject, out *v1alpha1.NamespaceScopeDownsyncObject, s conversion.Scope) error {
+	out.GroupVersionResource = in.GroupVersionResource
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.ResourceVersion = in.ResourceVersion
+	return nil
+}
+
+// Convert_v1beta1_NamespaceScopeDownsyncObject_To_v1alpha1_NamespaceScopeDownsyncObject is an autogenerated conversion function.
+func Convert_v1beta1_NamespaceScopeDownsyncObject_To_v1alpha1_NamespaceScopeDownsyncObject(in *NamespaceScopeDownsyncObject, out *v1alpha1.NamespaceScopeDownsyncObject, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespaceScopeDownsyncObject_To_v1alpha1_NamespaceScopeDownsyncObject(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceScopeDownsyncObject_To_v1beta1_NamespaceScopeDownsyncObject(in *v1alpha1.NamespaceScopeDownsyncObject, out *NamespaceScopeDownsyncObject, s conversion.Scope) error {
+	out.GroupVersionResource = in.GroupVersionResource
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.ResourceVersion = in.ResourceVersion
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceScopeDownsyncObject_To_v1beta1_NamespaceScopeDownsyncObject is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceScopeDownsyncObject_To_v1beta1_NamespaceScopeDownsyncObject(in *v1alpha1.NamespaceScopeDownsyncObject, out *NamespaceScopeDownsyncObject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceScopeDownsyncObject_To_v1beta1_NamespaceScopeDownsyncObject(in, out, s)
+}
+
+func autoConvert_v1beta1_PendingDestination_To_v1alpha1_PendingDestination(in *PendingDestination, out *v1alpha1.PendingDestination, s conversion.Scope) error {
+	// WARNING: in.Cluster requires manual conversion: does not exist in peer-type
+	out.PendingUntil = in.PendingUntil
+	return nil
+}
+
+func autoConvert_v1alpha1_PendingDestination_To_v1beta1_PendingDestination(in *v1alpha1.PendingDestination, out *PendingDestination, s conversion.Scope) error {
+	// WARNING: in.ClusterId requires manual conversion: does not exist in peer-type
+	out.PendingUntil = in.PendingUntil
+	return nil
+}
+
+func autoConvert_v1beta1_RolloutHealthCheck_To_v1alpha1_RolloutHealthCheck(in *RolloutHealthCheck, out *v1alpha1.RolloutHealthCheck, s conversion.Scope) error {
+	out.StatusCollector = in.StatusCollector
+	out.ClusterColumn = in.ClusterColumn
+	out.Condition = v1alpha1.Expression(in.Condition)
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1beta1_RolloutHealthCheck_To_v1alpha1_RolloutHealthCheck is an autogenerated conversion function.
+func Convert_v1beta1_RolloutHealthCheck_To_v1alpha1_RolloutHealthCheck(in *RolloutHealthCheck, out *v1alpha1.RolloutHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutHealthCheck_To_v1alpha1_RolloutHealthCheck(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutHealthCheck_To_v1beta1_RolloutHealthCheck(in *v1alpha1.RolloutHealthCheck, out *RolloutHealthCheck, s conversion.Scope) error {
+	out.StatusCollector = in.StatusCollector
+	out.ClusterColumn = in.ClusterColumn
+	out.Condition = Expression(in.Condition)
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutHealthCheck_To_v1beta1_RolloutHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutHealthCheck_To_v1beta1_RolloutHealthCheck(in *v1alpha1.RolloutHealthCheck, out *RolloutHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutHealthCheck_To_v1beta1_RolloutHealthCheck(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *RolloutStrategy, out *v1alpha1.RolloutStrategy, s conversion.Scope) error {
+	out.BatchSize = in.BatchSize
+	out.BatchPercentage = in.BatchPercentage
+	out.Pause = (*metav1.Duration)(unsafe.Pointer(in.Pause))
+	out.HealthCheck = (*v1alpha1.RolloutHealthCheck)(unsafe.Pointer(in.HealthCheck))
+	return nil
+}
+
+// Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy is an autogenerated conversion function.
+func Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *RolloutStrategy, out *v1alpha1.RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(in *v1alpha1.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	out.BatchSize = in.BatchSize
+	out.BatchPercentage = in.BatchPercentage
+	out.Pause = (*metav1.Duration)(unsafe.Pointer(in.Pause))
+	out.HealthCheck = (*RolloutHealthCheck)(unsafe.Pointer(in.HealthCheck))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(in *v1alpha1.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulingPlugin_To_v1alpha1_SchedulingPlugin(in *SchedulingPlugin, out *v1alpha1.SchedulingPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	out.Args = *(*map[string]string)(unsafe.Pointer(&in.Args))
+	return nil
+}
+
+// Convert_v1beta1_SchedulingPlugin_To_v1alpha1_SchedulingPlugin is an autogenerated conversion function.
+func Convert_v1beta1_SchedulingPlugin_To_v1alpha1_SchedulingPlugin(in *SchedulingPlugin, out *v1alpha1.SchedulingPlugin, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulingPlugin_To_v1alpha1_SchedulingPlugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_SchedulingPlugin_To_v1beta1_SchedulingPlugin(in *v1alpha1.SchedulingPlugin, out *SchedulingPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	out.Args = *(*map[string]string)(unsafe.Pointer(&in.Args))
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingPlugin_To_v1beta1_SchedulingPlugin is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingPlugin_To_v1beta1_SchedulingPlugin(in *v1alpha1.SchedulingPlugin, out *SchedulingPlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingPlugin_To_v1beta1_SchedulingPlugin(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulingSpec_To_v1alpha1_SchedulingSpec(in *SchedulingSpec, out *v1alpha1.SchedulingSpec, s conversion.Scope) error {
+	out.Plugins = *(*[]v1alpha1.SchedulingPlugin)(unsafe.Pointer(&in.Plugins))
+	return nil
+}
+
+// Convert_v1beta1_SchedulingSpec_To_v1alpha1_SchedulingSpec is an autogenerated conversion function.
+func Convert_v1beta1_SchedulingSpec_To_v1alpha1_SchedulingSpec(in *SchedulingSpec, out *v1alpha1.SchedulingSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulingSpec_To_v1alpha1_SchedulingSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SchedulingSpec_To_v1beta1_SchedulingSpec(in *v1alpha1.SchedulingSpec, out *SchedulingSpec, s conversion.Scope) error {
+	out.Plugins = *(*[]SchedulingPlugin)(unsafe.Pointer(&in.Plugins))
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingSpec_To_v1beta1_SchedulingSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingSpec_To_v1beta1_SchedulingSpec(in *v1alpha1.SchedulingSpec, out *SchedulingSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingSpec_To_v1beta1_SchedulingSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SpreadConstraint_To_v1alpha1_SpreadConstraint(in *SpreadConstraint, out *v1alpha1.SpreadConstraint, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	out.PerGroupCount = in.PerGroupCount
+	out.MaxSkew = in.MaxSkew
+	return nil
+}
+
+// Convert_v1beta1_SpreadConstraint_To_v1alpha1_SpreadConstraint is an autogenerated conversion function.
+func Convert_v1beta1_SpreadConstraint_To_v1alpha1_SpreadConstraint(in *SpreadConstraint, out *v1alpha1.SpreadConstraint, s conversion.Scope) error {
+	return autoConvert_v1beta1_SpreadConstraint_To_v1alpha1_SpreadConstraint(in, out, s)
+}
+
+func autoConvert_v1alpha1_SpreadConstraint_To_v1beta1_SpreadConstraint(in *v1alpha1.SpreadConstraint, out *SpreadConstraint, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	out.PerGroupCount = in.PerGroupCount
+	out.MaxSkew = in.MaxSkew
+	return nil
+}
+
+// Convert_v1alpha1_SpreadConstraint_To_v1beta1_SpreadConstraint is an autogenerated conversion function.
+func Convert_v1alpha1_SpreadConstraint_To_v1beta1_SpreadConstraint(in *v1alpha1.SpreadConstraint, out *SpreadConstraint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpreadConstraint_To_v1beta1_SpreadConstraint(in, out, s)
+}
+
+func autoConvert_v1beta1_StatusCollector_To_v1alpha1_StatusCollector(in *StatusCollector, out *v1alpha1.StatusCollector, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_StatusCollectorSpec_To_v1alpha1_StatusCollectorSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_StatusCollectorStatus_To_v1alpha1_StatusCollectorStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_StatusCollector_To_v1alpha1_StatusCollector is an autogenerated conversion function.
+func Convert_v1beta1_StatusCollector_To_v1alpha1_StatusCollector(in *StatusCollector, out *v1alpha1.StatusCollector, s conversion.Scope) error {
+	return autoConvert_v1beta1_StatusCollector_To_v1alpha1_StatusCollector(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatusCollector_To_v1beta1_StatusCollector(in *v1alpha1.StatusCollector, out *StatusCollector, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_StatusCollectorSpec_To_v1beta1_StatusCollectorSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_StatusCollectorStatus_To_v1beta1_StatusCollectorStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_StatusCollector_To_v1beta1_StatusCollector is an autogenerated conversion function.
+func Convert_v1alpha1_StatusCollector_To_v1beta1_StatusCollector(in *v1alpha1.StatusCollector, out *StatusCollector, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatusCollector_To_v1beta1_StatusCollector(in, out, s)
+}
+
+func autoConvert_v1beta1_StatusCollectorList_To_v1alpha1_StatusCollectorList(in *StatusCollectorList, out *v1alpha1.StatusCollectorList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.StatusCollector)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_StatusCollectorList_To_v1alpha1_StatusCollectorList is an autogenerated conversion function.
+func Convert_v1beta1_StatusCollectorList_To_v1alpha1_StatusCollectorList(in *StatusCollectorList, out *v1alpha1.StatusCollectorList, s conversion.Scope) error {
+	return autoConvert_v1beta1_StatusCollectorList_To_v1alpha1_StatusCollectorList(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatusCollectorList_To_v1beta1_StatusCollectorList(in *v1alpha1.StatusCollectorList, out *StatusCollectorList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]StatusCollector)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_StatusCollectorList_To_v1beta1_StatusCollectorList is an autogenerated conversion function.
+func Convert_v1alpha1_StatusCollectorList_To_v1beta1_StatusCollectorList(in *v1alpha1.StatusCollectorList, out *StatusCollectorList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatusCollectorList_To_v1beta1_StatusCollectorList(in, out, s)
+}
+
+func autoConvert_v1beta1_StatusCollectorSpec_To_v1alpha1_StatusCollectorSpec(in *StatusCollectorSpec, out *v1alpha1.StatusCollectorSpec, s conversion.Scope) error {
+	out.Filter = (*v1alpha1.Expression)(unsafe.Pointer(in.Filter))
+	out.GroupBy = *(*[]v1alpha1.NamedExpression)(unsafe.Pointer(&in.GroupBy))
+	out.CombinedFields = *(*[]v1alpha1.NamedAggregator)(unsafe.Pointer(&in.CombinedFields))
+	out.Select = *(*[]v1alpha1.NamedExpression)(unsafe.Pointer(&in.Select))
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_v1beta1_StatusCollectorSpec_To_v1alpha1_StatusCollectorSpec is an autogenerated conversion function.
+func Convert_v1beta1_StatusCollectorSpec_To_v1alpha1_StatusCollectorSpec(in *StatusCollectorSpec, out *v1alpha1.StatusCollectorSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_StatusCollectorSpec_To_v1alpha1_StatusCollectorSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatusCollectorSpec_To_v1beta1_StatusCollectorSpec(in *v1alpha1.StatusCollectorSpec, out *StatusCollectorSpec, s conversion.Scope) error {
+	out.Filter = (*Expression)(unsafe.Pointer(in.Filter))
+	out.GroupBy = *(*[]NamedExpression)(unsafe.Pointer(&in.GroupBy))
+	out.CombinedFields = *(*[]NamedAggregator)(unsafe.Pointer(&in.CombinedFields))
+	out.Select = *(*[]NamedExpression)(unsafe.Pointer(&in.Select))
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_v1alpha1_StatusCollectorSpec_To_v1beta1_StatusCollectorSpec is an autogenerated conversion function.
+func Convert_v1alpha1_StatusCollectorSpec_To_v1beta1_StatusCollectorSpec(in *v1alpha1.StatusCollectorSpec, out *StatusCollectorSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatusCollectorSpec_To_v1beta1_StatusCollectorSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_StatusCollectorStatus_To_v1alpha1_StatusCollectorStatus(in *StatusCollectorStatus, out *v1alpha1.StatusCollectorStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	return nil
+}
+
+// Convert_v1beta1_StatusCollectorStatus_To_v1alpha1_StatusCollectorStatus is an autogenerated conversion function.
+func Convert_v1beta1_StatusCollectorStatus_To_v1alpha1_StatusCollectorStatus(in *StatusCollectorStatus, out *v1alpha1.StatusCollectorStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_StatusCollectorStatus_To_v1alpha1_StatusCollectorStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatusCollectorStatus_To_v1beta1_StatusCollectorStatus(in *v1alpha1.StatusCollectorStatus, out *StatusCollectorStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Errors = *(*[]string)(unsafe.Pointer(&in.Errors))
+	return nil
+}
+
+// Convert_v1alpha1_StatusCollectorStatus_To_v1beta1_StatusCollectorStatus is an autogenerated conversion function.
+func Convert_v1alpha1_StatusCollectorStatus_To_v1beta1_StatusCollectorStatus(in *v1alpha1.StatusCollectorStatus, out *StatusCollectorStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatusCollectorStatus_To_v1beta1_StatusCollectorStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_StatusCombinationRow_To_v1alpha1_StatusCombinationRow(in *StatusCombinationRow, out *v1alpha1.StatusCombinationRow, s conversion.Scope) error {
+	out.Columns = *(*[]v1alpha1.Value)(unsafe.Pointer(&in.Columns))
+	return nil
+}
+
+// Convert_v1beta1_StatusCombinationRow_To_v1alpha1_StatusCombinationRow is an autogenerated conversion function.
+func Convert_v1beta1_StatusCombinationRow_To_v1alpha1_StatusCombinationRow(in *StatusCombinationRow, out *v1alpha1.StatusCombinationRow, s conversion.Scope) error {
+	return autoConvert_v1beta1_StatusCombinationRow_To_v1alpha1_StatusCombinationRow(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatusCombinationRow_To_v1beta1_StatusCombinationRow(in *v1alpha1.StatusCombinationRow, out *StatusCombinationRow, s conversion.Scope) error {
+	out.Columns = *(*[]Value)(unsafe.Pointer(&in.Columns))
+	return nil
+}
+
+// Convert_v1alpha1_StatusCombinationRow_To_v1beta1_StatusCombinationRow is an autogenerated conversion function.
+func Convert_v1alpha1_StatusCombinationRow_To_v1beta1_StatusCombinationRow(in *v1alpha1.StatusCombinationRow, out *StatusCombinationRow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatusCombinationRow_To_v1beta1_StatusCombinationRow(in, out, s)
+}
+
+func autoConvert_v1beta1_Value_To_v1alpha1_Value(in *Value, out *v1alpha1.Value, s conversion.Scope) error {
+	out.Type = v1alpha1.ValueType(in.Type)
+	out.String = (*string)(unsafe.Pointer(in.String))
+	out.Number = (*string)(unsafe.Pointer(in.Number))
+	out.Bool = (*bool)(unsafe.Pointer(in.Bool))
+	out.Object = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Object))
+	out.Array = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Array))
+	return nil
+}
+
+// Convert_v1beta1_Value_To_v1alpha1_Value is an autogenerated conversion function.
+func Convert_v1beta1_Value_To_v1alpha1_Value(in *Value, out *v1alpha1.Value, s conversion.Scope) error {
+	return autoConvert_v1beta1_Value_To_v1alpha1_Value(in, out, s)
+}
+
+func autoConvert_v1alpha1_Value_To_v1beta1_Value(in *v1alpha1.Value, out *Value, s conversion.Scope) error {
+	out.Type = ValueType(in.Type)
+	out.String = (*string)(unsafe.Pointer(in.String))
+	out.Number = (*string)(unsafe.Pointer(in.Number))
+	out.Bool = (*bool)(unsafe.Pointer(in.Bool))
+	out.Object = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Object))
+	out.Array = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Array))
+	return nil
+}
+
+// Convert_v1alpha1_Value_To_v1beta1_Value is an autogenerated conversion function.
+func Convert_v1alpha1_Value_To_v1beta1_Value(in *v1alpha1.Value, out *Value, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Value_To_v1beta1_Value(in, out, s)
+}
@@ -26,24 +26,34 @@ import (
 	"os"
 	"strings"
 
+	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	crwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	kfv1aplha1 "github.com/kubestellar/kubeflex/api/v1alpha1"
+
 	v1alpha1 "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
 	clientopts "github.com/kubestellar/kubestellar/options"
 	"github.com/kubestellar/kubestellar/pkg/binding"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
 	"github.com/kubestellar/kubestellar/pkg/status"
 	"github.com/kubestellar/kubestellar/pkg/util"
+	kswebhook "github.com/kubestellar/kubestellar/pkg/webhook"
 )
 
 var (
@@ -59,31 +69,79 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+	utilruntime.Must(kfv1aplha1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
 func main() {
 	var metricsAddr, pprofAddr, probeAddr string
 	var enableLeaderElection bool
-	var itsName string
+	var enableWebhooks bool
+	var conversionWebhookURL, conversionWebhookCAFile string
+	var itsNames []string
 	var wdsName string
+	var multiWDS bool
 	var allowedGroupsString string
+	var resourcesInclude, resourcesExclude []string
+	var resourcesExcludeDefaults bool
 	var controllers []string
+	var fullObjectCacheSize int
+	var enableSharding bool
+	var shardGroup, shardIdentity, shardLeaseNamespace string
 	pflag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The [host]:port from which /metrics is served.")
 	pflag.StringVar(&pprofAddr, "pprof-bind-address", ":8082", "The [host]:port fron which /debug/pprof is served.")
 	pflag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
-	pflag.StringVar(&itsName, "its-name", "", "name of the Inventory and Transport Space to connect to (empty string means to use the only one)")
+	pflag.StringSliceVar(&itsNames, "its-name", []string{}, "name of the Inventory and Transport Space to connect to (empty string means to use the only one). "+
+		"Several names, comma separated, mean that the inventory is sharded among those ITSes.")
 	pflag.StringVar(&wdsName, "wds-name", "", "name of the workload description space to connect to")
+	pflag.BoolVar(&multiWDS, "multi-wds", false, "serve every WDS, that is, every KubeFlex ControlPlane labeled kflex.kubestellar.io/cptype=wds, "+
+		"starting and stopping controllers as WDSes come and go. Metrics are labeled by \"wds\". Incompatible with --wds-name and the WDS kubeconfig flags.")
 	pflag.StringVar(&allowedGroupsString, "api-groups", "", "list of allowed api groups, comma separated. Empty string means all API groups are allowed")
+	pflag.StringSliceVar(&resourcesInclude, "resources-include", []string{}, "list of patterns, of the form <group>/<resource>, of the resources to watch. "+
+		"Both parts use the syntax of Go's path.Match and the core group is written as 'core', e.g. 'apps/deployments,core/*,*.example.com/*'. "+
+		"Empty list means all resources (of the allowed api groups) are watched. "+
+		"The resources that the controllers rely on, such as 'apiextensions.k8s.io/customresourcedefinitions', are watched regardless")
+	pflag.StringSliceVar(&resourcesExclude, "resources-exclude", []string{}, "list of patterns, of the same form as for resources-include, "+
+		"of the resources not to watch, in addition to the defaults (see resources-exclude-defaults)")
+	pflag.BoolVar(&resourcesExcludeDefaults, "resources-exclude-defaults", true, "exclude also the resources that should not be delivered "+
+		"to other clusters: "+strings.Join(util.DefaultExcludedResources, ","))
 	pflag.StringSliceVar(&controllers, "controllers", []string{}, "list of controllers to be started by the controller manager, lower case and comma separated, e.g. 'binding,status'. If not specified (or emtpy list specifed), all controllers are started. Currently available controllers are 'binding' and 'status'.")
+	pflag.IntVar(&fullObjectCacheSize, "full-object-cache-size", util.DefaultFullObjectCacheSize,
+		"number of whole workload objects cached per WDS, shared by its binding and status controllers. The informers hold only "+
+			"the metadata of workload objects, and the content is fetched when a CEL expression, a StatusCollector or a singleton "+
+			"status needs it. A larger cache saves fetches of unchanged objects at the cost of holding that many objects in memory.")
 	pflag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	pflag.BoolVar(&enableSharding, "sharding", false,
+		"Divide the BindingPolicies, with their Bindings and statuses, among all the replicas in the same shard group, "+
+			"coordinated through Leases in the hosting cluster. Every replica is active. Incompatible with --leader-elect. "+
+			"BindingPolicies are compared for overlaps only with the others of the same replica, "+
+			"so their priorities are ignored (and reported as errors) and their ConflictFree conditions may miss conflicts. "+
+			"This divides the resolving and the writing, not the watching: every replica still informs on, enqueues and "+
+			"evaluates every workload object and WorkStatus, so the memory and CPU of each replica do not shrink as replicas are added.")
+	pflag.StringVar(&shardGroup, "shard-group", "kubestellar-controller-manager",
+		"name of the group of replicas that share the work when sharding; replicas serving different WDSes need different groups")
+	pflag.StringVar(&shardIdentity, "shard-identity", "", "identity of this replica in its shard group (empty string means to use the hostname)")
+	pflag.StringVar(&shardLeaseNamespace, "shard-lease-namespace", "",
+		"namespace, in the hosting cluster, of the Leases of the shard group (empty string means the namespace of this pod)")
+	pflag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the validating admission webhooks for BindingPolicy, StatusCollector and CustomTransform. "+
+			"This requires a serving certificate, and a ValidatingWebhookConfiguration in the WDS (see config/webhook). "+
+			"The conversion webhook among the versions of the KubeStellar kinds is served too.")
+	pflag.StringVar(&conversionWebhookURL, "conversion-webhook-url", "",
+		"URL at which the WDS apiserver reaches the conversion webhook of this controller-manager, "+
+			"e.g. https://kubestellar-controller-manager.wds1-system.svc:9443/convert. "+
+			"When empty, only the storage version (v1alpha1) of the KubeStellar kinds is served. Requires --enable-webhooks.")
+	pflag.StringVar(&conversionWebhookCAFile, "conversion-webhook-ca-file", "",
+		"file holding the PEM-encoded CA bundle that the WDS apiserver uses to verify the serving certificate of the conversion webhook")
 
-	itsClientLimits := clientopts.NewClientLimits[*pflag.FlagSet]("its", "accessing the ITS")
-	wdsClientLimits := clientopts.NewClientLimits[*pflag.FlagSet]("wds", "accessing the WDS")
-	itsClientLimits.AddFlags(pflag.CommandLine)
-	wdsClientLimits.AddFlags(pflag.CommandLine)
+	// When given a kubeconfig, these are used in preference to finding the KubeFlex ControlPlanes.
+	itsClientOpts := clientopts.NewClientOptions[*pflag.FlagSet]("its", "accessing the ITS")
+	wdsClientOpts := clientopts.NewClientOptions[*pflag.FlagSet]("wds", "accessing the WDS")
+	itsClientOpts.AddFlags(pflag.CommandLine)
+	wdsClientOpts.AddFlags(pflag.CommandLine)
 	klog.InitFlags(nil)
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	pflag.Parse()
@@ -96,8 +154,50 @@ func main() {
 		setupLog.Info("Command line flag", "name", flg.Name, "value", flg.Value)
 	})
 
+	if multiWDS && (wdsName != "" || wdsClientOpts.IsSpecified()) {
+		setupLog.Error(fmt.Errorf("both a WDS and all WDSes requested"), "'multi-wds' is incompatible with 'wds-name' and the WDS kubeconfig flags")
+		os.Exit(1)
+	}
+	if fullObjectCacheSize < 1 {
+		setupLog.Error(fmt.Errorf("full object cache size is %d", fullObjectCacheSize), "'full-object-cache-size' must be positive")
+		os.Exit(1)
+	}
+	if enableSharding && enableLeaderElection {
+		setupLog.Error(fmt.Errorf("both sharding and leader election requested"), "'sharding' is incompatible with 'leader-elect'")
+		os.Exit(1)
+	}
+	if itsClientOpts.IsSpecified() && len(itsNames) > 1 {
+		setupLog.Error(fmt.Errorf("one ITS kubeconfig for several ITSes"), "the ITS kubeconfig flags are incompatible with several values of 'its-name'")
+		os.Exit(1)
+	}
+
+	var conversion *apiextensionsv1.WebhookClientConfig
+	if conversionWebhookURL != "" {
+		if !enableWebhooks {
+			setupLog.Error(fmt.Errorf("conversion webhook is not served"), "'conversion-webhook-url' requires 'enable-webhooks'")
+			os.Exit(1)
+		}
+		conversion = &apiextensionsv1.WebhookClientConfig{URL: &conversionWebhookURL}
+		if conversionWebhookCAFile != "" {
+			caBundle, err := os.ReadFile(conversionWebhookCAFile)
+			if err != nil {
+				setupLog.Error(err, "unable to read the CA bundle of the conversion webhook", "file", conversionWebhookCAFile)
+				os.Exit(1)
+			}
+			conversion.CABundle = caBundle
+		}
+	}
+
 	// parse allowed resources string
 	allowedGroupsSet := util.ParseAPIGroupsString(allowedGroupsString)
+	if resourcesExcludeDefaults {
+		resourcesExclude = append(resourcesExclude, util.DefaultExcludedResources...)
+	}
+	resourceFilter, err := util.NewResourceFilter(resourcesInclude, resourcesExclude)
+	if err != nil {
+		setupLog.Error(err, "'resources-include' or 'resources-exclude' flag has incorrect value")
+		os.Exit(1)
+	}
 
 	// check controllers flag
 	ctlrsToStart := sets.New(controllers...)
@@ -143,75 +243,170 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err := kswebhook.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up webhooks")
+			os.Exit(1)
+		}
+	}
 
-	// get the config for WDS
-	setupLog.Info("Getting config for WDS", "name", wdsName)
-	wdsRestConfig, wdsName, err := util.GetWDSKubeconfig(setupLog, wdsName)
-	if err != nil {
-		setupLog.Error(err, "unable to get WDS kubeconfig")
-		os.Exit(1)
+	signalCtx := ctrl.SetupSignalHandler()
+
+	// join the group of replicas that share the BindingPolicies
+	var sharder sharding.Sharder = sharding.Everything
+	var leaseSharder *sharding.LeaseSharder
+	if enableSharding {
+		leaseSharder, err = newLeaseSharder(signalCtx, setupLog, shardGroup, shardIdentity, shardLeaseNamespace)
+		if err != nil {
+			setupLog.Error(err, "unable to join the shard group", "group", shardGroup)
+			os.Exit(1)
+		}
+		sharder = leaseSharder
 	}
-	setupLog.Info("Got config for WDS", "name", wdsName)
-	wdsRestConfig = wdsClientLimits.LimitConfig(wdsRestConfig)
 
-	// get the config for ITS
-	setupLog.Info("Getting config for ITS")
-	itsRestConfig, itsName, err := util.GetITSKubeconfig(setupLog, itsName)
+	// get the configs for the ITSes, one per shard of the inventory
+	setupLog.Info("Getting config for ITS", "names", itsNames)
+	var itsRestConfigs []*rest.Config
+	if itsClientOpts.IsSpecified() {
+		var itsName string
+		if len(itsNames) == 1 {
+			itsName = itsNames[0]
+		}
+		var itsRestConfig *rest.Config
+		itsRestConfig, itsName, err = getExplicitRestConfig(ctx, setupLog.WithValues("space", "its"), itsClientOpts, itsName)
+		itsRestConfigs, itsNames = []*rest.Config{itsRestConfig}, []string{itsName}
+	} else {
+		itsRestConfigs, itsNames, err = util.GetITSKubeconfigs(setupLog, itsNames)
+	}
 	if err != nil {
 		setupLog.Error(err, "unable to get ITS kubeconfig")
 		os.Exit(1)
 	}
-	setupLog.Info("Got config for ITS", "name", itsName)
-	itsRestConfig = itsClientLimits.LimitConfig(itsRestConfig)
-
-	// start the binding controller
-	bindingController, err := binding.NewController(mgr.GetLogger(), wdsRestConfig, itsRestConfig, wdsName, allowedGroupsSet)
-	if err != nil {
-		setupLog.Error(err, "unable to create binding controller")
-		os.Exit(1)
+	setupLog.Info("Got config for ITS", "names", itsNames)
+	workStatusPresent := true
+	for idx, itsRestConfig := range itsRestConfigs {
+		itsRestConfigs[idx] = itsClientOpts.LimitConfig(itsRestConfig)
+		// status is collected only if every shard has the status add-on
+		workStatusPresent = workStatusPresent && util.CheckWorkStatusPresence(itsRestConfigs[idx])
 	}
 
-	if err := bindingController.EnsureCRDs(ctx); err != nil {
-		setupLog.Error(err, "error installing the CRDs")
+	// the informers on the ITSes are shared by the controllers of all the WDSes served here
+	itsInformers, err := binding.NewITSInformers(itsRestConfigs...)
+	if err != nil {
+		setupLog.Error(err, "unable to create ITS informers")
 		os.Exit(1)
 	}
+	itsInformers.Start(ctx.Done())
 
-	if err := bindingController.AppendKSResources(ctx); err != nil {
-		setupLog.Error(err, "error appending KubeStellar resources to discovered lists")
-		os.Exit(1)
+	starter := &wdsStarter{
+		logger:              mgr.GetLogger(),
+		itsRestConfigs:      itsRestConfigs,
+		itsInformers:        itsInformers,
+		workStatusPresent:   workStatusPresent,
+		allowedGroupsSet:    allowedGroupsSet,
+		resourceFilter:      resourceFilter,
+		sharder:             sharder,
+		ctlrsToStart:        ctlrsToStart,
+		conversion:          conversion,
+		limitWDSRestConfig:  wdsClientOpts.LimitConfig,
+		fullObjectCacheSize: fullObjectCacheSize,
 	}
 
-	cListers := make(chan interface{}, 1)
-
-	if len(ctlrsToStart) == 0 || ctlrsToStart.Has(strings.ToLower(binding.ControllerName)) {
-		setupLog.Info("Starting controller", "name", binding.ControllerName)
-		if err := bindingController.Start(ctx, workers, cListers); err != nil {
-			setupLog.Error(err, "error starting the binding controller")
+	if multiWDS {
+		// start and stop the controllers of each WDS as its ControlPlane comes and goes
+		hostingClient, err := kubernetes.NewForConfig(ctrl.GetConfigOrDie())
+		if err != nil {
+			setupLog.Error(err, "unable to create clientset for the hosting cluster")
 			os.Exit(1)
 		}
-	}
-
-	// check if status add-on present before starting the status controller
-	if util.CheckWorkStatusPresence(itsRestConfig) &&
-		(len(ctlrsToStart) == 0 || ctlrsToStart.Has(strings.ToLower(status.ControllerName))) {
-		setupLog.Info("Starting controller", "name", status.ControllerName)
-		statusController, err := status.NewController(wdsRestConfig, itsRestConfig, wdsName,
-			bindingController.GetBindingPolicyResolutionBroker())
+		if err := newWDSReconciler(mgr, hostingClient, starter, ctx, metrics.Registry).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up the watch on WDSes")
+			os.Exit(1)
+		}
+	} else {
+		// get the config for WDS
+		setupLog.Info("Getting config for WDS", "name", wdsName)
+		var wdsRestConfig *rest.Config
+		if wdsClientOpts.IsSpecified() {
+			wdsRestConfig, wdsName, err = getExplicitRestConfig(ctx, setupLog.WithValues("space", "wds"), wdsClientOpts, wdsName)
+		} else {
+			wdsRestConfig, wdsName, err = util.GetWDSKubeconfig(setupLog, wdsName)
+		}
 		if err != nil {
-			setupLog.Error(err, "unable to create status controller")
+			setupLog.Error(err, "unable to get WDS kubeconfig")
 			os.Exit(1)
 		}
+		setupLog.Info("Got config for WDS", "name", wdsName)
 
-		if err := statusController.Start(ctx, workers, cListers); err != nil {
-			setupLog.Error(err, "error starting the status controller")
+		if err := starter.start(ctx, wdsName, wdsRestConfig, metrics.Registry); err != nil {
+			setupLog.Error(err, "unable to start the controllers", "wds", wdsName)
 			os.Exit(1)
 		}
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(signalCtx)
+	if leaseSharder != nil {
+		leaseSharder.Leave()
+	}
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 	select {}
 }
+
+// getExplicitRestConfig gets the rest config from the kubeconfig given by the flags,
+// rather than from a KubeFlex ControlPlane. If the given space name is empty then
+// the name of the kubeconfig context is used instead.
+// The returned config follows changes to the credentials in the kubeconfig.
+func getExplicitRestConfig(ctx context.Context, logger logr.Logger, opts *clientopts.ClientOptions[*pflag.FlagSet], spaceName string) (*rest.Config, string, error) {
+	initial, err := opts.ToRESTConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	reloader, err := util.NewCredentialsReloader(logger, initial)
+	if err != nil {
+		return nil, "", err
+	}
+	util.PollCredentials(ctx, opts.ToRESTConfig, reloader)
+	restConfig := reloader.RestConfig()
+	if spaceName == "" {
+		spaceName, err = opts.ContextName()
+		if err != nil {
+			return nil, "", err
+		}
+		if spaceName == "" {
+			return nil, "", fmt.Errorf("the kubeconfig has no current context to name the space after")
+		}
+	}
+	return restConfig, spaceName, nil
+}
+
+// newLeaseSharder joins this replica to the given shard group, whose Leases are in the hosting cluster.
+// Empty identity means to use the hostname, and empty namespace means the namespace of this pod.
+func newLeaseSharder(ctx context.Context, logger logr.Logger, group, identity, namespace string) (*sharding.LeaseSharder, error) {
+	if identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the hostname: %w", err)
+		}
+		identity = hostname
+	}
+	if namespace == "" {
+		podNamespace, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace")
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine the namespace of this pod, specify --shard-lease-namespace: %w", err)
+		}
+		namespace = strings.TrimSpace(string(podNamespace))
+	}
+	hostingClient, err := kubernetes.NewForConfig(ctrl.GetConfigOrDie())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create clientset for the hosting cluster: %w", err)
+	}
+	leaseSharder := sharding.NewLeaseSharder(logger.WithName("sharder"), hostingClient, namespace, group, identity)
+	if err := leaseSharder.Start(ctx); err != nil {
+		return nil, err
+	}
+	return leaseSharder, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog/v2"
+
+	clientopts "github.com/kubestellar/kubestellar/options"
+)
+
+// writeKubeconfig writes a kubeconfig with a context per given name, for a server
+// named alike, and returns its path.
+func writeKubeconfig(t *testing.T, currentContext string, contexts ...string) string {
+	config := clientcmdapi.NewConfig()
+	for _, name := range contexts {
+		config.Clusters[name] = &clientcmdapi.Cluster{Server: "https://" + name}
+		config.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: "token"}
+		config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	}
+	config.CurrentContext = currentContext
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	return path
+}
+
+func TestGetExplicitRestConfig(t *testing.T) {
+	explicit := writeKubeconfig(t, "wds1", "wds1", "wds2")
+	noCurrent := writeKubeconfig(t, "", "wds3")
+	testCases := []struct {
+		name         string
+		args         []string
+		spaceName    string
+		expectedName string
+		expectedHost string
+		expectError  bool
+	}{
+		{name: "kubeconfig, named after its context", args: []string{"--wds-kubeconfig=" + explicit},
+			expectedName: "wds1", expectedHost: "https://wds1"},
+		{name: "kubeconfig and context, named after the context", args: []string{"--wds-kubeconfig=" + explicit, "--wds-context=wds2"},
+			expectedName: "wds2", expectedHost: "https://wds2"},
+		{name: "kubeconfig and given name", args: []string{"--wds-kubeconfig=" + explicit}, spaceName: "mine",
+			expectedName: "mine", expectedHost: "https://wds1"},
+		{name: "context without kubeconfig", args: []string{"--wds-context=default2"},
+			expectedName: "default2", expectedHost: "https://default2"},
+		{name: "kubeconfig without current context", args: []string{"--wds-kubeconfig=" + noCurrent}, expectError: true},
+		{name: "kubeconfig without current context, given context", args: []string{"--wds-kubeconfig=" + noCurrent, "--wds-context=wds3"},
+			expectedName: "wds3", expectedHost: "https://wds3"},
+		{name: "missing kubeconfig", args: []string{"--wds-kubeconfig=" + filepath.Join(t.TempDir(), "missing")}, expectError: true},
+		{name: "unknown context", args: []string{"--wds-kubeconfig=" + explicit, "--wds-context=wds9"}, expectError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			// where a kubeconfig is not given, the default one is used
+			t.Setenv(clientcmd.RecommendedConfigPathEnvVar, writeKubeconfig(t, "default1", "default1", "default2"))
+			opts := clientopts.NewClientOptions[*pflag.FlagSet]("wds", "accessing the WDS")
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			opts.AddFlags(flags)
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+			restConfig, name, err := getExplicitRestConfig(ctx, klog.Background(), opts, tc.spaceName)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got name %q", name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tc.expectedName || restConfig.Host != tc.expectedHost {
+				t.Errorf("expected name %q and host %q, got name %q and host %q", tc.expectedName, tc.expectedHost, name, restConfig.Host)
+			}
+		})
+	}
+}
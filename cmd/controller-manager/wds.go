@@ -0,0 +1,276 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kfv1aplha1 "github.com/kubestellar/kubeflex/api/v1alpha1"
+
+	"github.com/kubestellar/kubestellar/pkg/binding"
+	ksmetrics "github.com/kubestellar/kubestellar/pkg/metrics"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
+	"github.com/kubestellar/kubestellar/pkg/status"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// wdsStarter starts the binding and status controllers for a WDS.
+// The controllers for different WDSes share the ITS informers.
+type wdsStarter struct {
+	logger logr.Logger
+	// itsRestConfigs has one entry per shard of the inventory
+	itsRestConfigs     []*rest.Config
+	itsInformers       *binding.ITSInformers
+	workStatusPresent  bool
+	allowedGroupsSet   sets.Set[string]
+	resourceFilter     *util.ResourceFilter
+	sharder            sharding.Sharder // divides the BindingPolicies among the replicas, the same way for every WDS
+	ctlrsToStart       sets.Set[string]
+	conversion         *apiextensionsv1.WebhookClientConfig
+	limitWDSRestConfig func(*rest.Config) *rest.Config
+	// fullObjectCacheSize is the size of the cache of whole workload objects of each WDS
+	fullObjectCacheSize int
+}
+
+// start starts the controllers for the given WDS. They run until the given context is done.
+// Their metrics are registered with the given registerer.
+func (ws *wdsStarter) start(ctx context.Context, wdsName string, wdsRestConfig *rest.Config, reg prometheus.Registerer) error {
+	// The binding controller sends its listers to the status controller through this channel.
+	cListers := make(chan interface{}, 1)
+	wdsRestConfig = ws.limitWDSRestConfig(wdsRestConfig)
+	logger := ws.logger.WithValues("wds", wdsName)
+
+	// the binding and status controllers fetch the content of workload objects through one cache
+	wdsDynClient, err := dynamic.NewForConfig(wdsRestConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create dynamic client: %w", err)
+	}
+	fullObjectGetter := util.NewFullObjectGetter(wdsDynClient, ws.fullObjectCacheSize)
+
+	bindingController, err := binding.NewControllerSharingITS(logger, wdsRestConfig, ws.itsInformers, wdsName, ws.allowedGroupsSet, ws.resourceFilter)
+	if err != nil {
+		return fmt.Errorf("unable to create binding controller: %w", err)
+	}
+	bindingController.RegisterMetrics(ksmetrics.PrometheusRegisterFn(reg))
+	bindingController.UseSharder(ws.sharder)
+	bindingController.UseFullObjectGetter(fullObjectGetter)
+
+	if err := bindingController.EnsureCRDs(ctx, ws.conversion); err != nil {
+		return fmt.Errorf("error installing the CRDs: %w", err)
+	}
+
+	if err := bindingController.AppendKSResources(ctx); err != nil {
+		return fmt.Errorf("error appending KubeStellar resources to discovered lists: %w", err)
+	}
+
+	if len(ws.ctlrsToStart) == 0 || ws.ctlrsToStart.Has(strings.ToLower(binding.ControllerName)) {
+		logger.Info("Starting controller", "name", binding.ControllerName)
+		if err := bindingController.Start(ctx, workers, cListers); err != nil {
+			return fmt.Errorf("error starting the binding controller: %w", err)
+		}
+	}
+
+	// check if status add-on present before starting the status controller
+	if ws.workStatusPresent &&
+		(len(ws.ctlrsToStart) == 0 || ws.ctlrsToStart.Has(strings.ToLower(status.ControllerName))) {
+		logger.Info("Starting controller", "name", status.ControllerName)
+		statusController, err := status.NewController(wdsRestConfig, ws.itsRestConfigs, wdsName,
+			bindingController.GetBindingPolicyResolutionBroker())
+		if err != nil {
+			return fmt.Errorf("unable to create status controller: %w", err)
+		}
+
+		statusController.UseSharder(ws.sharder)
+		statusController.UseFullObjectGetter(fullObjectGetter)
+		if err := statusController.Start(ctx, workers, cListers); err != nil {
+			return fmt.Errorf("error starting the status controller: %w", err)
+		}
+	}
+	return nil
+}
+
+// wdsReconciler keeps a pair of binding and status controllers running for each WDS,
+// that is, for each KubeFlex ControlPlane labeled with kflex.kubestellar.io/cptype=wds.
+// The controllers of a WDS are stopped when its ControlPlane is deleted or loses that label,
+// and restarted when its access secret reference changes.
+// A rotation of the credentials within the access secret is followed without a restart.
+// Starting the controllers of a WDS waits for their informers to sync, so it is done
+// in a goroutine of its own, retried with backoff until it succeeds or the WDS is stopped.
+type wdsReconciler struct {
+	client.Client
+	// hostingClient reads the access secrets of the ControlPlanes.
+	hostingClient kubernetes.Interface
+	// start starts the controllers of a WDS; it is wdsStarter.start outside of tests.
+	start func(ctx context.Context, wdsName string, wdsRestConfig *rest.Config, reg prometheus.Registerer) error
+	// startBackoff paces the retries of a failed start.
+	startBackoff wait.Backoff
+	// baseCtx is the context in which the controllers of each WDS run.
+	baseCtx context.Context
+	// registerer is where the metrics of each WDS are registered, labeled with `wds`.
+	registerer prometheus.Registerer
+
+	// mutex guards running; it is never held while starting controllers.
+	mutex   sync.Mutex
+	running map[string]*runningWDS
+}
+
+// runningWDS is what it takes to stop the controllers of a WDS, whether or not they have
+// finished starting.
+type runningWDS struct {
+	secretRef  kfv1aplha1.SecretReference
+	cancel     context.CancelFunc
+	registerer *ksmetrics.TrackingRegisterer
+}
+
+func newWDSReconciler(mgr ctrl.Manager, hostingClient kubernetes.Interface, starter *wdsStarter,
+	baseCtx context.Context, registerer prometheus.Registerer) *wdsReconciler {
+	return &wdsReconciler{
+		Client:        mgr.GetClient(),
+		hostingClient: hostingClient,
+		start:         starter.start,
+		startBackoff:  wait.Backoff{Duration: time.Second, Factor: 2, Jitter: 0.1, Steps: math.MaxInt32, Cap: 5 * time.Minute},
+		baseCtx:       baseCtx,
+		registerer:    registerer,
+		running:       map[string]*runningWDS{},
+	}
+}
+
+// SetupWithManager has the given manager call this reconciler for every ControlPlane.
+// It does not filter by label, so that it sees a ControlPlane lose the WDS label.
+func (r *wdsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("wds-watcher").
+		For(&kfv1aplha1.ControlPlane{}).
+		Complete(r)
+}
+
+func (r *wdsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	wdsName := req.Name
+	cp := &kfv1aplha1.ControlPlane{}
+	if err := r.Get(ctx, client.ObjectKey{Name: wdsName}, cp); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		cp = nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	running := r.running[wdsName]
+	if cp == nil || cp.DeletionTimestamp != nil || cp.Labels[util.ControlPlaneTypeLabel] != util.ControlPlaneTypeWDS ||
+		cp.Status.SecretRef == nil {
+		if running != nil {
+			logger.Info("Stopping the controllers of a WDS", "wds", wdsName)
+			r.stop(wdsName, running)
+		}
+		return ctrl.Result{}, nil
+	}
+	if running != nil {
+		if running.secretRef == *cp.Status.SecretRef {
+			return ctrl.Result{}, nil
+		}
+		logger.Info("Restarting the controllers of a WDS because its access secret changed", "wds", wdsName)
+		r.stop(wdsName, running)
+	}
+	logger.Info("Starting the controllers of a WDS", "wds", wdsName)
+	wdsCtx, cancel := context.WithCancel(r.baseCtx)
+	running = &runningWDS{
+		secretRef:  *cp.Status.SecretRef,
+		cancel:     cancel,
+		registerer: ksmetrics.NewTrackingRegisterer(prometheus.WrapRegistererWith(prometheus.Labels{"wds": wdsName}, r.registerer)),
+	}
+	r.running[wdsName] = running
+	go r.startWithRetries(wdsCtx, logger.WithValues("wds", wdsName), cp.DeepCopy(), running)
+	return ctrl.Result{}, nil
+}
+
+// startWithRetries starts the controllers of the given WDS, retrying with backoff
+// until that succeeds or the given context, which is the one of the WDS, is done.
+func (r *wdsReconciler) startWithRetries(wdsCtx context.Context, logger logr.Logger, cp *kfv1aplha1.ControlPlane, running *runningWDS) {
+	backoff := r.startBackoff
+	for {
+		err := r.startAttempt(wdsCtx, logger, cp, running.registerer)
+		if err == nil {
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+			if wdsCtx.Err() != nil {
+				// stopped while starting; drop what got registered after the stop
+				running.registerer.UnregisterAll()
+			}
+			return
+		}
+		running.registerer.UnregisterAll()
+		if wdsCtx.Err() != nil {
+			return
+		}
+		delay := backoff.Step()
+		logger.Error(err, "Failed to start the controllers of a WDS, will retry", "delay", delay)
+		select {
+		case <-wdsCtx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// startAttempt makes one attempt at starting the controllers of the given WDS.
+// The attempt runs in a context of its own, canceled if the attempt fails,
+// so that a failed attempt leaves nothing running.
+func (r *wdsReconciler) startAttempt(wdsCtx context.Context, logger logr.Logger, cp *kfv1aplha1.ControlPlane, reg prometheus.Registerer) error {
+	ctx, cancel := context.WithCancel(wdsCtx)
+	started := false
+	defer func() {
+		if !started {
+			cancel()
+		}
+	}()
+	wdsRestConfig, err := util.GetReloadingControlPlaneRestConfig(ctx, logger, r.hostingClient, cp)
+	if err != nil {
+		return fmt.Errorf("unable to get the config of WDS %s: %w", cp.Name, err)
+	}
+	if err := r.start(ctx, cp.Name, wdsRestConfig, reg); err != nil {
+		return fmt.Errorf("unable to start the controllers of WDS %s: %w", cp.Name, err)
+	}
+	started = true
+	return nil
+}
+
+// stop stops the controllers of the given WDS, or the attempts to start them.
+// The caller holds r.mutex.
+func (r *wdsReconciler) stop(wdsName string, running *runningWDS) {
+	running.cancel()
+	running.registerer.UnregisterAll()
+	delete(r.running, wdsName)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kfv1aplha1 "github.com/kubestellar/kubeflex/api/v1alpha1"
+
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// startCall is a call of the start function of a wdsReconciler under test,
+// which returns what is sent on result.
+type startCall struct {
+	ctx    context.Context
+	host   string
+	result chan error
+}
+
+func accessSecret(t *testing.T, name, host string) *corev1.Secret {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["wds"] = &clientcmdapi.Cluster{Server: "https://" + host}
+	config.AuthInfos["wds"] = &clientcmdapi.AuthInfo{Token: "token"}
+	config.Contexts["wds"] = &clientcmdapi.Context{Cluster: "wds", AuthInfo: "wds"}
+	config.CurrentContext = "wds"
+	kubeconfig, err := clientcmd.Write(*config)
+	if err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "wds1-system", Name: name},
+		Data: map[string][]byte{"kubeconfig": kubeconfig}}
+}
+
+// TestWDSReconciler tests that the reconciler starts, restarts and stops the controllers
+// of a WDS, retries a failed start, and does not hold its mutex while starting.
+func TestWDSReconciler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheme := runtime.NewScheme()
+	if err := kfv1aplha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	cp := &kfv1aplha1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "wds1", Labels: map[string]string{util.ControlPlaneTypeLabel: util.ControlPlaneTypeWDS}},
+		Status: kfv1aplha1.ControlPlaneStatus{SecretRef: &kfv1aplha1.SecretReference{
+			Namespace: "wds1-system", Name: "secret1", Key: "kubeconfig", InClusterKey: "kubeconfig"}},
+	}
+	calls := make(chan startCall)
+	r := &wdsReconciler{
+		Client:        ctrlfake.NewClientBuilder().WithScheme(scheme).WithObjects(cp).Build(),
+		hostingClient: fake.NewSimpleClientset(accessSecret(t, "secret1", "one"), accessSecret(t, "secret2", "two")),
+		start: func(ctx context.Context, wdsName string, wdsRestConfig *rest.Config, reg prometheus.Registerer) error {
+			call := startCall{ctx: ctx, host: wdsRestConfig.Host, result: make(chan error)}
+			calls <- call
+			select {
+			case err := <-call.result:
+				return err
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+		startBackoff: wait.Backoff{Duration: time.Millisecond, Steps: 10},
+		baseCtx:      ctx,
+		registerer:   prometheus.NewRegistry(),
+		running:      map[string]*runningWDS{},
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "wds1"}}
+
+	reconcile := func() {
+		t.Helper()
+		done := make(chan error)
+		go func() {
+			_, err := r.Reconcile(ctx, req)
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatal("Reconcile did not return")
+		}
+	}
+	nextCall := func(expectedHost string) startCall {
+		t.Helper()
+		select {
+		case call := <-calls:
+			if call.host != "https://"+expectedHost {
+				t.Errorf("expected a start with host %q, got %q", expectedHost, call.host)
+			}
+			return call
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatal("controllers not started")
+			return startCall{}
+		}
+	}
+	expectCanceled := func(call startCall, what string) {
+		t.Helper()
+		select {
+		case <-call.ctx.Done():
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Errorf("expected the context of %s to be canceled", what)
+		}
+	}
+	update := func(mutate func(*kfv1aplha1.ControlPlane)) {
+		t.Helper()
+		current := &kfv1aplha1.ControlPlane{}
+		if err := r.Get(ctx, req.NamespacedName, current); err != nil {
+			t.Fatalf("failed to get ControlPlane: %v", err)
+		}
+		mutate(current)
+		if err := r.Update(ctx, current); err != nil {
+			t.Fatalf("failed to update ControlPlane: %v", err)
+		}
+	}
+
+	// the start is in progress while Reconcile returns, and a repeated Reconcile does not start again
+	reconcile()
+	failing := nextCall("one")
+	reconcile()
+	failing.result <- errors.New("cache sync failed")
+	expectCanceled(failing, "a failed start")
+
+	// the failed start is retried
+	first := nextCall("one")
+	first.result <- nil
+	select {
+	case call := <-calls:
+		t.Fatalf("unexpected start with host %q after success", call.host)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// a change of access secret restarts the controllers
+	update(func(cp *kfv1aplha1.ControlPlane) { cp.Status.SecretRef.Name = "secret2" })
+	reconcile()
+	expectCanceled(first, "the controllers using the old access secret")
+	second := nextCall("two")
+	second.result <- nil
+
+	// losing the WDS label stops the controllers
+	update(func(cp *kfv1aplha1.ControlPlane) { delete(cp.Labels, util.ControlPlaneTypeLabel) })
+	reconcile()
+	expectCanceled(second, "the controllers of a former WDS")
+
+	// regaining it starts them, and losing the access secret reference during the start stops them without waiting for it
+	update(func(cp *kfv1aplha1.ControlPlane) {
+		cp.Labels = map[string]string{util.ControlPlaneTypeLabel: util.ControlPlaneTypeWDS}
+	})
+	reconcile()
+	starting := nextCall("two")
+	update(func(cp *kfv1aplha1.ControlPlane) { cp.Status.SecretRef = nil })
+	reconcile()
+	expectCanceled(starting, "a start of a former WDS")
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.running) != 0 {
+		t.Errorf("expected no running WDS, got %v", r.running)
+	}
+}
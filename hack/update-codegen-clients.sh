@@ -42,3 +42,9 @@ kube::codegen::gen_client \
     --output-pkg-root github.com/kubestellar/kubestellar/pkg/generated \
     --output-base "${SCRIPT_ROOT}/../../.." \
     --boilerplate "${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt"
+
+# The conversions among the API versions (see the +k8s:conversion-gen tags)
+kube::codegen::gen_helpers \
+    --input-pkg-root github.com/kubestellar/kubestellar/api \
+    --output-base "${SCRIPT_ROOT}/../../.." \
+    --boilerplate "${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt"
@@ -81,8 +81,47 @@ func (opts *ClientOptions[FS]) ToRESTConfig() (*rest.Config, error) {
 	return opts.ClientLimits.LimitConfig(base), nil
 }
 
+// ToRESTConfigFor is like ToRESTConfig but uses the given kubeconfig file and context,
+// where they are not empty, in place of the ones given by the flags.
+func (opts *ClientOptions[FS]) ToRESTConfigFor(kubeconfig, context string) (*rest.Config, error) {
+	loadingRules := *opts.loadingRules
+	overrides := opts.overrides
+	if kubeconfig != "" {
+		loadingRules.ExplicitPath = kubeconfig
+	}
+	if context != "" {
+		overrides.CurrentContext = context
+	}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(&loadingRules, &overrides)
+	base, err := clientConfig.ClientConfig()
+	if err != nil {
+		return base, err
+	}
+	return opts.ClientLimits.LimitConfig(base), nil
+}
+
 func (opts *ClientLimits[FS]) LimitConfig(base *rest.Config) *rest.Config {
 	ans := *base
 	ans.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(float32(opts.QPS), opts.Burst)
 	return &ans
 }
+
+// IsSpecified tells whether any of the kubeconfig flags was given a value,
+// as opposed to leaving the choice of kubeconfig to the defaults.
+func (opts *ClientOptions[FS]) IsSpecified() bool {
+	return opts.loadingRules.ExplicitPath != "" || opts.overrides.CurrentContext != "" ||
+		opts.overrides.Context.AuthInfo != "" || opts.overrides.Context.Cluster != ""
+}
+
+// ContextName returns the name of the kubeconfig context that ToRESTConfig uses.
+func (opts *ClientOptions[FS]) ContextName() (string, error) {
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(opts.loadingRules, &opts.overrides)
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return "", err
+	}
+	if opts.overrides.CurrentContext != "" {
+		return opts.overrides.CurrentContext, nil
+	}
+	return rawConfig.CurrentContext, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientsopts
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// writeKubeconfig writes a kubeconfig with the given contexts and current context, and returns its path.
+func writeKubeconfig(t *testing.T, currentContext string, contexts ...string) string {
+	config := clientcmdapi.NewConfig()
+	for _, name := range contexts {
+		config.Clusters[name] = &clientcmdapi.Cluster{Server: "https://" + name}
+		config.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: "token"}
+		config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	}
+	config.CurrentContext = currentContext
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	return path
+}
+
+// parsedClientOptions returns ClientOptions named "test" after parsing the given arguments.
+func parsedClientOptions(t *testing.T, args ...string) *ClientOptions[*pflag.FlagSet] {
+	opts := NewClientOptions[*pflag.FlagSet]("test", "testing")
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	opts.AddFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	return opts
+}
+
+func TestIsSpecified(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{name: "nothing", expected: false},
+		{name: "only limits", args: []string{"--test-qps=10", "--test-burst=20"}, expected: false},
+		{name: "kubeconfig", args: []string{"--test-kubeconfig=/some/path"}, expected: true},
+		{name: "context", args: []string{"--test-context=ctx"}, expected: true},
+		{name: "user", args: []string{"--test-user=someone"}, expected: true},
+		{name: "cluster", args: []string{"--test-cluster=somewhere"}, expected: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := parsedClientOptions(t, tc.args...).IsSpecified(); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestContextName(t *testing.T) {
+	explicit := writeKubeconfig(t, "c", "c", "d")
+	noCurrent := writeKubeconfig(t, "", "e")
+	testCases := []struct {
+		name        string
+		args        []string
+		expected    string
+		expectError bool
+	}{
+		{name: "default kubeconfig", expected: "a"},
+		{name: "context in default kubeconfig", args: []string{"--test-context=b"}, expected: "b"},
+		{name: "given kubeconfig", args: []string{"--test-kubeconfig=" + explicit}, expected: "c"},
+		{name: "context in given kubeconfig", args: []string{"--test-kubeconfig=" + explicit, "--test-context=d"}, expected: "d"},
+		{name: "kubeconfig without current context", args: []string{"--test-kubeconfig=" + noCurrent}, expected: ""},
+		{name: "missing kubeconfig", args: []string{"--test-kubeconfig=" + filepath.Join(t.TempDir(), "missing")}, expectError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(clientcmd.RecommendedConfigPathEnvVar, writeKubeconfig(t, "a", "a", "b"))
+			actual, err := parsedClientOptions(t, tc.args...).ContextName()
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got %q", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
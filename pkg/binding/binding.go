@@ -54,10 +54,17 @@ func (c *Controller) syncBinding(ctx context.Context, bindingName string) error
 	} else if err != nil {
 		return fmt.Errorf("failed to get Binding from informer cache (name=%v): %w", bindingName, err)
 	}
+	if err := c.updateBindingPolicyConditions(ctx, binding); err != nil {
+		return err
+	}
 
 	// binding name matches that of the bindingpolicy 1:1, therefore its NamespacedName is the same.
 	bindingPolicyIdentifier := binding.GetName()
 
+	if c.bindingPolicyIsSuspended(bindingPolicyIdentifier) {
+		return c.freezeBinding(ctx, binding)
+	}
+
 	// generate binding spec from resolver
 	generatedBindingSpec := c.bindingPolicyResolver.GenerateBinding(bindingPolicyIdentifier)
 	if generatedBindingSpec == nil { // resolution does not exist, abort syncing
@@ -65,7 +72,10 @@ func (c *Controller) syncBinding(ctx context.Context, bindingName string) error
 	}
 
 	// calculate if the resolved decision is different from the current one
-	if !c.bindingPolicyResolver.CompareBinding(bindingPolicyIdentifier, &binding.Spec) {
+	bindingChanged := !c.bindingPolicyResolver.CompareBinding(bindingPolicyIdentifier, &binding.Spec)
+	// the bindings of overlapping bindingpolicies may need to exclude objects differently
+	c.updateOverlaps(bindingPolicyIdentifier, bindingChanged)
+	if bindingChanged {
 		// update the binding object in the cluster by updating spec
 		if err = c.updateOrCreateBinding(ctx, binding, generatedBindingSpec); err != nil {
 			return fmt.Errorf("failed to update or create binding: %w", err)
@@ -80,6 +90,67 @@ func (c *Controller) syncBinding(ctx context.Context, bindingName string) error
 	return nil
 }
 
+// updateBindingPolicyConditions copies the RolledOut condition of the given binding,
+// which the transport maintains, or its absence, to the status of the bindingpolicy,
+// and reports there whether the bindingpolicy's resolution conflicts with others.
+// Writing the status is skipped while the bindingpolicy's current generation has not
+// been processed, and done later when its binding is synced.
+// The given `binding *v1alpha1.Binding` points to immutable storage.
+func (c *Controller) updateBindingPolicyConditions(ctx context.Context, binding *v1alpha1.Binding) error {
+	conflictFree := c.updateConflicts(binding.Name)
+	bindingPolicy, err := c.bindingPolicyLister.Get(binding.Name)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get BindingPolicy from informer cache (name=%v): %w", binding.Name, err)
+	}
+	if bindingPolicy.Status.ObservedGeneration != bindingPolicy.Generation {
+		return nil
+	}
+	var rolledOut *v1alpha1.BindingPolicyCondition
+	for idx := range binding.Status.Conditions {
+		if binding.Status.Conditions[idx].Type == v1alpha1.TypeRolledOut {
+			rolledOut = &binding.Status.Conditions[idx]
+		}
+	}
+	return c.updateBindingPolicyStatus(ctx, bindingPolicy, func(status *v1alpha1.BindingPolicyStatus) {
+		if rolledOut == nil {
+			status.Conditions = v1alpha1.RemoveCondition(status.Conditions, v1alpha1.TypeRolledOut)
+		} else {
+			status.Conditions = v1alpha1.SetCondition(status.Conditions, *rolledOut)
+		}
+		status.Conditions = v1alpha1.SetCondition(status.Conditions, conflictFree)
+		status.Errors = c.withExclusionErrors(binding.Name, status.Errors)
+	})
+}
+
+// bindingPolicyIsSuspended tells whether the bindingpolicy with the given name
+// exists and has `spec.suspend` set.
+func (c *Controller) bindingPolicyIsSuspended(bindingPolicyName string) bool {
+	bindingPolicy, err := c.bindingPolicyLister.Get(bindingPolicyName)
+	return err == nil && bindingPolicy.Spec.Suspend
+}
+
+// freezeBinding handles a binding whose bindingpolicy is suspended: the binding's
+// workload and destinations are left as they are, and the binding is marked suspended
+// so that the transport leaves the destinations as they are too.
+// A binding that does not exist yet is not created.
+// The given `binding *v1alpha1.Binding` points to immutable storage.
+func (c *Controller) freezeBinding(ctx context.Context, binding *v1alpha1.Binding) error {
+	logger := klog.FromContext(ctx)
+	if binding.Spec.Suspend || binding.ResourceVersion == "" {
+		logger.V(4).Info("Not syncing Binding of suspended BindingPolicy", "name", binding.Name)
+		return nil
+	}
+	frozenSpec := binding.Spec.DeepCopy()
+	frozenSpec.Suspend = true
+	if err := c.updateOrCreateBinding(ctx, binding, frozenSpec); err != nil {
+		return fmt.Errorf("failed to suspend binding: %w", err)
+	}
+	c.bindingPolicyResolver.Broker().NotifyCallbacks(binding.Name)
+	return nil
+}
+
 // updateOrCreateBinding updates or creates a binding object in the cluster.
 // If the object already exists, it is updated. Otherwise, it is created.
 // The given `bdg *v1alpha1.Binding` points to immutable storage.
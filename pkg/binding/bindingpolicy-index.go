@@ -0,0 +1,170 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// indexWildcard is the index value for a criterion that accepts every value.
+// It can not collide with an API group, resource or namespace name.
+const indexWildcard = "*"
+
+// bindingPolicyIndex is an inverted index from the API group, resource and namespace
+// of a workload object to the downsync clauses that might match the object.
+// A clause is indexed under every combination of the literal values of its
+// `apiGroup`, `resources` and `namespaces` criteria, with indexWildcard standing
+// for a criterion that accepts every value. The other criteria are not indexed,
+// so a clause that is found still has to be tested.
+type bindingPolicyIndex struct {
+	sync.RWMutex
+
+	clauses map[indexKey]sets.Set[clauseRef]
+
+	// policies maps the name of each indexed BindingPolicy to what is indexed for it
+	policies map[string]indexedBindingPolicy
+}
+
+type indexKey struct {
+	group     string
+	resource  string
+	namespace string
+}
+
+// clauseRef identifies a member of the `downsync` of a BindingPolicy.
+type clauseRef struct {
+	bindingPolicyName string
+	index             int
+}
+
+type indexedBindingPolicy struct {
+	generation int64
+	keys       sets.Set[indexKey]
+}
+
+func newBindingPolicyIndex() *bindingPolicyIndex {
+	return &bindingPolicyIndex{
+		clauses:  map[indexKey]sets.Set[clauseRef]{},
+		policies: map[string]indexedBindingPolicy{},
+	}
+}
+
+// noteBindingPolicy indexes the clauses of the given BindingPolicy,
+// replacing what was indexed for an earlier generation of it.
+// `*bindingPolicy` is immutable.
+func (idx *bindingPolicyIndex) noteBindingPolicy(bindingPolicy *v1alpha1.BindingPolicy) {
+	idx.Lock()
+	defer idx.Unlock()
+	if indexed, have := idx.policies[bindingPolicy.Name]; have {
+		if indexed.generation == bindingPolicy.Generation {
+			return
+		}
+		idx.removeLocked(bindingPolicy.Name, indexed)
+	}
+	indexed := indexedBindingPolicy{generation: bindingPolicy.Generation, keys: sets.New[indexKey]()}
+	for clauseIdx, clause := range bindingPolicy.Spec.Downsync {
+		ref := clauseRef{bindingPolicyName: bindingPolicy.Name, index: clauseIdx}
+		groups := []string{indexWildcard}
+		if clause.APIGroup != nil {
+			groups = []string{*clause.APIGroup}
+		}
+		for _, group := range groups {
+			for _, resource := range indexValues(clause.Resources) {
+				for _, namespace := range indexValues(clause.Namespaces) {
+					key := indexKey{group: group, resource: resource, namespace: namespace}
+					refs, have := idx.clauses[key]
+					if !have {
+						refs = sets.New[clauseRef]()
+						idx.clauses[key] = refs
+					}
+					refs.Insert(ref)
+					indexed.keys.Insert(key)
+				}
+			}
+		}
+	}
+	idx.policies[bindingPolicy.Name] = indexed
+}
+
+// forgetBindingPolicy removes the named BindingPolicy from the index.
+func (idx *bindingPolicyIndex) forgetBindingPolicy(bindingPolicyName string) {
+	idx.Lock()
+	defer idx.Unlock()
+	if indexed, have := idx.policies[bindingPolicyName]; have {
+		idx.removeLocked(bindingPolicyName, indexed)
+	}
+}
+
+func (idx *bindingPolicyIndex) removeLocked(bindingPolicyName string, indexed indexedBindingPolicy) {
+	for key := range indexed.keys {
+		refs := idx.clauses[key]
+		for ref := range refs {
+			if ref.bindingPolicyName == bindingPolicyName {
+				refs.Delete(ref)
+			}
+		}
+		if refs.Len() == 0 {
+			delete(idx.clauses, key)
+		}
+	}
+	delete(idx.policies, bindingPolicyName)
+}
+
+// isCurrent tells whether the index reflects the given generation of the given BindingPolicy.
+func (idx *bindingPolicyIndex) isCurrent(bindingPolicy *v1alpha1.BindingPolicy) bool {
+	idx.RLock()
+	defer idx.RUnlock()
+	indexed, have := idx.policies[bindingPolicy.Name]
+	return have && indexed.generation == bindingPolicy.Generation
+}
+
+// candidateClauses returns, for each indexed BindingPolicy that has clauses that might
+// match an object with the given API group, resource and namespace, the indices of those clauses.
+// The namespace of a cluster-scoped object is the empty string.
+func (idx *bindingPolicyIndex) candidateClauses(group, resource, namespace string) map[string]sets.Set[int] {
+	idx.RLock()
+	defer idx.RUnlock()
+	candidates := map[string]sets.Set[int]{}
+	for _, groupKey := range []string{group, indexWildcard} {
+		for _, resourceKey := range []string{resource, indexWildcard} {
+			for _, namespaceKey := range []string{namespace, indexWildcard} {
+				for ref := range idx.clauses[indexKey{group: groupKey, resource: resourceKey, namespace: namespaceKey}] {
+					indices, have := candidates[ref.bindingPolicyName]
+					if !have {
+						indices = sets.New[int]()
+						candidates[ref.bindingPolicyName] = indices
+					}
+					indices.Insert(ref.index)
+				}
+			}
+		}
+	}
+	return candidates
+}
+
+// indexValues returns the values under which to index a criterion that is given
+// by a list of acceptable values, where an empty list or `"*"` accepts every value.
+func indexValues(acceptable []string) []string {
+	if len(acceptable) == 0 || SliceContains(acceptable, "*") {
+		return []string{indexWildcard}
+	}
+	return acceptable
+}
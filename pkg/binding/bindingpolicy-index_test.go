@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestBindingPolicyIndex(t *testing.T) {
+	core, apps := "", "apps"
+	clause := func(group *string, resources, namespaces []string) v1alpha1.DownsyncPolicyClause {
+		return v1alpha1.DownsyncPolicyClause{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{
+			APIGroup: group, Resources: resources, Namespaces: namespaces}}
+	}
+	bp1 := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp1", Generation: 1},
+		Spec: v1alpha1.BindingPolicySpec{Downsync: []v1alpha1.DownsyncPolicyClause{
+			clause(&core, []string{"configmaps", "secrets"}, []string{"app"}),
+			clause(&apps, []string{"deployments"}, nil),
+			clause(nil, nil, []string{"*"}),
+		}}}
+	bp2 := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp2", Generation: 1},
+		Spec: v1alpha1.BindingPolicySpec{Downsync: []v1alpha1.DownsyncPolicyClause{
+			clause(nil, []string{"*"}, []string{"other"}),
+		}}}
+	idx := newBindingPolicyIndex()
+	idx.noteBindingPolicy(bp1)
+	idx.noteBindingPolicy(bp2)
+
+	testCases := []struct {
+		name      string
+		group     string
+		resource  string
+		namespace string
+		expected  map[string]sets.Set[int]
+	}{
+		{name: "configmap in app", resource: "configmaps", namespace: "app",
+			expected: map[string]sets.Set[int]{"bp1": sets.New(0, 2)}},
+		{name: "configmap in other", resource: "configmaps", namespace: "other",
+			expected: map[string]sets.Set[int]{"bp1": sets.New(2), "bp2": sets.New(0)}},
+		{name: "deployment in app", group: "apps", resource: "deployments", namespace: "app",
+			expected: map[string]sets.Set[int]{"bp1": sets.New(1, 2)}},
+		{name: "cluster-scoped", group: "rbac.authorization.k8s.io", resource: "clusterroles",
+			expected: map[string]sets.Set[int]{"bp1": sets.New(2)}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := idx.candidateClauses(tc.group, tc.resource, tc.namespace)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+
+	// a new generation replaces the old, and forgetting removes everything
+	bp1 = bp1.DeepCopy()
+	bp1.Generation = 2
+	bp1.Spec.Downsync = bp1.Spec.Downsync[:1]
+	if idx.isCurrent(bp1) {
+		t.Errorf("expected generation 2 to not be indexed yet")
+	}
+	idx.noteBindingPolicy(bp1)
+	if !idx.isCurrent(bp1) {
+		t.Errorf("expected generation 2 to be indexed")
+	}
+	if actual := idx.candidateClauses("", "configmaps", "other"); !reflect.DeepEqual(actual, map[string]sets.Set[int]{"bp2": sets.New(0)}) {
+		t.Errorf("after update, got %v", actual)
+	}
+	idx.forgetBindingPolicy("bp1")
+	idx.forgetBindingPolicy("bp2")
+	if len(idx.clauses) != 0 || len(idx.policies) != 0 {
+		t.Errorf("expected empty index, got clauses %v and policies %v", idx.clauses, idx.policies)
+	}
+}
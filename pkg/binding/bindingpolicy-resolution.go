@@ -17,10 +17,12 @@ limitations under the License.
 package binding
 
 import (
+	"fmt"
 	"sync"
 
 	"golang.org/x/exp/slices"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -47,27 +49,40 @@ type bindingPolicyResolution struct {
 	// requiresSingletonReportedState indicates whether the bindingpolicy
 	// that this resolution is associated with requires singleton status.
 	requiresSingletonReportedState bool
+
+	// rolloutStrategy is a copy of the rollout strategy of the bindingpolicy,
+	// to be copied into the binding.
+	rolloutStrategy *v1alpha1.RolloutStrategy
+
+	// maintenanceWindow is a copy of the maintenance window of the bindingpolicy,
+	// to be copied into the binding.
+	maintenanceWindow *v1alpha1.MaintenanceWindow
+
+	// priority is the priority of the bindingpolicy, which settles which
+	// resolutions deliver an object that several would deliver to a destination.
+	priority int32
 }
 
 // objectData stores the UID, resource version, create-only bit,
-// and statuscollectors for an object.
+// orphan bit, and statuscollectors for an object.
 type objectData struct {
 	UID              string
 	ResourceVersion  string
 	CreateOnly       bool
+	Orphan           bool
 	StatusCollectors sets.Set[string]
 }
 
 // ensureObjectData ensures that an object identifier exists
 // in the resolution and is associated with the given UID, resource version,
-// create-only bit, and statuscollectors set.
+// create-only bit, orphan bit, and statuscollectors set.
 // The given set is expected not to be mutated during and after this call by
 // the caller.
 //
 // The returned bool indicates whether the resolution was changed.
 // This function is thread-safe.
 func (resolution *bindingPolicyResolution) ensureObjectData(objIdentifier util.ObjectIdentifier,
-	objUID, resourceVersion string, createOnly bool, statusCollectors sets.Set[string]) bool {
+	objUID, resourceVersion string, createOnly, orphan bool, statusCollectors sets.Set[string]) bool {
 	resolution.Lock()
 	defer resolution.Unlock()
 
@@ -77,18 +92,21 @@ func (resolution *bindingPolicyResolution) ensureObjectData(objIdentifier util.O
 			UID:              objUID,
 			ResourceVersion:  resourceVersion,
 			CreateOnly:       createOnly,
+			Orphan:           orphan,
 			StatusCollectors: statusCollectors,
 		}
 		return true
 	}
 
-	if objData.UID == objUID && objData.ResourceVersion == resourceVersion && objData.CreateOnly == createOnly && objData.StatusCollectors.Equal(statusCollectors) {
+	if objData.UID == objUID && objData.ResourceVersion == resourceVersion && objData.CreateOnly == createOnly && objData.Orphan == orphan &&
+		objData.StatusCollectors.Equal(statusCollectors) {
 		return false
 	}
 
 	objData.UID = objUID
 	objData.ResourceVersion = resourceVersion
 	objData.CreateOnly = createOnly
+	objData.Orphan = orphan
 	objData.StatusCollectors = statusCollectors
 
 	return true
@@ -109,9 +127,34 @@ func (resolution *bindingPolicyResolution) removeObjectIdentifier(objIdentifier
 	return true
 }
 
-// toBindingSpec converts the resolution to a binding
-// spec. This function is thread-safe.
-func (resolution *bindingPolicyResolution) toBindingSpec() *v1alpha1.BindingSpec {
+// setRolloutStrategy sets the rollout strategy to a copy of the given one.
+// This function is thread-safe.
+func (resolution *bindingPolicyResolution) setRolloutStrategy(rolloutStrategy *v1alpha1.RolloutStrategy) {
+	resolution.Lock()
+	defer resolution.Unlock()
+	resolution.rolloutStrategy = rolloutStrategy.DeepCopy()
+}
+
+// setMaintenanceWindow sets the maintenance window to a copy of the given one.
+// This function is thread-safe.
+func (resolution *bindingPolicyResolution) setMaintenanceWindow(maintenanceWindow *v1alpha1.MaintenanceWindow) {
+	resolution.Lock()
+	defer resolution.Unlock()
+	resolution.maintenanceWindow = maintenanceWindow.DeepCopy()
+}
+
+// setPriority sets the priority.
+// This function is thread-safe.
+func (resolution *bindingPolicyResolution) setPriority(priority int32) {
+	resolution.Lock()
+	defer resolution.Unlock()
+	resolution.priority = priority
+}
+
+// toBindingSpec converts the resolution to a binding spec, in which each object
+// is excluded from the destinations that the given map associates with it.
+// This function is thread-safe.
+func (resolution *bindingPolicyResolution) toBindingSpec(excluded map[util.ObjectIdentifier]sets.Set[string]) *v1alpha1.BindingSpec {
 	resolution.RLock()
 	defer resolution.RUnlock()
 
@@ -130,8 +173,10 @@ func (resolution *bindingPolicyResolution) toBindingSpec() *v1alpha1.BindingSpec
 						Name:                 objIdentifier.ObjectName.Name,
 						ResourceVersion:      objData.ResourceVersion,
 					},
-					CreateOnly:       objData.CreateOnly,
-					StatusCollectors: sets.List(objData.StatusCollectors),
+					CreateOnly:           objData.CreateOnly,
+					DeletionPolicy:       orphanToDeletionPolicy(objData.Orphan),
+					StatusCollectors:     sets.List(objData.StatusCollectors),
+					ExcludedDestinations: excludedDestinationsList(excluded[objIdentifier]),
 				})
 
 			continue
@@ -145,8 +190,10 @@ func (resolution *bindingPolicyResolution) toBindingSpec() *v1alpha1.BindingSpec
 					Namespace:            objIdentifier.ObjectName.Namespace,
 					ResourceVersion:      objData.ResourceVersion,
 				},
-				CreateOnly:       objData.CreateOnly,
-				StatusCollectors: sets.List(objData.StatusCollectors),
+				CreateOnly:           objData.CreateOnly,
+				DeletionPolicy:       orphanToDeletionPolicy(objData.Orphan),
+				StatusCollectors:     sets.List(objData.StatusCollectors),
+				ExcludedDestinations: excludedDestinationsList(excluded[objIdentifier]),
 			})
 	}
 
@@ -154,15 +201,31 @@ func (resolution *bindingPolicyResolution) toBindingSpec() *v1alpha1.BindingSpec
 	sortBindingWorkloadObjects(&workload)
 
 	return &v1alpha1.BindingSpec{
-		Workload:     workload,
-		Destinations: destinationsStringSetToSortedDestinations(resolution.destinations),
+		Workload:          workload,
+		Destinations:      destinationsStringSetToSortedDestinations(resolution.destinations),
+		RolloutStrategy:   resolution.rolloutStrategy.DeepCopy(),
+		MaintenanceWindow: resolution.maintenanceWindow.DeepCopy(),
 	}
 }
 
-func (resolution *bindingPolicyResolution) matchesBindingSpec(bindingSpec *v1alpha1.BindingSpec) bool {
+// matchesBindingSpec tells whether the given binding spec is what toBindingSpec
+// would return for the given exclusions.
+// This function is thread-safe.
+func (resolution *bindingPolicyResolution) matchesBindingSpec(bindingSpec *v1alpha1.BindingSpec,
+	excluded map[util.ObjectIdentifier]sets.Set[string]) bool {
 	resolution.RLock()
 	defer resolution.RUnlock()
 
+	// a resolution always generates an unsuspended spec
+	if bindingSpec.Suspend {
+		return false
+	}
+
+	if !apiequality.Semantic.DeepEqual(resolution.rolloutStrategy, bindingSpec.RolloutStrategy) ||
+		!apiequality.Semantic.DeepEqual(resolution.maintenanceWindow, bindingSpec.MaintenanceWindow) {
+		return false
+	}
+
 	// check destinations
 	if !destinationsMatch(resolution.destinations, bindingSpec.Destinations) {
 		return false
@@ -175,16 +238,20 @@ func (resolution *bindingPolicyResolution) matchesBindingSpec(bindingSpec *v1alp
 	}
 
 	objRefToDataFromWorkload := bindingObjectRefToDataFromWorkload(&bindingSpec.Workload)
+	objRefToExcludedFromWorkload := bindingObjectRefToExcludedDestinationsFromWorkload(&bindingSpec.Workload)
 
 	for objIdentifier, objData := range resolution.objectIdentifierToData {
 		// check if object ref exists, then check if the object data matches
-		if objDataFromWorkload := objRefToDataFromWorkload[objectRef{
+		objRef := objectRef{
 			GroupVersionResource: objIdentifier.GVR(),
 			ObjectName:           objIdentifier.ObjectName,
-		}]; objDataFromWorkload == nil ||
+		}
+		if objDataFromWorkload := objRefToDataFromWorkload[objRef]; objDataFromWorkload == nil ||
 			objData.ResourceVersion != objDataFromWorkload.ResourceVersion ||
 			objData.CreateOnly != objDataFromWorkload.CreateOnly ||
-			!objData.StatusCollectors.Equal(objDataFromWorkload.StatusCollectors) {
+			objData.Orphan != objDataFromWorkload.Orphan ||
+			!objData.StatusCollectors.Equal(objDataFromWorkload.StatusCollectors) ||
+			!objRefToExcludedFromWorkload[objRef].Equal(excluded[objIdentifier]) {
 			return false
 		}
 	} // this check works because both groups have unique members and are of equal size
@@ -192,6 +259,93 @@ func (resolution *bindingPolicyResolution) matchesBindingSpec(bindingSpec *v1alp
 	return true
 }
 
+// overlapView is a copy of the parts of a resolution that matter for
+// relating it to other resolutions that select some of the same objects.
+type overlapView struct {
+	destinations                   sets.Set[string]
+	objectIdentifierToData         map[util.ObjectIdentifier]objectData
+	requiresSingletonReportedState bool
+	priority                       int32
+}
+
+// getOverlapView returns an overlapView of the resolution, restricted to
+// the given objects.
+// This function is thread-safe.
+func (resolution *bindingPolicyResolution) getOverlapView(objIdentifiers sets.Set[util.ObjectIdentifier]) overlapView {
+	resolution.RLock()
+	defer resolution.RUnlock()
+
+	objectIdentifierToData := make(map[util.ObjectIdentifier]objectData, len(objIdentifiers))
+	for objIdentifier := range objIdentifiers {
+		if objData, found := resolution.objectIdentifierToData[objIdentifier]; found {
+			objectIdentifierToData[objIdentifier] = *objData // the statuscollectors set is not mutated
+		}
+	}
+	return overlapView{
+		destinations:                   resolution.destinations.Clone(),
+		objectIdentifierToData:         objectIdentifierToData,
+		requiresSingletonReportedState: resolution.requiresSingletonReportedState,
+		priority:                       resolution.priority,
+	}
+}
+
+// overlaps tells whether the two views send some object to some common destination.
+func (view overlapView) overlaps(other overlapView) bool {
+	if !view.destinations.HasAny(sets.List(other.destinations)...) {
+		return false
+	}
+	for objIdentifier := range view.objectIdentifierToData {
+		if _, found := other.objectIdentifierToData[objIdentifier]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// conflictWith returns the conflict between the two views, and whether there is any.
+// Views with different priorities do not conflict, because only the one with
+// the higher priority delivers their common objects to their common destinations.
+// The example object in the conflict is the least one, so that it is stable.
+func (view overlapView) conflictWith(other overlapView) (ResolutionConflict, bool) {
+	commonDestinations := view.destinations.Intersection(other.destinations)
+	if commonDestinations.Len() == 0 || view.priority != other.priority {
+		return ResolutionConflict{}, false
+	}
+	var conflict ResolutionConflict
+	for objIdentifier, objData := range view.objectIdentifierToData {
+		otherData, found := other.objectIdentifierToData[objIdentifier]
+		if !found {
+			continue
+		}
+		var settings []string
+		if objData.CreateOnly != otherData.CreateOnly {
+			settings = append(settings, "createOnly")
+		}
+		if objData.Orphan != otherData.Orphan {
+			settings = append(settings, "deletionPolicy")
+		}
+		if !objData.StatusCollectors.Equal(otherData.StatusCollectors) {
+			settings = append(settings, "statusCollectors")
+		}
+		if view.requiresSingletonReportedState != other.requiresSingletonReportedState {
+			settings = append(settings, "wantSingletonReportedState")
+		}
+		if len(settings) == 0 {
+			continue
+		}
+		conflict.NumObjects++
+		if conflict.NumObjects == 1 || fmt.Sprint(objIdentifier) < fmt.Sprint(conflict.Object) {
+			conflict.Object = objIdentifier
+			conflict.Settings = settings
+		}
+	}
+	if conflict.NumObjects == 0 {
+		return ResolutionConflict{}, false
+	}
+	conflict.Destination = sets.List(commonDestinations)[0]
+	return conflict, true
+}
+
 // getDestinationsList returns a sorted list of v1alpha1.Destination in the
 // resolution.
 func (resolution *bindingPolicyResolution) getDestinationsList() []v1alpha1.Destination {
@@ -243,6 +397,7 @@ func bindingObjectRefToDataFromWorkload(bindingSpecWorkload *v1alpha1.DownsyncOb
 		}] = &objectData{
 			ResourceVersion:  clusterScopeDownsyncClause.ResourceVersion,
 			CreateOnly:       clusterScopeDownsyncClause.CreateOnly,
+			Orphan:           clusterScopeDownsyncClause.DeletionPolicy == v1alpha1.DeletionPolicyOrphan,
 			StatusCollectors: sets.New(clusterScopeDownsyncClause.StatusCollectors...),
 		}
 	}
@@ -257,6 +412,7 @@ func bindingObjectRefToDataFromWorkload(bindingSpecWorkload *v1alpha1.DownsyncOb
 		}] = &objectData{
 			ResourceVersion:  namespacedScopeDownsyncClause.ResourceVersion,
 			CreateOnly:       namespacedScopeDownsyncClause.CreateOnly,
+			Orphan:           namespacedScopeDownsyncClause.DeletionPolicy == v1alpha1.DeletionPolicyOrphan,
 			StatusCollectors: sets.New(namespacedScopeDownsyncClause.StatusCollectors...),
 		}
 	}
@@ -264,6 +420,47 @@ func bindingObjectRefToDataFromWorkload(bindingSpecWorkload *v1alpha1.DownsyncOb
 	return bindingObjectRefToData
 }
 
+func bindingObjectRefToExcludedDestinationsFromWorkload(bindingSpecWorkload *v1alpha1.DownsyncObjectClauses) map[objectRef]sets.Set[string] {
+	bindingObjectRefToExcluded := make(map[objectRef]sets.Set[string])
+
+	for _, clusterScopeDownsyncClause := range bindingSpecWorkload.ClusterScope {
+		bindingObjectRefToExcluded[objectRef{
+			GroupVersionResource: schema.GroupVersionResource(clusterScopeDownsyncClause.GroupVersionResource),
+			ObjectName:           cache.ObjectName{Name: clusterScopeDownsyncClause.Name},
+		}] = sets.New(clusterScopeDownsyncClause.ExcludedDestinations...)
+	}
+
+	for _, namespacedScopeDownsyncClause := range bindingSpecWorkload.NamespaceScope {
+		bindingObjectRefToExcluded[objectRef{
+			GroupVersionResource: schema.GroupVersionResource(namespacedScopeDownsyncClause.GroupVersionResource),
+			ObjectName: cache.ObjectName{
+				Name:      namespacedScopeDownsyncClause.Name,
+				Namespace: namespacedScopeDownsyncClause.Namespace,
+			},
+		}] = sets.New(namespacedScopeDownsyncClause.ExcludedDestinations...)
+	}
+
+	return bindingObjectRefToExcluded
+}
+
+// excludedDestinationsList returns the given destinations as a sorted list,
+// or nil if there are none.
+func excludedDestinationsList(excluded sets.Set[string]) []string {
+	if excluded.Len() == 0 {
+		return nil
+	}
+	return sets.List(excluded)
+}
+
+// orphanToDeletionPolicy returns the deletion policy to put in a Binding for
+// an object with the given orphan bit; the default (Delete) is left implicit.
+func orphanToDeletionPolicy(orphan bool) v1alpha1.DeletionPolicy {
+	if orphan {
+		return v1alpha1.DeletionPolicyOrphan
+	}
+	return ""
+}
+
 func destinationsStringSetToSortedDestinations(destinationsStringSet sets.Set[string]) []v1alpha1.Destination {
 	sortedDestinations := make([]v1alpha1.Destination, 0, len(destinationsStringSet))
 
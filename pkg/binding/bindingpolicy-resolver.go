@@ -38,7 +38,8 @@ const bindingPolicyResolutionNotFoundErrorPrefix = "bindingpolicy resolution is
 // method-parameter during a call to one of them.
 type BindingPolicyResolver interface {
 	// GenerateBinding returns the binding for the given
-	// bindingpolicy key.
+	// bindingpolicy key. In it, each object is excluded from the destinations
+	// to which a resolution of higher priority also sends it.
 	//
 	// If no resolution is associated with the given key, nil is returned.
 	GenerateBinding(bindingPolicyKey string) *v1alpha1.BindingSpec
@@ -53,7 +54,8 @@ type BindingPolicyResolver interface {
 	// - The destinations in the BindingSpec are an exact match
 	//of those in the resolution.
 	//
-	// - The same is true for every selected object.
+	// - The same is true for every selected object, including the
+	// destinations that it is excluded from.
 	//
 	// It is possible to output a false negative due to a temporary state of
 	// internal caches being out of sync.
@@ -62,13 +64,13 @@ type BindingPolicyResolver interface {
 
 	// NoteBindingPolicy associates a new resolution with the given
 	// bindingpolicy, if none is associated. This method maintains the
-	// singleton status reporting requirement in the resolution.
+	// singleton status reporting requirement and the priority in the resolution.
 	// `*bindingPolicy` is immutable
 	NoteBindingPolicy(bindingpolicy *v1alpha1.BindingPolicy)
 
 	// EnsureObjectData ensures that an object's identifier is
 	// in the resolution for the given bindingpolicy key, and is associated
-	// with the given resource-version, create-only bit, and statuscollectors set.
+	// with the given resource-version, create-only bit, orphan bit, and statuscollectors set.
 	// The given set is expected not to be mutated during and after this call
 	// by the caller.
 	//
@@ -76,7 +78,7 @@ type BindingPolicyResolver interface {
 	// changed. If no resolution is associated with the given key, an error is
 	// returned.
 	EnsureObjectData(bindingPolicyKey string, objIdentifier util.ObjectIdentifier,
-		objUID, resourceVersion string, createOnly bool, statusCollectors sets.Set[string]) (bool, error)
+		objUID, resourceVersion string, createOnly, orphan bool, statusCollectors sets.Set[string]) (bool, error)
 	// RemoveObjectIdentifier ensures the absence of the given object
 	// identifier from the resolution for the given bindingpolicy key.
 	//
@@ -110,6 +112,20 @@ type BindingPolicyResolver interface {
 	// if it exists.
 	DeleteResolution(bindingPolicyKey string)
 
+	// FindConflicts returns the conflicts between the resolution associated
+	// with the given key and each other resolution, indexed by the other's key.
+	// Two resolutions conflict when they send the same object to the same
+	// destination with different create-only bits, orphan bits, statuscollectors
+	// sets, or singleton status reporting requirements.
+	// If no resolution is associated with the given key, nil is returned.
+	FindConflicts(bindingPolicyKey string) map[string]ResolutionConflict
+
+	// FindOverlaps returns the keys of the other resolutions that send some
+	// object to some destination that the resolution associated with the given
+	// key also sends it to, whatever their priorities.
+	// If no resolution is associated with the given key, nil is returned.
+	FindOverlaps(bindingPolicyKey string) sets.Set[string]
+
 	// Broker returns a ResolutionBroker for the resolver.
 	Broker() ResolutionBroker
 }
@@ -117,6 +133,7 @@ type BindingPolicyResolver interface {
 func NewBindingPolicyResolver() BindingPolicyResolver {
 	bpResolver := &bindingPolicyResolver{
 		bindingPolicyToResolution: make(map[string]*bindingPolicyResolution),
+		objectToResolutionKeys:    newResolutionKeysByObject(),
 	}
 	bpResolver.broker = newResolutionBroker(bpResolver.getResolution, bpResolver.getAllResolutionKeys)
 
@@ -128,6 +145,11 @@ type bindingPolicyResolver struct {
 
 	sync.RWMutex
 	bindingPolicyToResolution map[string]*bindingPolicyResolution
+
+	// objectToResolutionKeys indexes the keys of the resolutions by the objects
+	// in them, so that relating a resolution to the others only involves those
+	// that share some of its objects.
+	objectToResolutionKeys *resolutionKeysByObject
 }
 
 // GenerateBinding returns the binding for the given
@@ -142,7 +164,7 @@ func (resolver *bindingPolicyResolver) GenerateBinding(bindingPolicyKey string)
 	}
 
 	// thread-safe
-	return bindingPolicyResolution.toBindingSpec()
+	return bindingPolicyResolution.toBindingSpec(resolver.supersededDestinations(bindingPolicyKey, bindingPolicyResolution))
 }
 
 // GetOwnerReference returns the owner reference for the given
@@ -169,7 +191,8 @@ func (resolver *bindingPolicyResolver) GetOwnerReference(bindingPolicyKey string
 // - The destinations in the BindingSpec are an exact match
 // of those in the resolution.
 //
-// - The same is true for every selected object.
+// - The same is true for every selected object, including the
+// destinations that it is excluded from.
 //
 // It is possible to output a false negative due to a temporary state of
 // internal caches being out of sync.
@@ -181,16 +204,21 @@ func (resolver *bindingPolicyResolver) CompareBinding(bindingPolicyKey string,
 		return false
 	}
 
-	return bindingPolicyResolution.matchesBindingSpec(bindingSpec)
+	return bindingPolicyResolution.matchesBindingSpec(bindingSpec,
+		resolver.supersededDestinations(bindingPolicyKey, bindingPolicyResolution))
 }
 
 // NoteBindingPolicy associates a new resolution with the given
 // bindingpolicy, if none is associated. This method maintains the
-// singleton status reporting requirement in the resolution.
+// singleton status reporting requirement, the rollout strategy, the maintenance window
+// and the priority in the resolution.
 // `*bindingPolicy` is immutable
 func (resolver *bindingPolicyResolver) NoteBindingPolicy(bindingpolicy *v1alpha1.BindingPolicy) {
 	if resolution := resolver.getResolution(bindingpolicy.GetName()); resolution != nil {
 		resolution.requiresSingletonReportedState = bindingpolicy.Spec.WantSingletonReportedState
+		resolution.setRolloutStrategy(bindingpolicy.Spec.RolloutStrategy)
+		resolution.setMaintenanceWindow(bindingpolicy.Spec.MaintenanceWindow)
+		resolution.setPriority(bindingpolicy.Spec.Priority)
 		return
 	}
 
@@ -199,7 +227,7 @@ func (resolver *bindingPolicyResolver) NoteBindingPolicy(bindingpolicy *v1alpha1
 
 // EnsureObjectData ensures that an object's identifier is
 // in the resolution for the given bindingpolicy key, and is associated
-// with the given resource-version, create-only bit, and statuscollectors set.
+// with the given resource-version, create-only bit, orphan bit, and statuscollectors set.
 // The given set is expected not to be mutated during and after this call
 // by the caller.
 //
@@ -207,7 +235,7 @@ func (resolver *bindingPolicyResolver) NoteBindingPolicy(bindingpolicy *v1alpha1
 // changed. If no resolution is associated with the given key, an error is
 // returned.
 func (resolver *bindingPolicyResolver) EnsureObjectData(bindingPolicyKey string, objIdentifier util.ObjectIdentifier,
-	objUID, resourceVersion string, createOnly bool, statusCollectors sets.Set[string]) (bool, error) {
+	objUID, resourceVersion string, createOnly, orphan bool, statusCollectors sets.Set[string]) (bool, error) {
 	bindingPolicyResolution := resolver.getResolution(bindingPolicyKey) // thread-safe
 
 	if bindingPolicyResolution == nil {
@@ -217,7 +245,9 @@ func (resolver *bindingPolicyResolver) EnsureObjectData(bindingPolicyKey string,
 	}
 
 	// ensureObjectIdentifier is thread-safe
-	return bindingPolicyResolution.ensureObjectData(objIdentifier, objUID, resourceVersion, createOnly, statusCollectors), nil
+	changed := bindingPolicyResolution.ensureObjectData(objIdentifier, objUID, resourceVersion, createOnly, orphan, statusCollectors)
+	resolver.objectToResolutionKeys.add(objIdentifier, bindingPolicyKey)
+	return changed, nil
 }
 
 // RemoveObjectIdentifier ensures the absence of the given object
@@ -235,7 +265,9 @@ func (resolver *bindingPolicyResolver) RemoveObjectIdentifier(bindingPolicyKey s
 	}
 
 	// removeObjectIdentifier is thread-safe
-	return bindingPolicyResolution.removeObjectIdentifier(objIdentifier)
+	changed := bindingPolicyResolution.removeObjectIdentifier(objIdentifier)
+	resolver.objectToResolutionKeys.remove(objIdentifier, bindingPolicyKey)
+	return changed
 }
 
 // GetObjectIdentifiers returns a copy of the object identifiers associated
@@ -306,11 +338,129 @@ func (resolver *bindingPolicyResolver) ResolutionRequiresSingletonReportedState(
 // DeleteResolution deletes the resolution associated with the given key,
 // if it exists.
 func (resolver *bindingPolicyResolver) DeleteResolution(bindingPolicyKey string) {
+	bindingPolicyResolution := resolver.deleteResolution(bindingPolicyKey)
+	if bindingPolicyResolution == nil {
+		return
+	}
+
+	bindingPolicyResolution.RLock()
+	defer bindingPolicyResolution.RUnlock()
+	for objIdentifier := range bindingPolicyResolution.objectIdentifierToData {
+		resolver.objectToResolutionKeys.remove(objIdentifier, bindingPolicyKey)
+	}
+}
+
+// deleteResolution removes the resolution associated with the given key from
+// the map and returns it, or nil if there was none.
+func (resolver *bindingPolicyResolver) deleteResolution(bindingPolicyKey string) *bindingPolicyResolution {
 	resolver.Lock() // lock for modifying map
 	defer resolver.Unlock()
 
+	bindingPolicyResolution := resolver.bindingPolicyToResolution[bindingPolicyKey]
 	delete(resolver.bindingPolicyToResolution, bindingPolicyKey)
 	resolver.broker.NotifyCallbacks(bindingPolicyKey)
+	return bindingPolicyResolution
+}
+
+// ResolutionConflict describes how two resolutions disagree.
+type ResolutionConflict struct {
+	// NumObjects is the number of objects that both resolutions send to
+	// some common destination with different settings.
+	NumObjects int
+	// Object is one of those objects.
+	Object util.ObjectIdentifier
+	// Destination is one of the common destinations of Object.
+	Destination string
+	// Settings names the settings in which the resolutions differ for Object.
+	Settings []string
+}
+
+// FindConflicts returns the conflicts between the resolution associated
+// with the given key and each other resolution, indexed by the other's key.
+// If no resolution is associated with the given key, nil is returned.
+func (resolver *bindingPolicyResolver) FindConflicts(bindingPolicyKey string) map[string]ResolutionConflict {
+	bindingPolicyResolution := resolver.getResolution(bindingPolicyKey) // thread-safe
+	if bindingPolicyResolution == nil {
+		return nil
+	}
+	conflicts := map[string]ResolutionConflict{}
+	for otherKey, views := range resolver.getOverlapViews(bindingPolicyKey, bindingPolicyResolution) {
+		if conflict, found := views.own.conflictWith(views.other); found {
+			conflicts[otherKey] = conflict
+		}
+	}
+	return conflicts
+}
+
+// FindOverlaps returns the keys of the other resolutions that send some
+// object to some destination that the resolution associated with the given
+// key also sends it to, whatever their priorities.
+// If no resolution is associated with the given key, nil is returned.
+func (resolver *bindingPolicyResolver) FindOverlaps(bindingPolicyKey string) sets.Set[string] {
+	bindingPolicyResolution := resolver.getResolution(bindingPolicyKey) // thread-safe
+	if bindingPolicyResolution == nil {
+		return nil
+	}
+	overlaps := sets.New[string]()
+	for otherKey, views := range resolver.getOverlapViews(bindingPolicyKey, bindingPolicyResolution) {
+		if views.own.overlaps(views.other) {
+			overlaps.Insert(otherKey)
+		}
+	}
+	return overlaps
+}
+
+// supersededDestinations returns, for each object of the given resolution that
+// some resolution of higher priority also sends to some of the same destinations,
+// those destinations. The given key is the one associated with the given resolution.
+func (resolver *bindingPolicyResolver) supersededDestinations(bindingPolicyKey string,
+	bindingPolicyResolution *bindingPolicyResolution) map[util.ObjectIdentifier]sets.Set[string] {
+	superseded := map[util.ObjectIdentifier]sets.Set[string]{}
+	for _, views := range resolver.getOverlapViews(bindingPolicyKey, bindingPolicyResolution) {
+		if views.other.priority <= views.own.priority {
+			continue
+		}
+		commonDestinations := views.own.destinations.Intersection(views.other.destinations)
+		if commonDestinations.Len() == 0 {
+			continue
+		}
+		for objIdentifier := range views.own.objectIdentifierToData {
+			if _, found := views.other.objectIdentifierToData[objIdentifier]; !found {
+				continue
+			}
+			if destinations, found := superseded[objIdentifier]; found {
+				destinations.Insert(commonDestinations.UnsortedList()...)
+			} else {
+				superseded[objIdentifier] = commonDestinations.Clone()
+			}
+		}
+	}
+	return superseded
+}
+
+// overlapViews holds views of two resolutions, each restricted to the objects
+// that both of them hold.
+type overlapViews struct {
+	own, other overlapView
+}
+
+// getOverlapViews returns, for each other resolution that holds some object of
+// the given one, the overlapViews of the two, indexed by the other's key.
+// The given key is the one associated with the given resolution.
+func (resolver *bindingPolicyResolver) getOverlapViews(bindingPolicyKey string,
+	bindingPolicyResolution *bindingPolicyResolution) map[string]overlapViews {
+	views := map[string]overlapViews{}
+	for otherKey, sharedObjects := range resolver.objectToResolutionKeys.sharedWith(bindingPolicyKey, bindingPolicyResolution) {
+		otherResolution := resolver.getResolution(otherKey)
+		if otherResolution == nil { // deleted in the meantime
+			continue
+		}
+		views[otherKey] = overlapViews{
+			own:   bindingPolicyResolution.getOverlapView(sharedObjects),
+			other: otherResolution.getOverlapView(sharedObjects),
+		}
+	}
+	return views
 }
 
 // Broker returns a ResolutionBroker for the resolver.
@@ -359,6 +509,9 @@ func (resolver *bindingPolicyResolver) createResolution(bindingpolicy *v1alpha1.
 		destinations:                   sets.New[string](),
 		ownerReference:                 ownerReference,
 		requiresSingletonReportedState: bindingpolicy.Spec.WantSingletonReportedState,
+		rolloutStrategy:                bindingpolicy.Spec.RolloutStrategy.DeepCopy(),
+		maintenanceWindow:              bindingpolicy.Spec.MaintenanceWindow.DeepCopy(),
+		priority:                       bindingpolicy.Spec.Priority,
 	}
 	resolver.bindingPolicyToResolution[bindingpolicy.GetName()] = bindingPolicyResolution
 
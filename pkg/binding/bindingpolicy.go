@@ -19,6 +19,8 @@ package binding
 import (
 	"context"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/go-logr/logr"
 
@@ -35,7 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
-	"github.com/kubestellar/kubestellar/pkg/ocm"
+	"github.com/kubestellar/kubestellar/pkg/abstract"
 	"github.com/kubestellar/kubestellar/pkg/util"
 )
 
@@ -54,6 +56,9 @@ const (
 //   - if binding policy wants singleton-status reported, requeue all selected
 //     workload objects to remove the singleton label.
 //   - delete the bindingpolicy's finalizer and remove its resolution.
+//
+// A bindingpolicy that this replica does not own is left to its owner;
+// only its resolution, if this replica had one, is dropped.
 func (c *Controller) syncBindingPolicy(ctx context.Context, bindingPolicyName string) error {
 	logger := klog.FromContext(ctx)
 
@@ -61,45 +66,88 @@ func (c *Controller) syncBindingPolicy(ctx context.Context, bindingPolicyName st
 	// `*bindingPolicy` is immutable
 	if errors.IsNotFound(err) {
 		// binding policy is deleted, update resolver.
+		if err := c.noteBindingPolicyOfOthers(ctx, bindingPolicyName, nil); err != nil {
+			return err
+		}
 		return c.deleteResolutionForBindingPolicy(ctx, bindingPolicyName)
 	} else if err != nil {
 		return fmt.Errorf("failed to get BindingPolicy from informer cache (name=%v): %w", bindingPolicyName, err)
 	}
 
+	if !c.sharder.Owns(bindingPolicyName) {
+		if c.bindingPolicyResolver.ResolutionExists(bindingPolicyName) {
+			logger.Info("BindingPolicy is no longer owned by this replica", "name", bindingPolicyName)
+			c.forgetBindingPolicy(ctx, bindingPolicyName)
+		}
+		// the singleton labels that this replica maintains depend on the bindingpolicies of others too
+		return c.noteBindingPolicyOfOthers(ctx, bindingPolicyName, bindingPolicy)
+	}
+	c.singletonPoliciesOfOthers.note(bindingPolicyName, nil) // this replica now resolves it
+
 	// handle requeing for changes in bindingpolicy, excluding deletion
 	if !isBeingDeleted(bindingPolicy) {
 		if err := c.handleBindingPolicyFinalizer(ctx, bindingPolicy); err != nil {
 			return fmt.Errorf("failed to handle finalizer for bindingPolicy %s: %w", bindingPolicy.Name, err)
 		}
 
+		// index the clauses of the bindingpolicy before noting it in the resolver,
+		// so that workload objects are tested against the current clauses
+		c.bindingPolicyIndex.noteBindingPolicy(bindingPolicy)
+
 		// note bindingpolicy in resolver to create/update its resolution
-		c.bindingPolicyResolver.NoteBindingPolicy(bindingPolicy)
+		resolvedPolicy, priorityErrs := c.withEffectivePriority(bindingPolicy)
+		c.bindingPolicyResolver.NoteBindingPolicy(resolvedPolicy)
 		logger.V(5).Info("Noted BindingPolicy", "bindingPolicy", bindingPolicy)
+		if len(priorityErrs) > 0 {
+			logger.Info("Rejected priority of BindingPolicy", "name", bindingPolicy.Name, "errs", priorityErrs)
+		}
 
 		// update bindingpolicy resolution destinations since bindingpolicy was updated
-		clusterSet, err := ocm.FindClustersBySelectors(ctx, c.clusterClient, bindingPolicy.Spec.ClusterSelectors)
-		if err != nil {
-			return fmt.Errorf("failed to ocm.FindClustersBySelectors: %w", err)
-		}
-		if len(clusterSet) == 0 {
-			logger.Info("No clusters are selected by BindingPolicy", "name", bindingPolicy.Name)
+		celErrs := c.objectCELPrograms.compileErrors(bindingPolicy)
+		if len(celErrs) > 0 {
+			logger.Info("Failed to compile objectCELExpressions of BindingPolicy", "name", bindingPolicy.Name, "errs", celErrs)
 		}
+		specErrs := append(priorityErrs, celErrs...)
 
-		if bindingPolicy.Spec.WantSingletonReportedState {
-			// if the bindingpolicy requires a singleton status, then we should only
-			// have one destination
-			// TODO: this should be removed once we have proper enforcement or error reporting for this
-			if len(clusterSet) > 1 {
-				clusterSet = pickSingleDestination(clusterSet)
+		clusterSet, unmet, err := c.scheduleBindingPolicy(ctx, bindingPolicy)
+		if err != nil {
+			// the scheduling configuration is not valid; keep the current destinations
+			logger.Info("Failed to schedule BindingPolicy", "name", bindingPolicy.Name, "err", err)
+			if err := c.updateBindingPolicyStatus(ctx, bindingPolicy, func(status *v1alpha1.BindingPolicyStatus) {
+				status.Errors = c.withExclusionErrors(bindingPolicy.Name, append(specErrs, err.Error()))
+				status.Conditions = setPausedCondition(status.Conditions, bindingPolicy.Spec.Suspend)
+			}); err != nil {
+				return err
+			}
+			// the Binding still has to follow other changes in the bindingpolicy, such as suspension
+			logger.V(4).Info("Enqueued Binding for syncing, while handling BindingPolicy", "name", bindingPolicy.Name)
+			c.enqueueBinding(bindingPolicy.GetName())
+		} else {
+			if len(clusterSet) == 0 {
+				logger.Info("No clusters are selected by BindingPolicy", "name", bindingPolicy.Name)
+			}
+			if err := c.updateBindingPolicyStatus(ctx, bindingPolicy, func(status *v1alpha1.BindingPolicyStatus) {
+				status.Errors = c.withExclusionErrors(bindingPolicy.Name, specErrs)
+				switch {
+				case len(bindingPolicy.Spec.SpreadConstraints) == 0:
+					status.Conditions = v1alpha1.RemoveCondition(status.Conditions, v1alpha1.TypeSpreadConstraintsSatisfied)
+				case len(unmet) == 0:
+					status.Conditions = v1alpha1.SetCondition(status.Conditions, v1alpha1.ConditionSpreadConstraintsMet())
+				default:
+					status.Conditions = v1alpha1.SetCondition(status.Conditions, v1alpha1.ConditionSpreadConstraintsUnmet(strings.Join(unmet, "; ")))
+				}
+				status.Conditions = setPausedCondition(status.Conditions, bindingPolicy.Spec.Suspend)
+			}); err != nil {
+				return err
 			}
-		}
 
-		// set destinations and enqueue binding for syncing
-		// we can skip handling the error since the call to BindingPolicyResolver::NoteBindingPolicy above
-		// guarantees that an error won't be returned here
-		_ = c.bindingPolicyResolver.SetDestinations(bindingPolicy.GetName(), clusterSet)
-		logger.V(4).Info("Enqueued Binding for syncing, while handling BindingPolicy", "name", bindingPolicy.Name)
-		c.enqueueBinding(bindingPolicy.GetName())
+			// set destinations and enqueue binding for syncing
+			// we can skip handling the error since the call to BindingPolicyResolver::NoteBindingPolicy above
+			// guarantees that an error won't be returned here
+			_ = c.bindingPolicyResolver.SetDestinations(bindingPolicy.GetName(), clusterSet)
+			logger.V(4).Info("Enqueued Binding for syncing, while handling BindingPolicy", "name", bindingPolicy.Name)
+			c.enqueueBinding(bindingPolicy.GetName())
+		}
 
 		// requeue all objects to account for changes in bindingpolicy.
 		// this does not include bindingpolicy/binding objects.
@@ -114,6 +162,21 @@ func (c *Controller) syncBindingPolicy(ctx context.Context, bindingPolicyName st
 	return c.deleteResolutionForBindingPolicy(ctx, bindingPolicyName)
 }
 
+// setPausedCondition sets the Ready condition to say ReconcilePaused if the bindingpolicy
+// is suspended, and otherwise removes a Ready condition that says ReconcilePaused.
+// Returns the updated slice of conditions.
+func setPausedCondition(conditions []v1alpha1.BindingPolicyCondition, suspended bool) []v1alpha1.BindingPolicyCondition {
+	if suspended {
+		return v1alpha1.SetCondition(conditions, v1alpha1.ConditionReconcilePaused())
+	}
+	for _, condition := range conditions {
+		if condition.Type == v1alpha1.TypeReady && condition.Reason == v1alpha1.ReasonReconcilePaused {
+			return v1alpha1.RemoveCondition(conditions, v1alpha1.TypeReady)
+		}
+	}
+	return conditions
+}
+
 func (c *Controller) deleteResolutionForBindingPolicy(ctx context.Context, bindingPolicyName string) error {
 	if c.bindingPolicyResolver.ResolutionRequiresSingletonReportedState(bindingPolicyName) {
 		// if the bindingpolicy required a singleton status, all selected objects should
@@ -123,11 +186,36 @@ func (c *Controller) deleteResolutionForBindingPolicy(ctx context.Context, bindi
 		}
 	}
 
+	c.forgetBindingPolicy(ctx, bindingPolicyName)
+	return nil
+}
+
+// forgetBindingPolicy removes the resolution of the given bindingpolicy and
+// everything else that this controller keeps in memory about it.
+func (c *Controller) forgetBindingPolicy(ctx context.Context, bindingPolicyName string) {
 	logger := klog.FromContext(ctx)
 	c.bindingPolicyResolver.DeleteResolution(bindingPolicyName)
+	c.bindingPolicyIndex.forgetBindingPolicy(bindingPolicyName)
+	c.objectCELPrograms.forget(bindingPolicyName)
+	c.exclusionErrors.forget(bindingPolicyName)
+	c.dependencyTracker.forgetBindingPolicy(bindingPolicyName)
+	c.forgetConflicts(bindingPolicyName)
+	c.forgetOverlaps(bindingPolicyName)
 	logger.Info("Deleted resolution for bindingpolicy", "name", bindingPolicyName)
+}
 
-	return nil
+// requeueAllBindingPolicies enqueues a reference to every BindingPolicy.
+// It is called when the ownership of BindingPolicies may have changed.
+func (c *Controller) requeueAllBindingPolicies() {
+	bindingPolicies, err := c.listBindingPolicies()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.logger.Info("Enqueuing all BindingPolicies because their ownership may have changed", "count", len(bindingPolicies))
+	for _, bindingPolicy := range bindingPolicies {
+		c.workqueue.Add(bindingPolicyRef(bindingPolicy.Name))
+	}
 }
 
 func (c *Controller) requeueSelectedWorkloadObjects(ctx context.Context, bindingPolicyName string) error {
@@ -276,69 +364,248 @@ type mrObject interface {
 	runtime.Object
 }
 
-// testObject tests if the object matches the given tests.
-// The returned tuple is:
-//   - bool: whether the object matches ANY of the tests
-//   - bool: whether any test that matches the object also says CreateOnly==true
-//   - sets.Set[string]: the UNION of the statuscollector names that appear within
-//     EACH of the tests that the object matches
-func (c *Controller) testObject(ctx context.Context, objIdentifier util.ObjectIdentifier, objLabels map[string]string,
-	tests []v1alpha1.DownsyncPolicyClause) (bool, bool, sets.Set[string]) {
-	gvr := schema.GroupVersionResource{
-		Group:    objIdentifier.GVK.Group,
-		Version:  objIdentifier.GVK.Version,
-		Resource: objIdentifier.Resource,
-	}
+// objectTestResult is the outcome of testing an object against a BindingPolicy.
+type objectTestResult struct {
+	// matched is whether the object matches ANY of the tests and NONE of the exclusions
+	matched bool
+	// createOnly is whether any test that matches the object also says CreateOnly==true
+	createOnly bool
+	// statusCollectors is the UNION of the statuscollector names that appear within
+	// EACH of the tests that the object matches
+	statusCollectors sets.Set[string]
+	// wantDependencies is whether any test that matches the object also says WantDependencies==true
+	wantDependencies bool
+	// orphan is whether the object is to be orphaned, i.e., whether any test that matches the
+	// object has DeletionPolicy==Orphan, either explicitly or by default from the BindingPolicy
+	orphan bool
+	// excluded is whether the object matches some test but also some exclusion
+	excluded bool
+	// exclusionErrors describe the exclusions that could not be evaluated for the object.
+	// Each of them is taken to match, i.e., to exclude the object.
+	exclusionErrors []string
+}
 
+// testObject tests if the object matches the downsync clauses of the given BindingPolicy
+// and does not match any of its downsync exclusions.
+// Only the clauses whose indices are in `clauses` are considered, or all of them if `clauses` is nil.
+// A clause that cannot be evaluated does not match, while an exclusion that cannot be
+// evaluated does (so that a broken exclusion does not let the object propagate).
+func (c *Controller) testObject(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, objIdentifier util.ObjectIdentifier,
+	obj mrObject, clauses sets.Set[int]) objectTestResult {
 	logger := klog.FromContext(ctx)
 
-	matchedStatusCollectors := sets.New[string]()
-	var matched, createOnly bool
+	result := objectTestResult{statusCollectors: sets.New[string]()}
 
-	var objNS *corev1.Namespace
-	for _, test := range tests {
-		if test.APIGroup != nil && (*test.APIGroup) != objIdentifier.GVK.Group {
+	subject := &objectUnderTest{identifier: objIdentifier, obj: obj}
+	for clauseIdx, test := range bindingPolicy.Spec.Downsync {
+		if clauses != nil && !clauses.Has(clauseIdx) {
 			continue
 		}
-		if len(test.Resources) > 0 && !(SliceContains(test.Resources, "*") ||
-			SliceContains(test.Resources, gvr.Resource)) {
+		passes, err := c.objectPassesTest(ctx, bindingPolicy, &test.DownsyncObjectTest, subject)
+		if err != nil {
+			logger.V(4).Info("Failed to evaluate downsync clause, assuming object does not match",
+				"objIdentifier", objIdentifier, "bindingPolicy", bindingPolicy.Name, "clauseIndex", clauseIdx, "err", err)
 			continue
 		}
-		if len(test.Namespaces) > 0 && !(SliceContains(test.Namespaces, "*") ||
-			SliceContains(test.Namespaces, objIdentifier.ObjectName.Namespace)) {
+		if !passes {
 			continue
 		}
-		if len(test.ObjectNames) > 0 && !(SliceContains(test.ObjectNames, "*") ||
-			SliceContains(test.ObjectNames, objIdentifier.ObjectName.Name)) {
+
+		logger.Info("Workload object matched test", "objIdentifier", objIdentifier, "objLabels", obj.GetLabels(), "test", test)
+		// test is a match
+		result.statusCollectors.Insert(test.StatusCollectors...)
+		result.matched = true
+		result.createOnly = result.createOnly || test.CreateOnly
+		result.wantDependencies = result.wantDependencies || test.WantDependencies
+		result.orphan = result.orphan || clauseDeletionPolicy(bindingPolicy, &test) == v1alpha1.DeletionPolicyOrphan
+	}
+	if !result.matched {
+		return objectTestResult{statusCollectors: result.statusCollectors}
+	}
+
+	if excluded, exclusionErrors := c.testExclusions(ctx, bindingPolicy, subject); excluded {
+		return objectTestResult{statusCollectors: sets.New[string](), excluded: true, exclusionErrors: exclusionErrors}
+	}
+
+	return result
+}
+
+// testExclusions tests if the object matches any of the downsync exclusions of the given BindingPolicy.
+// An exclusion that cannot be evaluated is taken to match; the returned strings describe those.
+func (c *Controller) testExclusions(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, subject *objectUnderTest) (bool, []string) {
+	logger := klog.FromContext(ctx)
+	objIdentifier := subject.identifier
+	var exclusionErrors []string
+	for idx := range bindingPolicy.Spec.DownsyncExclusions {
+		exclusion := &bindingPolicy.Spec.DownsyncExclusions[idx]
+		passes, err := c.objectPassesTest(ctx, bindingPolicy, exclusion, subject)
+		if err != nil {
+			logger.Info("Failed to evaluate downsync exclusion, assuming object is excluded",
+				"objIdentifier", objIdentifier, "bindingPolicy", bindingPolicy.Name, "exclusionIndex", idx, "err", err)
+			exclusionErrors = append(exclusionErrors, describeExclusionError(idx, objIdentifier, err))
+			continue
+		}
+		if passes {
+			logger.V(4).Info("Workload object matched exclusion", "objIdentifier", objIdentifier, "bindingPolicy", bindingPolicy.Name, "exclusionIndex", idx)
+			return true, exclusionErrors
+		}
+	}
+	return len(exclusionErrors) > 0, exclusionErrors
+}
+
+// needObjectContent tells whether testing the given object against the given BindingPolicies,
+// or finding its dependencies, needs more than the object's metadata.
+// The BindingPolicies that this replica resolves count, and of those only the
+// clauses that the bindingPolicyIndex finds for the object (all of them, for a
+// BindingPolicy not yet indexed in its current generation). So do the singleton
+// BindingPolicies of other replicas, when this replica maintains the object's singleton label.
+// The given candidates are the result of bindingPolicyIndex.candidateClauses for the object.
+func (c *Controller) needObjectContent(bindingPolicies []*v1alpha1.BindingPolicy, objIdentifier util.ObjectIdentifier,
+	candidates map[string]sets.Set[int]) bool {
+	for _, bindingPolicy := range bindingPolicies {
+		name := bindingPolicy.GetName()
+		if !c.sharder.Owns(name) {
+			// the replica that maintains the object's singleton label tests it against every singleton bindingpolicy
+			if bindingPolicy.Spec.WantSingletonReportedState && c.ownsSingletonLabel(objIdentifier) &&
+				clausesNeedObjectContent(bindingPolicy, nil) {
+				return true
+			}
 			continue
 		}
-		if len(test.ObjectSelectors) > 0 && !labelsMatchAny(c.logger, objLabels, test.ObjectSelectors) {
+		if !c.bindingPolicyResolver.ResolutionExists(name) {
 			continue
 		}
-		if len(test.NamespaceSelectors) > 0 && !ALabelSelectorIsEmpty(test.NamespaceSelectors...) {
-			if objNS == nil {
-				var err error
-				objNS, err = c.kubernetesClient.CoreV1().Namespaces().Get(context.TODO(),
-					objIdentifier.ObjectName.Namespace, metav1.GetOptions{})
-				if err != nil {
-					logger.Info("Object namespace not found, assuming object does not match",
-						"object identifier", objIdentifier)
+		var clauses sets.Set[int] // nil means every clause
+		if c.bindingPolicyIndex.isCurrent(bindingPolicy) {
+			var isCandidate bool
+			if clauses, isCandidate = candidates[name]; !isCandidate {
+				if !c.dependencyTracker.isDependency(name, objIdentifier) {
 					continue
 				}
+				clauses = sets.New[int]() // only the exclusions get tested
 			}
-			if !labelsMatchAny(logger, objNS.Labels, test.NamespaceSelectors) {
-				continue
-			}
 		}
+		if clausesNeedObjectContent(bindingPolicy, clauses) {
+			return true
+		}
+	}
+	return false
+}
 
-		klog.FromContext(ctx).Info("Workload object matched test", "objIdentifier", objIdentifier, "objLabels", objLabels, "test", test)
-		// test is a match
-		matchedStatusCollectors.Insert(test.StatusCollectors...)
-		matched = true
-		createOnly = createOnly || test.CreateOnly
+// clausesNeedObjectContent tells whether testing an object against the given clauses
+// (nil meaning all) and the exclusions of the given BindingPolicy, or finding the
+// dependencies of an object matched by those clauses, needs more than the object's metadata.
+func clausesNeedObjectContent(bindingPolicy *v1alpha1.BindingPolicy, clauses sets.Set[int]) bool {
+	hasCEL := func(test *v1alpha1.DownsyncObjectTest) bool {
+		return test.ObjectCELExpression != nil && len(*test.ObjectCELExpression) > 0
+	}
+	for idx := range bindingPolicy.Spec.Downsync {
+		if clauses != nil && !clauses.Has(idx) {
+			continue
+		}
+		clause := &bindingPolicy.Spec.Downsync[idx]
+		if clause.WantDependencies || hasCEL(&clause.DownsyncObjectTest) {
+			return true
+		}
+	}
+	for idx := range bindingPolicy.Spec.DownsyncExclusions {
+		if hasCEL(&bindingPolicy.Spec.DownsyncExclusions[idx]) {
+			return true
+		}
+	}
+	return false
+}
+
+// clauseDeletionPolicy returns the deletion policy that applies to the objects selected
+// by the given clause of the given BindingPolicy.
+func clauseDeletionPolicy(bindingPolicy *v1alpha1.BindingPolicy, clause *v1alpha1.DownsyncPolicyClause) v1alpha1.DeletionPolicy {
+	if clause.DeletionPolicy != "" {
+		return clause.DeletionPolicy
 	}
+	return bindingPolicy.Spec.DeletionPolicy
+}
 
-	return matched, createOnly, matchedStatusCollectors
+// objectUnderTest is an object being tested against DownsyncObjectTests,
+// along with the related data that are fetched or computed on demand.
+type objectUnderTest struct {
+	identifier util.ObjectIdentifier
+	obj        mrObject
+	namespace  *corev1.Namespace      // nil until fetched
+	content    map[string]interface{} // nil until computed
+}
+
+// objectPassesTest tests if the object satisfies every criterion of the given test,
+// which appears in the given BindingPolicy.
+// An error is returned if some criterion could not be evaluated.
+func (c *Controller) objectPassesTest(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, test *v1alpha1.DownsyncObjectTest,
+	subject *objectUnderTest) (bool, error) {
+	logger := klog.FromContext(ctx)
+	objIdentifier := subject.identifier
+
+	if test.APIGroup != nil && (*test.APIGroup) != objIdentifier.GVK.Group {
+		return false, nil
+	}
+	if len(test.Resources) > 0 && !(SliceContains(test.Resources, "*") ||
+		SliceContains(test.Resources, objIdentifier.Resource)) {
+		return false, nil
+	}
+	if len(test.Namespaces) > 0 && !(SliceContains(test.Namespaces, "*") ||
+		SliceContains(test.Namespaces, objIdentifier.ObjectName.Namespace)) {
+		return false, nil
+	}
+	if len(test.ObjectNames) > 0 && !nameMatchesAny(test.ObjectNames, objIdentifier.ObjectName.Name) {
+		return false, nil
+	}
+	if len(test.ObjectSelectors) > 0 && !labelsMatchAny(c.logger, subject.obj.GetLabels(), test.ObjectSelectors) {
+		return false, nil
+	}
+	if len(test.NamespaceSelectors) > 0 && !ALabelSelectorIsEmpty(test.NamespaceSelectors...) {
+		if objIdentifier.ObjectName.Namespace == "" {
+			return false, nil // a cluster-scoped object is in no namespace
+		}
+		if subject.namespace == nil {
+			objNS, err := c.namespaceLister.Get(objIdentifier.ObjectName.Namespace)
+			if err != nil {
+				return false, fmt.Errorf("failed to get the namespace of the object: %w", err)
+			}
+			subject.namespace = objNS
+		}
+		if !labelsMatchAny(logger, subject.namespace.Labels, test.NamespaceSelectors) {
+			return false, nil
+		}
+	}
+	if test.ObjectCELExpression != nil && len(*test.ObjectCELExpression) > 0 {
+		if subject.content == nil {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(subject.obj)
+			if err != nil {
+				return false, fmt.Errorf("failed to convert object for CEL evaluation: %w", err)
+			}
+			subject.content = content
+		}
+		matches, err := c.objectCELPrograms.evaluate(bindingPolicy, *test.ObjectCELExpression, subject.content)
+		if err != nil {
+			return false, fmt.Errorf("failed to evaluate objectCELExpression: %w", err)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// nameMatchesAny tests if the given name matches any of the given patterns,
+// which use the syntax of path.Match.
+// A malformed pattern matches only the identical name.
+func nameMatchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if pattern == name {
+			return true
+		}
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
 }
 
 func labelsMatchAny(logger logr.Logger, labelSet map[string]string, selectors []metav1.LabelSelector) bool {
@@ -373,8 +640,43 @@ func SliceContains[Elt comparable](slice []Elt, seek Elt) bool {
 	return false
 }
 
-// pickSingleDestination sorts clusters by name and picks first cluster so that the choice is deterministic based on names
-// pickSingleDestination expects a non-empty clusterSet
-func pickSingleDestination(clusterSet sets.Set[string]) sets.Set[string] {
-	return sets.New(sets.List(clusterSet)[0])
+// scheduleBindingPolicy runs the scheduler over the inventory to choose the
+// destinations of the given BindingPolicy. Also returns a description of each
+// way in which the spread constraints are not met.
+// `*bindingPolicy` is immutable.
+func (c *Controller) scheduleBindingPolicy(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy) (sets.Set[string], []string, error) {
+	clusters, err := c.clusterLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.scheduler.Schedule(ctx, bindingPolicy, clusters)
+}
+
+// updateBindingPolicyStatus applies the given edit to a copy of the status of
+// the given BindingPolicy, with the observed generation set to the current one,
+// and writes the result if it differs from the current status.
+// `*bindingPolicy` is immutable.
+func (c *Controller) updateBindingPolicyStatus(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, edit func(*v1alpha1.BindingPolicyStatus)) error {
+	status := bindingPolicy.Status.DeepCopy()
+	status.ObservedGeneration = bindingPolicy.Generation
+	if status.Conditions == nil {
+		status.Conditions = []v1alpha1.BindingPolicyCondition{}
+	}
+	edit(status)
+	if bindingPolicy.Status.ObservedGeneration == status.ObservedGeneration && bindingPolicy.Status.Conditions != nil &&
+		abstract.SliceEqual(bindingPolicy.Status.Errors, status.Errors) &&
+		v1alpha1.AreConditionSlicesSame(bindingPolicy.Status.Conditions, status.Conditions) {
+		return nil
+	}
+	bindingPolicy = bindingPolicy.DeepCopy()
+	bindingPolicy.Status = *status
+	bpEcho, err := c.controlClient.BindingPolicies().UpdateStatus(ctx, bindingPolicy, metav1.UpdateOptions{FieldManager: ControllerName})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil // the BindingPolicy was deleted after getting into this function.
+		}
+		return fmt.Errorf("failed to update status of BindingPolicy %s: %w", bindingPolicy.Name, err)
+	}
+	klog.FromContext(ctx).V(3).Info("Updated BindingPolicy status", "name", bindingPolicy.Name, "resourceVersion", bpEcho.ResourceVersion)
+	return nil
 }
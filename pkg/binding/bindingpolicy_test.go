@@ -0,0 +1,341 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	ksfake "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/fake"
+	controllisters "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+func testWorkloadObject(kind, resource, namespace, name string, labels map[string]string) (util.ObjectIdentifier, mrObject) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return util.IdentifierForObject(obj, resource), obj
+}
+
+// TestTestObject tests the matching of workload objects against downsync clauses and exclusions
+func TestTestObject(t *testing.T) {
+	noPropagate := []metav1.LabelSelector{{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "kubestellar.io/no-propagate", Operator: metav1.LabelSelectorOpExists},
+	}}}
+	spec := v1alpha1.BindingPolicySpec{
+		Downsync: []v1alpha1.DownsyncPolicyClause{
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Namespaces: []string{"app"}}},
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"configmaps"}, ObjectNames: []string{"shared-*"}}},
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"deployments"},
+				NamespaceSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"propagate": "true"}}}}},
+		},
+		DownsyncExclusions: []v1alpha1.DownsyncObjectTest{
+			{Resources: []string{"secrets"}, ObjectNames: []string{"*-local"}},
+			{ObjectSelectors: noPropagate},
+		},
+	}
+	testCases := []struct {
+		name     string
+		kind     string
+		resource string
+		ns       string
+		objName  string
+		labels   map[string]string
+		expected bool
+	}{
+		{name: "in namespace", kind: "ConfigMap", resource: "configmaps", ns: "app", objName: "cm", expected: true},
+		{name: "other namespace", kind: "ConfigMap", resource: "configmaps", ns: "other", objName: "cm"},
+		{name: "name pattern", kind: "ConfigMap", resource: "configmaps", ns: "other", objName: "shared-cm", expected: true},
+		{name: "secret not excluded", kind: "Secret", resource: "secrets", ns: "app", objName: "creds", expected: true},
+		{name: "secret excluded by name", kind: "Secret", resource: "secrets", ns: "app", objName: "creds-local"},
+		{name: "excluded by label", kind: "ConfigMap", resource: "configmaps", ns: "app", objName: "cm",
+			labels: map[string]string{"kubestellar.io/no-propagate": ""}},
+		{name: "namespace selected", kind: "Deployment", resource: "deployments", ns: "labeled", objName: "d", expected: true},
+		{name: "namespace not selected", kind: "Deployment", resource: "deployments", ns: "plain", objName: "d"},
+		{name: "namespace unknown", kind: "Deployment", resource: "deployments", ns: "unknown", objName: "d"},
+	}
+
+	ocp, err := newObjectCELPrograms()
+	if err != nil {
+		t.Fatalf("failed to make objectCELPrograms: %v", err)
+	}
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for name, labels := range map[string]map[string]string{"labeled": {"propagate": "true"}, "plain": nil} {
+		if err := namespaceIndexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}); err != nil {
+			t.Fatalf("failed to add namespace: %v", err)
+		}
+	}
+	c := &Controller{logger: klog.Background(), objectCELPrograms: ocp, namespaceLister: corev1listers.NewNamespaceLister(namespaceIndexer)}
+	bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp"}, Spec: spec}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objIdentifier, obj := testWorkloadObject(tc.kind, tc.resource, tc.ns, tc.objName, tc.labels)
+			matched := c.testObject(context.Background(), bindingPolicy, objIdentifier, obj, nil).matched
+			if matched != tc.expected {
+				t.Errorf("expected match %v, got %v", tc.expected, matched)
+			}
+		})
+	}
+}
+
+// TestTestObjectOrphan tests how the deletion policies of the matching clauses combine
+func TestTestObjectOrphan(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   v1alpha1.DeletionPolicy
+		clauses  []v1alpha1.DeletionPolicy
+		expected bool
+	}{
+		{name: "default", clauses: []v1alpha1.DeletionPolicy{""}},
+		{name: "policy orphan", policy: v1alpha1.DeletionPolicyOrphan, clauses: []v1alpha1.DeletionPolicy{""}, expected: true},
+		{name: "clause overrides policy", policy: v1alpha1.DeletionPolicyOrphan, clauses: []v1alpha1.DeletionPolicy{v1alpha1.DeletionPolicyDelete}},
+		{name: "any clause orphans", clauses: []v1alpha1.DeletionPolicy{v1alpha1.DeletionPolicyDelete, v1alpha1.DeletionPolicyOrphan}, expected: true},
+	}
+	c := &Controller{logger: klog.Background()}
+	objIdentifier, obj := testWorkloadObject("ConfigMap", "configmaps", "app", "cm", nil)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp"},
+				Spec: v1alpha1.BindingPolicySpec{DeletionPolicy: tc.policy}}
+			for _, deletionPolicy := range tc.clauses {
+				bindingPolicy.Spec.Downsync = append(bindingPolicy.Spec.Downsync, v1alpha1.DownsyncPolicyClause{
+					DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Namespaces: []string{"app"}},
+					DeletionPolicy:     deletionPolicy,
+				})
+			}
+			result := c.testObject(context.Background(), bindingPolicy, objIdentifier, obj, nil)
+			matched, orphan := result.matched, result.orphan
+			if !matched || orphan != tc.expected {
+				t.Errorf("expected match with orphan=%v, got matched=%v orphan=%v", tc.expected, matched, orphan)
+			}
+		})
+	}
+}
+
+// TestTestObjectExclusionErrors tests that an exclusion that cannot be evaluated excludes the object
+func TestTestObjectExclusionErrors(t *testing.T) {
+	brokenCEL := v1alpha1.Expression("obj.spec.missing == 1")
+	testCases := []struct {
+		name      string
+		exclusion v1alpha1.DownsyncObjectTest
+		ns        string
+		expected  bool
+	}{
+		{name: "CEL evaluation fails", exclusion: v1alpha1.DownsyncObjectTest{ObjectCELExpression: &brokenCEL}, ns: "app"},
+		{name: "namespace unknown", ns: "app",
+			exclusion: v1alpha1.DownsyncObjectTest{NamespaceSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"local": "true"}}}}},
+		{name: "cluster-scoped object has no namespace to select", expected: true,
+			exclusion: v1alpha1.DownsyncObjectTest{NamespaceSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"local": "true"}}}}},
+	}
+	ocp, err := newObjectCELPrograms()
+	if err != nil {
+		t.Fatalf("failed to make objectCELPrograms: %v", err)
+	}
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	c := &Controller{logger: klog.Background(), objectCELPrograms: ocp, namespaceLister: corev1listers.NewNamespaceLister(namespaceIndexer)}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp"}, Spec: v1alpha1.BindingPolicySpec{
+				Downsync:           []v1alpha1.DownsyncPolicyClause{{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"*"}}}},
+				DownsyncExclusions: []v1alpha1.DownsyncObjectTest{tc.exclusion},
+			}}
+			objIdentifier, obj := testWorkloadObject("ConfigMap", "configmaps", tc.ns, "cm", nil)
+			result := c.testObject(context.Background(), bindingPolicy, objIdentifier, obj, nil)
+			if result.matched != tc.expected {
+				t.Errorf("expected match %v, got %v", tc.expected, result.matched)
+			}
+			if hasErrors := len(result.exclusionErrors) > 0; hasErrors == tc.expected {
+				t.Errorf("expected exclusion errors only when not matched, got %v", result.exclusionErrors)
+			}
+		})
+	}
+}
+
+// TestTestExclusions tests that the exclusions are told apart from a mere lack of match,
+// so that an excluded object is not taken in as a dependency either
+func TestTestExclusions(t *testing.T) {
+	c := &Controller{logger: klog.Background()}
+	bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp"}, Spec: v1alpha1.BindingPolicySpec{
+		Downsync:           []v1alpha1.DownsyncPolicyClause{{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"deployments", "secrets"}}}},
+		DownsyncExclusions: []v1alpha1.DownsyncObjectTest{{Resources: []string{"secrets"}, ObjectNames: []string{"*-local"}}},
+	}}
+	objIdentifier, obj := testWorkloadObject("Secret", "secrets", "app", "creds-local", nil)
+	if result := c.testObject(context.Background(), bindingPolicy, objIdentifier, obj, nil); result.matched || !result.excluded {
+		t.Errorf("expected an excluded object, got matched=%v excluded=%v", result.matched, result.excluded)
+	}
+	bindingPolicy.Spec.Downsync[0].Resources = []string{"deployments"}
+	if result := c.testObject(context.Background(), bindingPolicy, objIdentifier, obj, nil); result.matched || result.excluded {
+		t.Errorf("expected an object that is merely not matched, got matched=%v excluded=%v", result.matched, result.excluded)
+	}
+	if excluded, _ := c.testExclusions(context.Background(), bindingPolicy, &objectUnderTest{identifier: objIdentifier, obj: obj}); !excluded {
+		t.Error("expected the exclusions to apply to an object that no clause matches")
+	}
+}
+
+// TestNeedObjectContent tests that an object's content is fetched only for the
+// clauses that it is a candidate for, of the bindingpolicies that this replica resolves
+func TestNeedObjectContent(t *testing.T) {
+	celExpression := v1alpha1.Expression("object.data.enabled == 'true'")
+	bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp", Generation: 1}, Spec: v1alpha1.BindingPolicySpec{
+		Downsync: []v1alpha1.DownsyncPolicyClause{
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"configmaps"}, ObjectCELExpression: &celExpression}},
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"secrets"}}},
+		},
+	}}
+	c := &Controller{logger: klog.Background(), sharder: sharding.Everything, bindingPolicyResolver: NewBindingPolicyResolver(),
+		bindingPolicyIndex: newBindingPolicyIndex(), dependencyTracker: newDependencyTracker()}
+	c.bindingPolicyIndex.noteBindingPolicy(bindingPolicy)
+	c.bindingPolicyResolver.NoteBindingPolicy(bindingPolicy)
+	need := func(objIdentifier util.ObjectIdentifier) bool {
+		candidates := c.bindingPolicyIndex.candidateClauses(objIdentifier.GVK.Group, objIdentifier.Resource, objIdentifier.ObjectName.Namespace)
+		return c.needObjectContent([]*v1alpha1.BindingPolicy{bindingPolicy}, objIdentifier, candidates)
+	}
+	configMap, _ := testWorkloadObject("ConfigMap", "configmaps", "app", "cm", nil)
+	secret, _ := testWorkloadObject("Secret", "secrets", "app", "creds", nil)
+	if !need(configMap) {
+		t.Error("expected the content of an object that a clause with a CEL expression may match to be needed")
+	}
+	if need(secret) {
+		t.Error("expected the content of an object that only a clause without CEL may match to not be needed")
+	}
+	bindingPolicy.Generation = 2 // not yet indexed, so every clause gets tested
+	if !need(secret) {
+		t.Error("expected the content to be needed for a bindingpolicy that is not indexed in its current generation")
+	}
+	c.bindingPolicyResolver.DeleteResolution(bindingPolicy.Name)
+	if need(configMap) {
+		t.Error("expected the content to not be needed for a bindingpolicy that this replica does not resolve")
+	}
+}
+
+func TestSetPausedCondition(t *testing.T) {
+	paused := v1alpha1.ConditionReconcilePaused()
+	spread := v1alpha1.ConditionSpreadConstraintsMet()
+	ready := v1alpha1.ConditionAvailable()
+	testCases := []struct {
+		name       string
+		conditions []v1alpha1.BindingPolicyCondition
+		suspended  bool
+		expected   []v1alpha1.BindingPolicyCondition
+	}{
+		{name: "suspend", conditions: []v1alpha1.BindingPolicyCondition{spread}, suspended: true,
+			expected: []v1alpha1.BindingPolicyCondition{spread, paused}},
+		{name: "stay suspended", conditions: []v1alpha1.BindingPolicyCondition{paused, spread}, suspended: true,
+			expected: []v1alpha1.BindingPolicyCondition{paused, spread}},
+		{name: "resume", conditions: []v1alpha1.BindingPolicyCondition{spread, paused}, suspended: false,
+			expected: []v1alpha1.BindingPolicyCondition{spread}},
+		{name: "other ready reason kept", conditions: []v1alpha1.BindingPolicyCondition{ready}, suspended: false,
+			expected: []v1alpha1.BindingPolicyCondition{ready}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := setPausedCondition(tc.conditions, tc.suspended)
+			if !v1alpha1.AreConditionSlicesSame(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// ownerSharder is a replica that owns every key while it is the current owner.
+type ownerSharder struct {
+	owner    *string
+	identity string
+}
+
+func (s ownerSharder) Owns(key string) bool { return *s.owner == s.identity }
+
+func (ownerSharder) AddOwnershipChangeHandler(handler func()) func() { return func() {} }
+
+// TestBindingPolicyHandover tests that, when a bindingpolicy changes owner, the former owner
+// forgets its resolution and the new owner resolves it.
+func TestBindingPolicyHandover(t *testing.T) {
+	ctx := context.Background()
+	bindingPolicy := &v1alpha1.BindingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "bp", Generation: 1, Finalizers: []string{KSFinalizer}},
+		Spec: v1alpha1.BindingPolicySpec{Downsync: []v1alpha1.DownsyncPolicyClause{
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"configmaps"}}},
+		}},
+	}
+	controlClient := ksfake.NewSimpleClientset(bindingPolicy).ControlV1alpha1()
+	policyIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := policyIndexer.Add(bindingPolicy); err != nil {
+		t.Fatalf("failed to add BindingPolicy: %v", err)
+	}
+	clusterLister := clusterlisters.NewManagedClusterLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	owner := "a"
+	newReplica := func(identity string) *Controller {
+		ocp, err := newObjectCELPrograms()
+		if err != nil {
+			t.Fatalf("failed to make objectCELPrograms: %v", err)
+		}
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		t.Cleanup(queue.ShutDown)
+		return &Controller{logger: klog.Background(), sharder: ownerSharder{owner: &owner, identity: identity},
+			controlClient: controlClient, bindingPolicyLister: controllisters.NewBindingPolicyLister(policyIndexer),
+			clusterLister: clusterLister, listers: util.NewConcurrentMap[schema.GroupVersionResource, cache.GenericLister](),
+			bindingPolicyResolver: NewBindingPolicyResolver(), bindingPolicyIndex: newBindingPolicyIndex(), scheduler: NewScheduler(),
+			objectCELPrograms: ocp, exclusionErrors: newExclusionErrorTracker(), dependencyTracker: newDependencyTracker(),
+			conflictTracker: newConflictTracker(), overlapTracker: newOverlapTracker(),
+			singletonPoliciesOfOthers: newSingletonPoliciesOfOthers(), workqueue: queue}
+	}
+	a, b := newReplica("a"), newReplica("b")
+	expectResolved := func(c *Controller, identity string, expected bool) {
+		t.Helper()
+		if actual := c.bindingPolicyResolver.ResolutionExists(bindingPolicy.Name); actual != expected {
+			t.Errorf("expected replica %s to have a resolution: %v, got %v", identity, expected, actual)
+		}
+		if actual := len(c.bindingPolicyIndex.candidateClauses("", "configmaps", "app")) > 0; actual != expected {
+			t.Errorf("expected replica %s to index the clauses: %v, got %v", identity, expected, actual)
+		}
+	}
+
+	for _, c := range []*Controller{a, b} {
+		if err := c.syncBindingPolicy(ctx, bindingPolicy.Name); err != nil {
+			t.Fatalf("failed to sync BindingPolicy: %v", err)
+		}
+	}
+	expectResolved(a, "a", true)
+	expectResolved(b, "b", false)
+
+	// the ownership moves, and both replicas revisit the bindingpolicy
+	owner = "b"
+	for _, c := range []*Controller{a, b} {
+		if err := c.syncBindingPolicy(ctx, bindingPolicy.Name); err != nil {
+			t.Fatalf("failed to sync BindingPolicy: %v", err)
+		}
+	}
+	expectResolved(a, "a", false)
+	expectResolved(b, "b", true)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8smetrics "k8s.io/component-base/metrics"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	ksmetrics "github.com/kubestellar/kubestellar/pkg/metrics"
+)
+
+// conflictTracker remembers, for each bindingpolicy, the other bindingpolicies
+// that its resolution was last found to conflict with.
+// This is what tells the controller which other bindingpolicies to revisit
+// when the conflicts of one change, since the relation is symmetric.
+type conflictTracker struct {
+	sync.Mutex
+	// partners maps the name of a bindingpolicy to the names of the others it conflicts with.
+	// Bindingpolicies without conflicts have no entry.
+	partners map[string]sets.Set[string]
+	sampler  ksmetrics.Sampler
+}
+
+func newConflictTracker() *conflictTracker {
+	ct := &conflictTracker{partners: map[string]sets.Set[string]{}}
+	ct.sampler = ksmetrics.NewSampler(ct.numConflicting,
+		&k8smetrics.KubeOpts{Namespace: "kubestellar", Subsystem: "binding_controller",
+			Name: "conflicting_bindingpolicies", Help: "number of BindingPolicy objects that conflict with some other",
+			StabilityLevel: k8smetrics.ALPHA})
+	return ct
+}
+
+// setPartners records the partners of the given bindingpolicy and returns the
+// names of the bindingpolicies that were added or removed.
+func (ct *conflictTracker) setPartners(bindingPolicyName string, partners sets.Set[string]) sets.Set[string] {
+	ct.Lock()
+	defer ct.Unlock()
+	old := ct.partners[bindingPolicyName]
+	if partners.Len() == 0 {
+		delete(ct.partners, bindingPolicyName)
+	} else {
+		ct.partners[bindingPolicyName] = partners
+	}
+	changed := partners.SymmetricDifference(old)
+	if changed.Len() > 0 {
+		ct.sampler.Prod()
+	}
+	return changed
+}
+
+// forget removes the given bindingpolicy and returns its partners.
+func (ct *conflictTracker) forget(bindingPolicyName string) sets.Set[string] {
+	return ct.setPartners(bindingPolicyName, sets.New[string]())
+}
+
+func (ct *conflictTracker) numConflicting() float64 {
+	ct.Lock()
+	defer ct.Unlock()
+	return float64(len(ct.partners))
+}
+
+// RegisterMetrics registers the metrics of the binding controller with the given registry.
+func (c *Controller) RegisterMetrics(reg ksmetrics.RegisterFn) {
+	ksmetrics.MustRegister(reg, c.conflictTracker.sampler)
+}
+
+// updateConflicts finds the current conflicts of the resolution of the given
+// bindingpolicy, enqueues the bindings of the bindingpolicies that newly conflict
+// or stopped conflicting with it, and returns the ConflictFree condition to report.
+func (c *Controller) updateConflicts(bindingPolicyName string) v1alpha1.BindingPolicyCondition {
+	conflicts := c.bindingPolicyResolver.FindConflicts(bindingPolicyName)
+	partners := sets.KeySet(conflicts)
+	for _, partner := range sets.List(c.conflictTracker.setPartners(bindingPolicyName, partners)) {
+		c.enqueueBinding(partner)
+	}
+	if len(conflicts) == 0 {
+		return v1alpha1.ConditionNoConflicts()
+	}
+	descriptions := make([]string, 0, len(conflicts))
+	for _, partner := range sets.List(partners) {
+		descriptions = append(descriptions, describeConflict(partner, conflicts[partner]))
+	}
+	return v1alpha1.ConditionOverlapConflicts(strings.Join(descriptions, "; "))
+}
+
+// forgetConflicts enqueues the bindings of the bindingpolicies that conflicted
+// with the given one, which is going away.
+func (c *Controller) forgetConflicts(bindingPolicyName string) {
+	for _, partner := range sets.List(c.conflictTracker.forget(bindingPolicyName)) {
+		c.enqueueBinding(partner)
+	}
+}
+
+func describeConflict(partner string, conflict ResolutionConflict) string {
+	objIdentifier := conflict.Object
+	return fmt.Sprintf("BindingPolicy %q differs on %d object(s), e.g. %s %s to %s: %s",
+		partner, conflict.NumObjects, objIdentifier.GVK.GroupKind(), objIdentifier.ObjectName, conflict.Destination, strings.Join(conflict.Settings, ", "))
+}
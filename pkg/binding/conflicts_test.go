@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+func TestFindConflicts(t *testing.T) {
+	configMap := func(name string) util.ObjectIdentifier {
+		return util.ObjectIdentifier{GVK: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, Resource: "configmaps",
+			ObjectName: cache.ObjectName{Namespace: "ns1", Name: name}}
+	}
+	resolver := NewBindingPolicyResolver()
+	note := func(name string, singleton bool, destinations ...string) {
+		resolver.NoteBindingPolicy(&v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.BindingPolicySpec{WantSingletonReportedState: singleton}})
+		if err := resolver.SetDestinations(name, sets.New(destinations...)); err != nil {
+			t.Fatalf("failed to set destinations of %s: %v", name, err)
+		}
+	}
+	ensure := func(name string, objIdentifier util.ObjectIdentifier, createOnly bool, statusCollectors ...string) {
+		if _, err := resolver.EnsureObjectData(name, objIdentifier, "uid-"+objIdentifier.ObjectName.Name, "1",
+			createOnly, false, sets.New(statusCollectors...)); err != nil {
+			t.Fatalf("failed to ensure object data in %s: %v", name, err)
+		}
+	}
+	note("bp1", false, "c1", "c2")
+	ensure("bp1", configMap("a"), false)
+	ensure("bp1", configMap("b"), false, "sc1")
+	ensure("bp1", configMap("c"), false)
+	// bp2 agrees about a, and differs about b and c
+	note("bp2", false, "c2", "c3")
+	ensure("bp2", configMap("a"), false)
+	ensure("bp2", configMap("b"), true)
+	ensure("bp2", configMap("c"), true)
+	// bp3 differs about a, but has no destination in common with bp1
+	note("bp3", false, "c3")
+	ensure("bp3", configMap("a"), true)
+	// bp4 only differs about singleton status reporting
+	note("bp4", true, "c1")
+	ensure("bp4", configMap("c"), false)
+
+	expected := map[string]ResolutionConflict{
+		"bp2": {NumObjects: 2, Object: configMap("b"), Destination: "c2", Settings: []string{"createOnly", "statusCollectors"}},
+		"bp4": {NumObjects: 1, Object: configMap("c"), Destination: "c1", Settings: []string{"wantSingletonReportedState"}},
+	}
+	if actual := resolver.FindConflicts("bp1"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected conflicts %+v, got %+v", expected, actual)
+	}
+	expected = map[string]ResolutionConflict{
+		"bp1": {NumObjects: 2, Object: configMap("b"), Destination: "c2", Settings: []string{"createOnly", "statusCollectors"}},
+		"bp3": {NumObjects: 1, Object: configMap("a"), Destination: "c3", Settings: []string{"createOnly"}},
+	}
+	if actual := resolver.FindConflicts("bp2"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected conflicts %+v, got %+v", expected, actual)
+	}
+	if actual := resolver.FindConflicts("bp5"); actual != nil {
+		t.Errorf("expected no conflicts for a missing resolution, got %+v", actual)
+	}
+}
+
+func TestConflictTracker(t *testing.T) {
+	ct := newConflictTracker()
+	if changed := ct.setPartners("bp1", sets.New("bp2", "bp3")); !changed.Equal(sets.New("bp2", "bp3")) {
+		t.Errorf("expected bp2 and bp3 to change, got %v", sets.List(changed))
+	}
+	if changed := ct.setPartners("bp1", sets.New("bp3", "bp4")); !changed.Equal(sets.New("bp2", "bp4")) {
+		t.Errorf("expected bp2 and bp4 to change, got %v", sets.List(changed))
+	}
+	ct.setPartners("bp5", sets.New[string]())
+	if num := ct.numConflicting(); num != 1 {
+		t.Errorf("expected 1 conflicting bindingpolicy, got %v", num)
+	}
+	if changed := ct.forget("bp1"); !changed.Equal(sets.New("bp3", "bp4")) {
+		t.Errorf("expected bp3 and bp4 to change, got %v", sets.List(changed))
+	}
+	if num := ct.numConflicting(); num != 0 {
+		t.Errorf("expected no conflicting bindingpolicy, got %v", num)
+	}
+}
@@ -20,16 +20,19 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
-	clusterclientset "open-cluster-management.io/api/client/cluster/clientset/versioned"
-	clusterpkginformers "open-cluster-management.io/api/client/cluster/informers/externalversions"
 	clusterinformers "open-cluster-management.io/api/client/cluster/informers/externalversions/cluster/v1"
 	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -38,8 +41,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/dynamic/dynamicinformer"
+	kubeinformers "k8s.io/client-go/informers"
+	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -52,6 +59,7 @@ import (
 	ksinformers "github.com/kubestellar/kubestellar/pkg/generated/informers/externalversions"
 	controlinformers "github.com/kubestellar/kubestellar/pkg/generated/informers/externalversions/control/v1alpha1"
 	controllisters "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
 	"github.com/kubestellar/kubestellar/pkg/util"
 )
 
@@ -69,18 +77,6 @@ var excludedGroups = map[string]bool{
 	"control.kubestellar.io":       true,
 }
 
-// Resource names to exclude for watchers as they should not delivered to other clusters
-// TODO - add also group version to qualify and avoid filtering when same names used on
-// user-supplied CRDs
-var excludedResourceNames = map[string]bool{
-	"events":               true,
-	"nodes":                true,
-	"csistoragecapacities": true,
-	"csinodes":             true,
-	"endpoints":            true,
-	"workstatuses":         true,
-}
-
 const (
 	bindingQueueingDelay = 2 * time.Second
 	// https://github.com/kubernetes/kubernetes/blob/5d527dcf1265d7fcd0e6c8ec511ce16cc6a40699/staging/src/k8s.io/cli-runtime/pkg/genericclioptions/config_flags.go#L477
@@ -99,13 +95,17 @@ type Controller struct {
 	bindingLister               controllisters.BindingLister
 	bindingPolicyInformer       cache.SharedIndexInformer
 	bindingPolicyLister         controllisters.BindingPolicyLister
-	clusterClient               clusterclientset.Interface // used for ManagedCluster in ITS
 	clusterInformerFactoryStart func(stopCh <-chan struct{})
-	clusterInformer             cache.SharedIndexInformer // used for ManagedCluster in ITS
-	clusterLister               clusterlisters.ManagedClusterLister
-	dynamicClient               dynamic.Interface // used for workload
+	clusterInformers            []cache.SharedIndexInformer         // used for ManagedCluster in ITS, one per inventory shard
+	clusterLister               clusterlisters.ManagedClusterLister // over all the inventory shards
+	dynamicClient               dynamic.Interface                   // used for workload
+	metadataClient              metadata.Interface                  // used for the informers on workload
+	fullObjectGetter            *util.FullObjectGetter              // fetches what the informers on workload lack
 
-	kubernetesClient kubernetes.Interface // used for Namespaces, and Discovery
+	kubernetesClient              kubernetes.Interface // used for Namespaces, and Discovery
+	namespaceInformerFactoryStart func(stopCh <-chan struct{})
+	namespaceInformer             cache.SharedIndexInformer
+	namespaceLister               corev1listers.NamespaceLister
 
 	extClient apiextensionsclientset.Interface // used for CRD
 
@@ -113,14 +113,29 @@ type Controller struct {
 	listers          util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister]
 	informers        util.ConcurrentMap[schema.GroupVersionResource, cache.SharedIndexInformer]
 	stoppers         util.ConcurrentMap[schema.GroupVersionResource, chan struct{}]
+	// informersMutex is held while starting or stopping an informer after startup,
+	// so that no GVR gets two informers and no stopper gets closed twice
+	informersMutex sync.Mutex
 
 	bindingPolicyResolver BindingPolicyResolver
-
-	// Contains bindingPolicyRef, bindingRef, util.ObjectIdentifier
+	bindingPolicyIndex    *bindingPolicyIndex
+	scheduler             Scheduler
+	objectCELPrograms     *objectCELPrograms
+	exclusionErrors       *exclusionErrorTracker
+	dependencyTracker     *dependencyTracker
+	conflictTracker       *conflictTracker
+	overlapTracker        *overlapTracker
+	// singletonPoliciesOfOthers tracks the singleton bindingpolicies that other replicas resolve
+	singletonPoliciesOfOthers *singletonPoliciesOfOthers
+
+	// Contains bindingPolicyRef, bindingRef, namespaceRef, util.ObjectIdentifier
 	workqueue        workqueue.RateLimitingInterface
 	initializedTs    time.Time
 	wdsName          string
 	allowedGroupsSet sets.Set[string]
+	resourceFilter   *util.ResourceFilter
+	// sharder tells which BindingPolicies (and thus Bindings) this replica is responsible for
+	sharder sharding.Sharder
 }
 
 // bindingPolicyRef is a workqueue item that references a BindingPolicy
@@ -129,10 +144,34 @@ type bindingPolicyRef string
 // bindingRef is a workqueue item that references a Binding
 type bindingRef string
 
-// Create a new binding controller
+// namespaceRef is a workqueue item that references a Namespace whose labels changed
+type namespaceRef string
+
+// Create a new binding controller.
+// A nil resourceFilter means util.DefaultResourceFilter().
 func NewController(parentLogger logr.Logger, wdsRestConfig *rest.Config, itsRestConfig *rest.Config,
-	wdsName string, allowedGroupsSet sets.Set[string]) (*Controller, error) {
+	wdsName string, allowedGroupsSet sets.Set[string], resourceFilter *util.ResourceFilter) (*Controller, error) {
+	its, err := NewITSInformers(itsRestConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newController(parentLogger, wdsRestConfig, its, its.Start, wdsName, allowedGroupsSet, resourceFilter)
+}
+
+// NewControllerSharingITS creates a new binding controller that uses the given informers
+// on the ITS, which can be shared with the binding controllers for other WDSes.
+// The caller is responsible for starting those informers.
+func NewControllerSharingITS(parentLogger logr.Logger, wdsRestConfig *rest.Config, its *ITSInformers,
+	wdsName string, allowedGroupsSet sets.Set[string], resourceFilter *util.ResourceFilter) (*Controller, error) {
+	return newController(parentLogger, wdsRestConfig, its, func(<-chan struct{}) {}, wdsName, allowedGroupsSet, resourceFilter)
+}
+
+func newController(parentLogger logr.Logger, wdsRestConfig *rest.Config, its *ITSInformers,
+	itsInformersStart func(<-chan struct{}), wdsName string, allowedGroupsSet sets.Set[string], resourceFilter *util.ResourceFilter) (*Controller, error) {
 	logger := parentLogger.WithName(ControllerName)
+	if resourceFilter == nil {
+		resourceFilter = util.DefaultResourceFilter()
+	}
 
 	kubernetesClient, err := kubernetes.NewForConfig(wdsRestConfig)
 	if err != nil {
@@ -171,25 +210,26 @@ func NewController(parentLogger logr.Logger, wdsRestConfig *rest.Config, itsRest
 	if err != nil {
 		return nil, err
 	}
-
-	ksClient, err := ksclient.NewForConfig(wdsRestConfig)
+	metadataClient, err := metadata.NewForConfig(wdsRestConfigTuned)
 	if err != nil {
 		return nil, err
 	}
-	ksInformerFactory := ksinformers.NewSharedInformerFactory(ksClient, defaultResyncPeriod)
 
-	clusterClient, err := clusterclientset.NewForConfig(itsRestConfig)
+	ksClient, err := ksclient.NewForConfig(wdsRestConfig)
 	if err != nil {
 		return nil, err
 	}
-	clusterInformerFactory := clusterpkginformers.NewSharedInformerFactory(clusterClient, defaultResyncPeriod)
+	ksInformerFactory := ksinformers.NewSharedInformerFactory(ksClient, defaultResyncPeriod)
+
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubernetesClient, defaultResyncPeriod)
 
-	return makeController(logger, ksClient.ControlV1alpha1(), ksInformerFactory.Start, ksInformerFactory.Control().V1alpha1(), dynamicClient, kubernetesClient, extClient, clusterClient, clusterInformerFactory.Start, clusterInformerFactory.Cluster().V1().ManagedClusters(), apiResourceLists, wdsName, allowedGroupsSet)
+	return makeController(logger, ksClient.ControlV1alpha1(), ksInformerFactory.Start, ksInformerFactory.Control().V1alpha1(), dynamicClient, metadataClient, kubernetesClient, kubeInformerFactory.Start, kubeInformerFactory.Core().V1().Namespaces(), extClient, itsInformersStart, its.managedClusters(), apiResourceLists, wdsName, allowedGroupsSet, resourceFilter)
 }
 
 // doDiscovery contains the exact one occurence of ServerPreferredResources() in this repository.
-// doDiscovery is supposed to be invoked exactly one time during the lifecycle of a binding controller.
-// That is, full API discovery against the WDS is done exactly one time during the lifecycle of a binding controller.
+// doDiscovery is invoked once when a binding controller is created, with a disposable client whose
+// rate limits are generous. After that it is invoked by rediscover, which runs only every
+// rediscoveryPeriod or after a change to an APIService.
 // doDiscovery also returns the number of successfully discovered GVRs.
 // We do these to optimize the performance of the binding controller, especially when it runs against a WDS which
 // (a) uses legacy discovery;
@@ -239,52 +279,80 @@ func makeController(logger logr.Logger,
 	ksInformerFactoryStart func(stopCh <-chan struct{}),
 	controlInformers controlinformers.Interface,
 	dynamicClient dynamic.Interface, // used for CRD, Binding[Policy], workload
+	metadataClient metadata.Interface, // used for the informers on workload
 	kubernetesClient kubernetes.Interface, // used for Namespaces, and Discovery
+	namespaceInformerFactoryStart func(<-chan struct{}),
+	namespacePreInformer corev1informers.NamespaceInformer,
 	extClient apiextensionsclientset.Interface, // used for CRD
-	clusterClient clusterclientset.Interface, // used for ManagedCluster in ITS
 	clusterInformerFactoryStart func(<-chan struct{}),
-	clusterPreInformer clusterinformers.ManagedClusterInformer, // used for ManagedCluster in ITS
+	clusterPreInformers []clusterinformers.ManagedClusterInformer, // used for ManagedCluster in ITS, one per inventory shard
 	apiResourceLists []*metav1.APIResourceList,
-	wdsName string, allowedGroupsSet sets.Set[string]) (*Controller, error) {
+	wdsName string, allowedGroupsSet sets.Set[string], resourceFilter *util.ResourceFilter) (*Controller, error) {
 
 	ratelimiter := workqueue.NewMaxOfRateLimiter(
 		workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, 1000*time.Second),
 		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(50), 300)},
 	)
 
-	clusterInformer := clusterPreInformer.Informer()
+	objectCELPrograms, err := newObjectCELPrograms()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInformers := make([]cache.SharedIndexInformer, len(clusterPreInformers))
+	clusterLister := make(shardedClusterLister, len(clusterPreInformers))
+	for idx, clusterPreInformer := range clusterPreInformers {
+		clusterInformers[idx] = clusterPreInformer.Informer()
+		clusterLister[idx] = clusterPreInformer.Lister()
+	}
 	controller := &Controller{
-		wdsName:                     wdsName,
-		logger:                      logger,
-		controlClient:               controlClient,
-		ksInformerFactoryStart:      ksInformerFactoryStart,
-		bindingInformer:             controlInformers.Bindings().Informer(),
-		bindingLister:               controlInformers.Bindings().Lister(),
-		bindingPolicyInformer:       controlInformers.BindingPolicies().Informer(),
-		bindingPolicyLister:         controlInformers.BindingPolicies().Lister(),
-		clusterClient:               clusterClient,
-		clusterInformerFactoryStart: clusterInformerFactoryStart,
-		clusterInformer:             clusterInformer,
-		clusterLister:               clusterPreInformer.Lister(),
-		dynamicClient:               dynamicClient,
-		kubernetesClient:            kubernetesClient,
-		extClient:                   extClient,
-		apiResourceLists:            apiResourceLists,
-		listers:                     util.NewConcurrentMap[schema.GroupVersionResource, cache.GenericLister](),
-		informers:                   util.NewConcurrentMap[schema.GroupVersionResource, cache.SharedIndexInformer](),
-		stoppers:                    util.NewConcurrentMap[schema.GroupVersionResource, chan struct{}](),
-		bindingPolicyResolver:       NewBindingPolicyResolver(),
-		workqueue:                   workqueue.NewRateLimitingQueueWithConfig(ratelimiter, workqueue.RateLimitingQueueConfig{Name: ControllerName + "-" + wdsName}),
-		allowedGroupsSet:            allowedGroupsSet,
+		wdsName:                       wdsName,
+		logger:                        logger,
+		controlClient:                 controlClient,
+		ksInformerFactoryStart:        ksInformerFactoryStart,
+		bindingInformer:               controlInformers.Bindings().Informer(),
+		bindingLister:                 controlInformers.Bindings().Lister(),
+		bindingPolicyInformer:         controlInformers.BindingPolicies().Informer(),
+		bindingPolicyLister:           controlInformers.BindingPolicies().Lister(),
+		clusterInformerFactoryStart:   clusterInformerFactoryStart,
+		clusterInformers:              clusterInformers,
+		clusterLister:                 clusterLister,
+		dynamicClient:                 dynamicClient,
+		metadataClient:                metadataClient,
+		fullObjectGetter:              util.NewFullObjectGetter(dynamicClient, util.DefaultFullObjectCacheSize),
+		kubernetesClient:              kubernetesClient,
+		namespaceInformerFactoryStart: namespaceInformerFactoryStart,
+		namespaceInformer:             namespacePreInformer.Informer(),
+		namespaceLister:               namespacePreInformer.Lister(),
+		extClient:                     extClient,
+		apiResourceLists:              apiResourceLists,
+		listers:                       util.NewConcurrentMap[schema.GroupVersionResource, cache.GenericLister](),
+		informers:                     util.NewConcurrentMap[schema.GroupVersionResource, cache.SharedIndexInformer](),
+		stoppers:                      util.NewConcurrentMap[schema.GroupVersionResource, chan struct{}](),
+		bindingPolicyResolver:         NewBindingPolicyResolver(),
+		bindingPolicyIndex:            newBindingPolicyIndex(),
+		scheduler:                     NewScheduler(),
+		objectCELPrograms:             objectCELPrograms,
+		exclusionErrors:               newExclusionErrorTracker(),
+		dependencyTracker:             newDependencyTracker(),
+		conflictTracker:               newConflictTracker(),
+		overlapTracker:                newOverlapTracker(),
+		singletonPoliciesOfOthers:     newSingletonPoliciesOfOthers(),
+		workqueue:                     workqueue.NewRateLimitingQueueWithConfig(ratelimiter, workqueue.RateLimitingQueueConfig{Name: ControllerName + "-" + wdsName}),
+		allowedGroupsSet:              allowedGroupsSet,
+		resourceFilter:                resourceFilter,
+		sharder:                       sharding.Everything,
 	}
 
 	return controller, nil
 }
 
 // EnsureCRDs will ensure that the CRDs are installed.
+// `conversion`, if not nil, says how the apiserver reaches the conversion webhook;
+// only then are the versions other than the storage version served.
 // Call this before Start.
-func (c *Controller) EnsureCRDs(ctx context.Context) error {
-	return crd.ApplyCRDs(ctx, ControllerName, c.kubernetesClient, c.extClient, c.logger)
+func (c *Controller) EnsureCRDs(ctx context.Context, conversion *apiextensionsv1.WebhookClientConfig) error {
+	return crd.ApplyCRDs(ctx, ControllerName, c.kubernetesClient, c.extClient, conversion, c.logger)
 }
 
 // AppendKSResources lets the controller know about the KS resources.
@@ -299,6 +367,20 @@ func (c *Controller) AppendKSResources(ctx context.Context) error {
 	return nil
 }
 
+// UseSharder has this controller handle only the BindingPolicies, and their Bindings,
+// that the given Sharder says this replica owns. By default all of them are handled.
+// Call this before Start.
+func (c *Controller) UseSharder(sharder sharding.Sharder) {
+	c.sharder = sharder
+}
+
+// UseFullObjectGetter has this controller fetch the content of workload objects through
+// the given FullObjectGetter, which may be shared with the status controller of the same WDS.
+// By default the controller has one of its own, of DefaultFullObjectCacheSize. Call this before Start.
+func (c *Controller) UseFullObjectGetter(fullObjectGetter *util.FullObjectGetter) {
+	c.fullObjectGetter = fullObjectGetter
+}
+
 // Start the controller
 func (c *Controller) Start(parentCtx context.Context, workers int, cListers chan interface{}) error {
 	logger := klog.FromContext(parentCtx).WithName(ControllerName)
@@ -310,6 +392,12 @@ func (c *Controller) Start(parentCtx context.Context, workers int, cListers chan
 		return err
 	}
 
+	// Create informer on namespaces so we can test namespaceSelectors
+	// and re-match objects when the labels of their namespace change.
+	if err := c.setupNamespaceInformer(ctx); err != nil {
+		return err
+	}
+
 	if err := c.setupBindingPolicyInformer(ctx); err != nil {
 		return err
 	}
@@ -348,8 +436,12 @@ func (c *Controller) run(ctx context.Context, workers int, cListers chan interfa
 
 	logger := klog.FromContext(ctx)
 
-	// Create a dynamic shared informer factory
-	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(c.dynamicClient, 0*time.Minute)
+	// stop all the informers on the way out, including those started after startup
+	defer c.stopAllInformers(ctx)
+
+	// Create a metadata shared informer factory.
+	// Only the metadata of the workload objects is cached, the rest is fetched when needed.
+	informerFactory := metadatainformer.NewSharedInformerFactory(c.metadataClient, 0*time.Minute)
 
 	// Loop through the api resources and create informers and listers for each of them
 	for _, list := range c.apiResourceLists {
@@ -368,13 +460,16 @@ func (c *Controller) run(ctx context.Context, workers int, cListers chan interfa
 		}
 		logger.V(1).Info("Working on APIResourceList", "groupVersion", list.GroupVersion, "numResources", len(list.APIResources))
 		for _, resource := range list.APIResources {
-			if _, excluded := excludedResourceNames[resource.Name]; excluded {
+			if !c.resourceFilter.Allows(gv.Group, resource.Name) {
 				continue
 			}
 			informable := verbsSupportInformers(resource.Verbs)
 			if informable {
 				gvr := gv.WithResource(resource.Name)
 				informer := informerFactory.ForResource(gvr).Informer()
+				if err := informer.SetTransform(typeMetaTransform(gv.WithKind(resource.Kind))); err != nil {
+					return err
+				}
 				c.informers.Set(gvr, informer)
 
 				// add the event handler functions
@@ -402,7 +497,6 @@ func (c *Controller) run(ctx context.Context, workers int, cListers chan interfa
 				// after startup, therefore we use a stopper channel for each informer
 				// instead than informerFactory.Start(ctx.Done())
 				stopper := make(chan struct{})
-				defer close(stopper)
 				c.stoppers.Set(gvr, stopper)
 				go informer.Run(stopper)
 			}
@@ -440,6 +534,14 @@ func (c *Controller) run(ctx context.Context, workers int, cListers chan interfa
 	c.logger.Info("Started workers")
 	c.initializedTs = time.Now()
 
+	// take up the BindingPolicies that this replica comes to own and drop the others
+	removeOwnershipChangeHandler := c.sharder.AddOwnershipChangeHandler(c.requeueAllBindingPolicies)
+	defer removeOwnershipChangeHandler()
+
+	// APIs that are not defined by CRDs (e.g., those served by aggregated apiservers)
+	// can come and go too; catch them by repeating the discovery.
+	go c.runRediscovery(ctx)
+
 	<-ctx.Done()
 	c.logger.Info("Shutting down workers")
 
@@ -447,18 +549,18 @@ func (c *Controller) run(ctx context.Context, workers int, cListers chan interfa
 }
 
 func (c *Controller) setupManagedClustersInformer(ctx context.Context) error {
-	_, err := c.clusterInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	handler := cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			objM := obj.(metav1.Object)
 			c.evaluateBindingPolicies(ctx, objM.GetName(), objM.GetLabels())
 		},
 		UpdateFunc: func(old, new interface{}) {
-			oldM := old.(metav1.Object)
-			newM := new.(metav1.Object)
-			// Re-evaluateBindingPolicies iff labels have changed.
+			oldM := old.(*clusterv1.ManagedCluster)
+			newM := new.(*clusterv1.ManagedCluster)
+			// Re-evaluateBindingPolicies iff labels or the status considered by scheduler plugins have changed.
 			oldLabels := oldM.GetLabels()
 			newLabels := newM.GetLabels()
-			if !reflect.DeepEqual(oldLabels, newLabels) {
+			if !reflect.DeepEqual(oldLabels, newLabels) || schedulingStatusChanged(oldM, newM) {
 				c.evaluateBindingPoliciesForUpdate(ctx, newM.GetName(), oldLabels, newLabels)
 			}
 		},
@@ -466,14 +568,65 @@ func (c *Controller) setupManagedClustersInformer(ctx context.Context) error {
 			objM := obj.(metav1.Object)
 			c.evaluateBindingPolicies(ctx, objM.GetName(), objM.GetLabels())
 		},
+	}
+	synced := make([]cache.InformerSynced, len(c.clusterInformers))
+	for idx, clusterInformer := range c.clusterInformers {
+		clusterInformer := clusterInformer
+		registration, err := clusterInformer.AddEventHandler(handler)
+		if err != nil {
+			c.logger.Error(err, "failed to add managedclusters informer event handler")
+			return err
+		}
+		// The informer may be shared with the controllers for other WDSes and outlive this one.
+		go func() {
+			<-ctx.Done()
+			if err := clusterInformer.RemoveEventHandler(registration); err != nil {
+				c.logger.Error(err, "failed to remove managedclusters informer event handler")
+			}
+		}()
+		synced[idx] = clusterInformer.HasSynced
+	}
+	c.clusterInformerFactoryStart(ctx.Done())
+	if ok := cache.WaitForCacheSync(ctx.Done(), synced...); !ok {
+		return fmt.Errorf("failed to wait for MangedCluster informer to sync")
+	}
+	return nil
+}
+
+func (c *Controller) setupNamespaceInformer(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+	_, err := c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			// Objects in a namespace that is new to the informer might have been
+			// tested before the informer heard of the namespace.
+			if isInInitialList {
+				return
+			}
+			ns := obj.(*corev1.Namespace)
+			if c.namespaceSelectorsDistinguish(nil, ns.Labels) {
+				logger.V(5).Info("Enqueuing reference to Namespace because of informer add event", "name", ns.Name)
+				c.workqueue.Add(namespaceRef(ns.Name))
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			oldNS := old.(*corev1.Namespace)
+			newNS := new.(*corev1.Namespace)
+			if reflect.DeepEqual(oldNS.Labels, newNS.Labels) {
+				return
+			}
+			if c.namespaceSelectorsDistinguish(oldNS.Labels, newNS.Labels) {
+				logger.V(5).Info("Enqueuing reference to Namespace because of label change", "name", newNS.Name)
+				c.workqueue.Add(namespaceRef(newNS.Name))
+			}
+		},
 	})
 	if err != nil {
-		c.logger.Error(err, "failed to add managedclusters informer event handler")
+		c.logger.Error(err, "failed to add namespaces informer event handler")
 		return err
 	}
-	c.clusterInformerFactoryStart(ctx.Done())
-	if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterInformer.HasSynced); !ok {
-		return fmt.Errorf("failed to wait for MangedCluster informer to sync")
+	c.namespaceInformerFactoryStart(ctx.Done())
+	if ok := cache.WaitForCacheSync(ctx.Done(), c.namespaceInformer.HasSynced); !ok {
+		return fmt.Errorf("failed to wait for Namespace informer to sync")
 	}
 	return nil
 }
@@ -524,6 +677,10 @@ func (c *Controller) setupBindingInformer(ctx context.Context) error {
 			if oldBdg.Generation != newBdg.Generation {
 				logger.V(5).Info("Enqueuing reference to Binding because of informer update event", "name", newBdg.Name, "resourceVersion", newBdg.ResourceVersion)
 				c.workqueue.Add(bindingPolicyRef(newBdg.Name))
+			} else if !v1alpha1.AreConditionSlicesSame(oldBdg.Status.Conditions, newBdg.Status.Conditions) {
+				// the rollout condition is to be mirrored in the BindingPolicy
+				logger.V(5).Info("Enqueuing reference to Binding because its conditions changed", "name", newBdg.Name, "resourceVersion", newBdg.ResourceVersion)
+				c.enqueueBinding(newBdg.Name)
 			}
 		},
 		DeleteFunc: func(obj interface{}) {
@@ -542,6 +699,14 @@ func (c *Controller) setupBindingInformer(ctx context.Context) error {
 	return nil
 }
 
+// schedulingStatusChanged tells whether a change to a ManagedCluster
+// might change the verdict of a built-in scheduler plugin.
+func schedulingStatusChanged(old, new *clusterv1.ManagedCluster) bool {
+	return meta.IsStatusConditionTrue(old.Status.Conditions, clusterv1.ManagedClusterConditionAvailable) !=
+		meta.IsStatusConditionTrue(new.Status.Conditions, clusterv1.ManagedClusterConditionAvailable) ||
+		!reflect.DeepEqual(old.Status.Allocatable, new.Status.Allocatable)
+}
+
 func shouldSkipUpdate(old, new interface{}) bool {
 	oldMObj := old.(metav1.Object)
 	newMObj := new.(metav1.Object)
@@ -657,6 +822,8 @@ func (c *Controller) reconcile(ctx context.Context, item any) error {
 	switch objIdentifier := item.(type) {
 	case bindingRef:
 		return c.syncBinding(ctx, string(objIdentifier)) // this function logs through all its exits
+	case namespaceRef:
+		return c.requeueObjectsInNamespace(ctx, string(objIdentifier))
 	case bindingPolicyRef:
 		if err := c.syncBindingPolicy(ctx, string(objIdentifier)); err != nil {
 			return fmt.Errorf("failed to handle bindingpolicy: %w", err) // error logging after this call
@@ -689,6 +856,18 @@ func (c *Controller) getObjectFromIdentifier(objIdentifier util.ObjectIdentifier
 	return getObject(lister, objIdentifier.ObjectName.Namespace, objIdentifier.ObjectName.Name)
 }
 
+// typeMetaTransform returns an informer transform that restores the TypeMeta,
+// which the metadata client does not fill in, of the objects of the given kind.
+func typeMetaTransform(gvk schema.GroupVersionKind) cache.TransformFunc {
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	return func(obj interface{}) (interface{}, error) {
+		if partial, ok := obj.(*metav1.PartialObjectMetadata); ok {
+			partial.APIVersion, partial.Kind = apiVersion, kind
+		}
+		return obj, nil
+	}
+}
+
 func getObject(lister cache.GenericLister, namespace, name string) (runtime.Object, error) {
 	if namespace != "" {
 		return lister.ByNamespace(namespace).Get(name)
@@ -712,6 +891,7 @@ func (c *Controller) GetInformers() util.ConcurrentMap[schema.GroupVersionResour
 // populateBindingPolicyResolverWithExistingBindingPolicies fills the BindingPolicyResolver
 // with entries for existing BindingPolicy objects. Any bindingpolicy name that is not
 // associated with a resolution gets associated to an empty resolution.
+// The bindingpolicies are also indexed.
 func (c *Controller) populateBindingPolicyResolverWithExistingBindingPolicies() error {
 	bindingpolicies, err := c.listBindingPolicies()
 	if err != nil {
@@ -719,7 +899,12 @@ func (c *Controller) populateBindingPolicyResolverWithExistingBindingPolicies()
 	}
 
 	for _, bindingpolicy := range bindingpolicies {
-		c.bindingPolicyResolver.NoteBindingPolicy(bindingpolicy)
+		if !c.sharder.Owns(bindingpolicy.Name) {
+			continue
+		}
+		c.bindingPolicyIndex.noteBindingPolicy(bindingpolicy)
+		resolvedPolicy, _ := c.withEffectivePriority(bindingpolicy)
+		c.bindingPolicyResolver.NoteBindingPolicy(resolvedPolicy)
 	}
 
 	return nil
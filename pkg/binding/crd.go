@@ -19,20 +19,27 @@ package binding
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	"github.com/kubestellar/kubestellar/pkg/util"
 )
 
+// informerSyncTimeout bounds the wait for a new informer to sync when switching
+// the watched version of a CRD.
+const informerSyncTimeout = time.Minute
+
 type APIResource struct {
 	groupVersion schema.GroupVersion
 	resource     metav1.APIResource
@@ -42,75 +49,111 @@ type APIResource struct {
 func (c *Controller) handleCRD(ctx context.Context, objIdentifier util.ObjectIdentifier) error {
 	logger := klog.FromContext(ctx)
 	var crdObj *apiextensionsv1.CustomResourceDefinition
-	var specVersions []apiextensionsv1.CustomResourceDefinitionVersion
 
-	obj, err := c.getObjectFromIdentifier(objIdentifier)
+	// the informer holds only the metadata, the spec is needed here
+	uObj, err := c.fullObjectGetter.Get(ctx, objIdentifier, "")
 	if errors.IsNotFound(err) {
 		logger.V(2).Info("Handling deleted CRD", "name", objIdentifier.ObjectName.Name)
 	} else if err != nil {
-		return fmt.Errorf("failed to get runtime.Object from identifier (%v): %w", objIdentifier, err)
+		return fmt.Errorf("failed to get CRD (%v): %w", objIdentifier, err)
 	} else {
-		uObj := obj.(*unstructured.Unstructured)
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uObj.UnstructuredContent(), &crdObj); err != nil {
 			return fmt.Errorf("failed to convert Unstructured to CRD: %w", err)
 		}
-		specVersions = crdObj.Spec.Versions
 	}
 
-	// for each CustomResourceDefinitionVersion, follow a decision tree to tell whether the corresponding gvr should be watched
-	toStartList, toStopList := []APIResource{}, []schema.GroupVersionResource{}
-	for _, ver := range specVersions {
-		apiResource := APIResource{
-			groupVersion: schema.GroupVersion{
-				Group:   crdObj.Spec.Group,
-				Version: ver.Name,
-			},
-			resource: metav1.APIResource{
-				Name: crdObj.Spec.Names.Plural,
-				Kind: crdObj.Spec.Names.Kind,
-			},
-		}
-
-		gvr := apiResource.groupVersion.WithResource(apiResource.resource.Name)
+	// The name of a CRD is <plural>.<group>, which is all there is to go on once the CRD is gone.
+	plural, group, _ := strings.Cut(objIdentifier.ObjectName.Name, ".")
+	groupResource := schema.GroupResource{Group: group, Resource: plural}
 
-		if !c.includedToWatch(apiResource) {
-			continue
+	// Only the preferred version is watched, so that each object is seen (and bound) just once.
+	// That is also the version found by API discovery.
+	var toStart *APIResource
+	if crdObj != nil && !isBeingDeleted(crdObj) && crdEstablished(crdObj) {
+		if preferred := preferredVersion(crdObj); preferred != "" {
+			apiResource := APIResource{
+				groupVersion: schema.GroupVersion{
+					Group:   crdObj.Spec.Group,
+					Version: preferred,
+				},
+				resource: metav1.APIResource{
+					Name: crdObj.Spec.Names.Plural,
+					Kind: crdObj.Spec.Names.Kind,
+				},
+			}
+			if c.includedToWatch(apiResource) {
+				toStart = &apiResource
+			}
 		}
-		if isBeingDeleted(crdObj) {
+	}
+	toStopList := []schema.GroupVersionResource{}
+	_ = c.informers.Iterator(func(gvr schema.GroupVersionResource, _ cache.SharedIndexInformer) error {
+		if gvr.GroupResource() == groupResource && (toStart == nil || gvr.Version != toStart.groupVersion.Version) {
 			toStopList = append(toStopList, gvr)
-			continue
 		}
-		if !ver.Served {
-			toStopList = append(toStopList, gvr)
-			continue
+		return nil
+	})
+
+	if toStart != nil {
+		if len(toStopList) == 0 {
+			go c.startInformersForNewAPIResources(ctx, []APIResource{*toStart})
+			return nil
 		}
-		if crdEstablished(crdObj) {
-			toStartList = append(toStartList, apiResource)
+		// The preferred version changed; have the new informer synced before letting go of the old ones.
+		c.startInformersForNewAPIResources(ctx, []APIResource{*toStart})
+		if err := c.waitForInformerSync(ctx, toStart.groupVersion.WithResource(toStart.resource.Name)); err != nil {
+			return err
 		}
 	}
 
-	if len(toStartList) > 0 {
-		go c.startInformersForNewAPIResources(ctx, toStartList)
-	}
-
 	for _, gvr := range toStopList {
 		logger.Info("API should not be watched, ensuring the informer's absence.", "gvr", gvr)
-		stopper, ok := c.stoppers.Get(gvr)
-		if !ok {
-			logger.V(3).Info("Informer is already absent.", "gvr", gvr)
-		} else {
-			// close channel
-			close(stopper)
+		c.stopInformer(ctx, gvr)
+	}
+	if len(toStopList) > 0 {
+		newVersion := ""
+		if toStart != nil {
+			newVersion = toStart.groupVersion.Version
+		}
+		if err := c.migrateResolutions(ctx, sets.New(toStopList...), newVersion); err != nil {
+			return err
 		}
-		// remove entries for gvr
-		c.informers.Remove(gvr)
-		c.listers.Remove(gvr)
-		c.stoppers.Remove(gvr)
 	}
 
 	return nil
 }
 
+// stopInformer stops the informer for the given GVR, if there is one,
+// and removes its entries from the informers, listers and stoppers maps.
+func (c *Controller) stopInformer(ctx context.Context, gvr schema.GroupVersionResource) {
+	logger := klog.FromContext(ctx)
+	c.informersMutex.Lock()
+	defer c.informersMutex.Unlock()
+	stopper, ok := c.stoppers.Get(gvr)
+	if !ok {
+		logger.V(3).Info("Informer is already absent.", "gvr", gvr)
+	} else {
+		// close channel
+		close(stopper)
+	}
+	// remove entries for gvr
+	c.informers.Remove(gvr)
+	c.listers.Remove(gvr)
+	c.stoppers.Remove(gvr)
+}
+
+// stopAllInformers stops the informers for all the GVRs.
+func (c *Controller) stopAllInformers(ctx context.Context) {
+	gvrs := []schema.GroupVersionResource{}
+	_ = c.stoppers.Iterator(func(gvr schema.GroupVersionResource, _ chan struct{}) error {
+		gvrs = append(gvrs, gvr)
+		return nil
+	})
+	for _, gvr := range gvrs {
+		c.stopInformer(ctx, gvr)
+	}
+}
+
 func (c *Controller) includedToWatch(r APIResource) bool {
 	if _, excluded := excludedGroups[r.groupVersion.Group]; excluded {
 		return false
@@ -118,12 +161,73 @@ func (c *Controller) includedToWatch(r APIResource) bool {
 	if !util.IsAPIGroupAllowed(r.groupVersion.Group, c.allowedGroupsSet) {
 		return false
 	}
-	if _, excluded := excludedResourceNames[r.resource.Name]; excluded {
+	if !c.resourceFilter.Allows(r.groupVersion.Group, r.resource.Name) {
 		return false
 	}
 	return true
 }
 
+// preferredVersion returns the served version of the given CRD that has the highest priority,
+// which is the one that API discovery reports as preferred; or "" if no version is served.
+func preferredVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	preferred := ""
+	for _, ver := range crd.Spec.Versions {
+		if ver.Served && (preferred == "" || version.CompareKubeAwareVersionStrings(ver.Name, preferred) > 0) {
+			preferred = ver.Name
+		}
+	}
+	return preferred
+}
+
+// waitForInformerSync waits, up to informerSyncTimeout, for the informer of the given GVR to sync.
+func (c *Controller) waitForInformerSync(ctx context.Context, gvr schema.GroupVersionResource) error {
+	informer, ok := c.informers.Get(gvr)
+	if !ok {
+		return fmt.Errorf("no informer for %s", gvr)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+	defer cancel()
+	if ok := cache.WaitForCacheSync(waitCtx.Done(), informer.HasSynced); !ok {
+		return fmt.Errorf("failed to wait for the informer of %s to sync", gvr)
+	}
+	return nil
+}
+
+// migrateResolutions moves the objects of the given GVRs, which are no longer watched,
+// to the given version in the resolutions. This is done by enqueuing both identifiers of each
+// object: the old one gets removed because its GVR is not watched, and the new one gets
+// resolved afresh. An empty newVersion means there is nothing to move to.
+// The Bindings get synced after bindingQueueingDelay, which normally sees both changes at once.
+func (c *Controller) migrateResolutions(ctx context.Context, oldGVRs sets.Set[schema.GroupVersionResource], newVersion string) error {
+	logger := klog.FromContext(ctx)
+	bindingPolicies, err := c.listBindingPolicies()
+	if err != nil {
+		return err
+	}
+	for _, bindingPolicy := range bindingPolicies {
+		objIdentifiers, err := c.bindingPolicyResolver.GetObjectIdentifiers(bindingPolicy.GetName())
+		if err != nil {
+			if errorIsBindingPolicyResolutionNotFound(err) {
+				continue
+			}
+			return err
+		}
+		for objIdentifier := range objIdentifiers {
+			if !oldGVRs.Has(objIdentifier.GVR()) {
+				continue
+			}
+			c.enqueueObjectIdentifier(objIdentifier)
+			if newVersion != "" {
+				migrated := objIdentifier
+				migrated.GVK.Version = newVersion
+				logger.V(3).Info("Migrating object in resolution", "bindingPolicy", bindingPolicy.GetName(), "from", objIdentifier, "to", migrated)
+				c.enqueueObjectIdentifier(migrated)
+			}
+		}
+	}
+	return nil
+}
+
 func crdEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
 	for _, condition := range crd.Status.Conditions {
 		if condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue {
@@ -136,7 +240,11 @@ func crdEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
 func (c *Controller) startInformersForNewAPIResources(ctx context.Context, toStartList []APIResource) {
 	logger := klog.FromContext(ctx)
 
+	c.informersMutex.Lock()
+	defer c.informersMutex.Unlock()
+
 	for _, toStart := range toStartList {
+		toStart := toStart // the closures below must not share the loop variable
 		gvr := toStart.groupVersion.WithResource(toStart.resource.Name)
 
 		if _, found := c.informers.Get(gvr); found {
@@ -147,19 +255,13 @@ func (c *Controller) startInformersForNewAPIResources(ctx context.Context, toSta
 		logger.Info("New API added. Starting informer for:", "group", toStart.groupVersion.Group,
 			"version", toStart.groupVersion, "kind", toStart.resource.Kind, "resource", toStart.resource.Name)
 
-		informer := cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-					return c.dynamicClient.Resource(gvr).List(context.TODO(), metav1.ListOptions{})
-				},
-				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-					return c.dynamicClient.Resource(gvr).Watch(context.TODO(), metav1.ListOptions{})
-				},
-			},
-			nil,
+		informer := metadatainformer.NewFilteredMetadataInformer(c.metadataClient, gvr, metav1.NamespaceAll,
 			0, //Skip resync
-			cache.Indexers{},
-		)
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, nil).Informer()
+		if err := informer.SetTransform(typeMetaTransform(toStart.groupVersion.WithKind(toStart.resource.Kind))); err != nil {
+			logger.Error(err, "Failed to set transform of informer", "gvr", gvr)
+			continue
+		}
 
 		// add the event handler functions (same as those used by the startup logic)
 		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -0,0 +1,192 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	controllisters "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+func TestPreferredVersion(t *testing.T) {
+	for _, tc := range []struct {
+		versions map[string]bool // name -> served
+		expected string
+	}{
+		{map[string]bool{"v1": true, "v1beta1": true}, "v1"},
+		{map[string]bool{"v1": false, "v1beta1": true}, "v1beta1"},
+		{map[string]bool{"v1alpha1": true, "v1beta2": true, "v1beta10": true}, "v1beta10"},
+		{map[string]bool{"v2": true, "v10": true, "v1": true}, "v10"},
+		{map[string]bool{"v1": false}, ""},
+	} {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		for name, served := range tc.versions {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: name, Served: served})
+		}
+		if actual := preferredVersion(crd); actual != tc.expected {
+			t.Errorf("for versions %v expected %q, got %q", tc.versions, tc.expected, actual)
+		}
+	}
+}
+
+// TestIncludedToWatchKeepsCRDs tests that the CustomResourceDefinitions, through which
+// the controller learns of new resources, are watched however narrow the resource filter.
+func TestIncludedToWatchKeepsCRDs(t *testing.T) {
+	resourceFilter, err := util.NewResourceFilter([]string{"apps/deployments"}, nil)
+	if err != nil {
+		t.Fatalf("failed to make resource filter: %v", err)
+	}
+	c := &Controller{resourceFilter: resourceFilter}
+	crds := APIResource{groupVersion: apiextensionsv1.SchemeGroupVersion, resource: metav1.APIResource{Name: "customresourcedefinitions"}}
+	if !c.includedToWatch(crds) {
+		t.Error("expected the CustomResourceDefinitions to be watched")
+	}
+	configMaps := APIResource{groupVersion: schema.GroupVersion{Version: "v1"}, resource: metav1.APIResource{Name: "configmaps"}}
+	if c.includedToWatch(configMaps) {
+		t.Error("expected the ConfigMaps, which are not included, to not be watched")
+	}
+}
+
+// TestPreferredVersionSwitch tests that, when the preferred version of a CRD changes, the objects
+// in the resolutions move to the new version: the old identifiers are enqueued along with the new
+// ones, and the old ones leave the resolutions because their GVR is no longer watched.
+func TestPreferredVersionSwitch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gvrV1 := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	gvrV2 := schema.GroupVersionResource{Group: "example.com", Version: "v2", Resource: "widgets"}
+	identifier := func(version string) util.ObjectIdentifier {
+		return util.ObjectIdentifier{GVK: schema.GroupVersionKind{Group: "example.com", Version: version, Kind: "Widget"},
+			Resource: "widgets", ObjectName: cache.NewObjectName("app", "w")}
+	}
+	widget := func(version string) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "example.com/" + version, Kind: "Widget"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "w", UID: "uid", ResourceVersion: "1"}}
+	}
+
+	// v1 is watched; the CRD now prefers v2
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets.example.com"},
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"names": map[string]interface{}{"plural": "widgets", "kind": "Widget"},
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1", "served": true},
+				map[string]interface{}{"name": "v2", "served": true},
+			},
+		},
+		"status": map[string]interface{}{"conditions": []interface{}{
+			map[string]interface{}{"type": "Established", "status": "True"},
+		}},
+	}}
+	crdGVR := apiextensionsv1.SchemeGroupVersion.WithResource("customresourcedefinitions")
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{crdGVR: "CustomResourceDefinitionList"}, crd)
+	metadataScheme := metadatafake.NewTestScheme()
+	metadataScheme.AddKnownTypeWithName(gvrV2.GroupVersion().WithKind("Widget"), &metav1.PartialObjectMetadata{})
+	metadataClient := metadatafake.NewSimpleMetadataClient(metadataScheme, widget("v2"))
+
+	v1Indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := v1Indexer.Add(widget("v1")); err != nil {
+		t.Fatalf("failed to add object: %v", err)
+	}
+	bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp", Generation: 1}, Spec: v1alpha1.BindingPolicySpec{
+		Downsync: []v1alpha1.DownsyncPolicyClause{
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{APIGroup: &gvrV1.Group, Resources: []string{"widgets"}}},
+		},
+	}}
+	policyIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := policyIndexer.Add(bindingPolicy); err != nil {
+		t.Fatalf("failed to add BindingPolicy: %v", err)
+	}
+	ocp, err := newObjectCELPrograms()
+	if err != nil {
+		t.Fatalf("failed to make objectCELPrograms: %v", err)
+	}
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	c := &Controller{logger: klog.Background(), sharder: sharding.Everything, dynamicClient: dynamicClient, metadataClient: metadataClient,
+		fullObjectGetter: util.NewFullObjectGetter(dynamicClient, util.DefaultFullObjectCacheSize), resourceFilter: util.DefaultResourceFilter(),
+		listers:             util.NewConcurrentMap[schema.GroupVersionResource, cache.GenericLister](),
+		informers:           util.NewConcurrentMap[schema.GroupVersionResource, cache.SharedIndexInformer](),
+		stoppers:            util.NewConcurrentMap[schema.GroupVersionResource, chan struct{}](),
+		bindingPolicyLister: controllisters.NewBindingPolicyLister(policyIndexer), bindingPolicyResolver: NewBindingPolicyResolver(),
+		bindingPolicyIndex: newBindingPolicyIndex(), objectCELPrograms: ocp, exclusionErrors: newExclusionErrorTracker(),
+		dependencyTracker: newDependencyTracker(), singletonPoliciesOfOthers: newSingletonPoliciesOfOthers(), workqueue: queue}
+	c.listers.Set(gvrV1, cache.NewGenericLister(v1Indexer, gvrV1.GroupResource()))
+	c.informers.Set(gvrV1, cache.NewSharedIndexInformer(&cache.ListWatch{}, &metav1.PartialObjectMetadata{}, 0, cache.Indexers{}))
+	c.stoppers.Set(gvrV1, make(chan struct{}))
+	c.bindingPolicyIndex.noteBindingPolicy(bindingPolicy)
+	c.bindingPolicyResolver.NoteBindingPolicy(bindingPolicy)
+	if _, err := c.bindingPolicyResolver.EnsureObjectData(bindingPolicy.Name, identifier("v1"), "uid", "1", false, false, nil); err != nil {
+		t.Fatalf("failed to put object in resolution: %v", err)
+	}
+
+	crdIdentifier := util.IdentifierForObject(crd, "customresourcedefinitions")
+	defer c.stopAllInformers(ctx)
+	if err := c.handleCRD(ctx, crdIdentifier); err != nil {
+		t.Fatalf("failed to handle CRD: %v", err)
+	}
+	if _, watched := c.listers.Get(gvrV1); watched {
+		t.Error("expected the old version to no longer be watched")
+	}
+	if _, watched := c.listers.Get(gvrV2); !watched {
+		t.Error("expected the new version to be watched")
+	}
+	enqueued := sets.New[any]()
+	for queue.Len() > 0 {
+		item, _ := queue.Get()
+		enqueued.Insert(item)
+		queue.Done(item)
+	}
+	for _, version := range []string{"v1", "v2"} {
+		if !enqueued.Has(identifier(version)) {
+			t.Errorf("expected the %s identifier of the object to be enqueued, got %v", version, enqueued.UnsortedList())
+		}
+	}
+
+	for _, version := range []string{"v1", "v2"} {
+		if err := c.updateResolutions(ctx, identifier(version)); err != nil {
+			t.Fatalf("failed to update resolutions for %s: %v", version, err)
+		}
+	}
+	objIdentifiers, err := c.bindingPolicyResolver.GetObjectIdentifiers(bindingPolicy.Name)
+	if err != nil {
+		t.Fatalf("failed to get resolution: %v", err)
+	}
+	if expected := sets.New(identifier("v2")); !objIdentifiers.Equal(expected) {
+		t.Errorf("expected the resolution to hold %v, got %v", expected.UnsortedList(), objIdentifiers.UnsortedList())
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// DependencyFunc returns the identifiers of the objects that the given workload object
+// references and thus depends on. It must not mutate the given object.
+// The Namespace and CustomResourceDefinition dependencies are found without a DependencyFunc.
+type DependencyFunc func(obj *unstructured.Unstructured) []util.ObjectIdentifier
+
+var (
+	dependencyFuncRegistryLock sync.RWMutex
+	dependencyFuncRegistry     = map[schema.GroupKind]DependencyFunc{}
+)
+
+// RegisterDependencyFunc makes the given function be used to find the dependencies
+// of objects of the given kind, for BindingPolicy clauses that say `wantDependencies`.
+// An error is returned if the kind already has a DependencyFunc.
+func RegisterDependencyFunc(groupKind schema.GroupKind, fn DependencyFunc) error {
+	dependencyFuncRegistryLock.Lock()
+	defer dependencyFuncRegistryLock.Unlock()
+	if _, have := dependencyFuncRegistry[groupKind]; have {
+		return fmt.Errorf("dependency function for %s is already registered", groupKind)
+	}
+	dependencyFuncRegistry[groupKind] = fn
+	return nil
+}
+
+func getDependencyFunc(groupKind schema.GroupKind) (DependencyFunc, bool) {
+	dependencyFuncRegistryLock.RLock()
+	defer dependencyFuncRegistryLock.RUnlock()
+	fn, have := dependencyFuncRegistry[groupKind]
+	return fn, have
+}
+
+func init() {
+	for groupKind, podSpecPath := range map[schema.GroupKind][]string{
+		{Group: "", Kind: "Pod"}:                   {"spec"},
+		{Group: "", Kind: "ReplicationController"}: {"spec", "template", "spec"},
+		{Group: "apps", Kind: "Deployment"}:        {"spec", "template", "spec"},
+		{Group: "apps", Kind: "ReplicaSet"}:        {"spec", "template", "spec"},
+		{Group: "apps", Kind: "StatefulSet"}:       {"spec", "template", "spec"},
+		{Group: "apps", Kind: "DaemonSet"}:         {"spec", "template", "spec"},
+		{Group: "batch", Kind: "Job"}:              {"spec", "template", "spec"},
+		{Group: "batch", Kind: "CronJob"}:          {"spec", "jobTemplate", "spec", "template", "spec"},
+	} {
+		if err := RegisterDependencyFunc(groupKind, podSpecDependencyFunc(podSpecPath...)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+var (
+	namespaceGVK             = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
+	serviceAccountGVK        = schema.GroupVersionKind{Version: "v1", Kind: "ServiceAccount"}
+	configMapGVK             = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	secretGVK                = schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	persistentVolumeClaimGVK = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
+	crdGVK                   = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: util.CRDKind}
+)
+
+const (
+	namespacesResource             = "namespaces"
+	serviceAccountsResource        = "serviceaccounts"
+	configMapsResource             = "configmaps"
+	secretsResource                = "secrets"
+	persistentVolumeClaimsResource = "persistentvolumeclaims"
+	crdsResource                   = "customresourcedefinitions"
+)
+
+// podSpecDependencyFunc returns a DependencyFunc for a kind of object that
+// holds a PodSpec at the given path.
+// The dependencies are the ServiceAccount (other than "default"), the image pull Secrets,
+// and the ConfigMaps, Secrets and PersistentVolumeClaims referenced from the volumes
+// and from the `env` and `envFrom` of the containers and init containers.
+func podSpecDependencyFunc(podSpecPath ...string) DependencyFunc {
+	return func(obj *unstructured.Unstructured) []util.ObjectIdentifier {
+		podSpec, found, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+		if err != nil || !found {
+			return nil
+		}
+		namespace := obj.GetNamespace()
+		deps := []util.ObjectIdentifier{}
+		add := func(gvk schema.GroupVersionKind, resource, name string) {
+			if name != "" {
+				deps = append(deps, util.ObjectIdentifier{GVK: gvk, Resource: resource,
+					ObjectName: cache.ObjectName{Namespace: namespace, Name: name}})
+			}
+		}
+		if sa, _, _ := unstructured.NestedString(podSpec, "serviceAccountName"); sa != "default" {
+			add(serviceAccountGVK, serviceAccountsResource, sa)
+		}
+		for _, ref := range nestedMaps(podSpec, "imagePullSecrets") {
+			add(secretGVK, secretsResource, nestedString(ref, "name"))
+		}
+		for _, volume := range nestedMaps(podSpec, "volumes") {
+			add(configMapGVK, configMapsResource, nestedString(volume, "configMap", "name"))
+			add(secretGVK, secretsResource, nestedString(volume, "secret", "secretName"))
+			add(persistentVolumeClaimGVK, persistentVolumeClaimsResource, nestedString(volume, "persistentVolumeClaim", "claimName"))
+			for _, source := range nestedMaps(volume, "projected", "sources") {
+				add(configMapGVK, configMapsResource, nestedString(source, "configMap", "name"))
+				add(secretGVK, secretsResource, nestedString(source, "secret", "name"))
+			}
+		}
+		for _, containersField := range []string{"containers", "initContainers"} {
+			for _, container := range nestedMaps(podSpec, containersField) {
+				for _, envFrom := range nestedMaps(container, "envFrom") {
+					add(configMapGVK, configMapsResource, nestedString(envFrom, "configMapRef", "name"))
+					add(secretGVK, secretsResource, nestedString(envFrom, "secretRef", "name"))
+				}
+				for _, env := range nestedMaps(container, "env") {
+					add(configMapGVK, configMapsResource, nestedString(env, "valueFrom", "configMapKeyRef", "name"))
+					add(secretGVK, secretsResource, nestedString(env, "valueFrom", "secretKeyRef", "name"))
+				}
+			}
+		}
+		return deps
+	}
+}
+
+// nestedMaps returns the members of the slice at the given path that are maps.
+func nestedMaps(obj map[string]interface{}, fields ...string) []map[string]interface{} {
+	slice, _, _ := unstructured.NestedSlice(obj, fields...)
+	maps := make([]map[string]interface{}, 0, len(slice))
+	for _, elt := range slice {
+		if eltMap, is := elt.(map[string]interface{}); is {
+			maps = append(maps, eltMap)
+		}
+	}
+	return maps
+}
+
+// nestedString returns the string at the given path, or the empty string if there is none.
+func nestedString(obj map[string]interface{}, fields ...string) string {
+	str, _, _ := unstructured.NestedString(obj, fields...)
+	return str
+}
+
+// dependencyTracker records, for each BindingPolicy, the dependencies of the
+// objects that it selects with `wantDependencies`.
+// A dependencyTracker is safe for concurrent use.
+type dependencyTracker struct {
+	sync.Mutex
+
+	// dependencies maps BindingPolicy name to dependent object to the objects that it depends on
+	dependencies map[string]map[util.ObjectIdentifier]sets.Set[util.ObjectIdentifier]
+
+	// dependents maps BindingPolicy name to object to the dependent objects that depend on it.
+	// This is the inverse of dependencies.
+	dependents map[string]map[util.ObjectIdentifier]sets.Set[util.ObjectIdentifier]
+}
+
+func newDependencyTracker() *dependencyTracker {
+	return &dependencyTracker{
+		dependencies: map[string]map[util.ObjectIdentifier]sets.Set[util.ObjectIdentifier]{},
+		dependents:   map[string]map[util.ObjectIdentifier]sets.Set[util.ObjectIdentifier]{},
+	}
+}
+
+// setDependencies records that, for the given BindingPolicy, the given dependent object
+// depends on the given objects (none if nil).
+// Returns the objects that the dependent object newly depends on or no longer depends on,
+// as those are the ones whose inclusion as dependencies may have changed.
+// The given set is expected not to be mutated during and after this call by the caller.
+func (tracker *dependencyTracker) setDependencies(bindingPolicyName string, dependent util.ObjectIdentifier,
+	dependencies sets.Set[util.ObjectIdentifier]) sets.Set[util.ObjectIdentifier] {
+	tracker.Lock()
+	defer tracker.Unlock()
+	policyDependencies := tracker.dependencies[bindingPolicyName]
+	policyDependents := tracker.dependents[bindingPolicyName]
+	if policyDependencies == nil {
+		if dependencies.Len() == 0 {
+			return sets.New[util.ObjectIdentifier]()
+		}
+		policyDependencies = map[util.ObjectIdentifier]sets.Set[util.ObjectIdentifier]{}
+		policyDependents = map[util.ObjectIdentifier]sets.Set[util.ObjectIdentifier]{}
+		tracker.dependencies[bindingPolicyName] = policyDependencies
+		tracker.dependents[bindingPolicyName] = policyDependents
+	}
+	previous := policyDependencies[dependent]
+	dropped := previous.Difference(dependencies)
+	added := dependencies.Difference(previous)
+	for dependency := range dropped {
+		dependents := policyDependents[dependency]
+		dependents.Delete(dependent)
+		if dependents.Len() == 0 {
+			delete(policyDependents, dependency)
+		}
+	}
+	for dependency := range added {
+		dependents := policyDependents[dependency]
+		if dependents == nil {
+			dependents = sets.New[util.ObjectIdentifier]()
+			policyDependents[dependency] = dependents
+		}
+		dependents.Insert(dependent)
+	}
+	if dependencies.Len() == 0 {
+		delete(policyDependencies, dependent)
+	} else {
+		policyDependencies[dependent] = dependencies
+	}
+	if len(policyDependencies) == 0 {
+		delete(tracker.dependencies, bindingPolicyName)
+		delete(tracker.dependents, bindingPolicyName)
+	}
+	return added.Union(dropped)
+}
+
+// isDependency tells whether, for the given BindingPolicy, some object depends on the given object.
+func (tracker *dependencyTracker) isDependency(bindingPolicyName string, objIdentifier util.ObjectIdentifier) bool {
+	tracker.Lock()
+	defer tracker.Unlock()
+	return tracker.dependents[bindingPolicyName][objIdentifier].Len() > 0
+}
+
+// dependenciesOf returns the dependencies of the given object for the given
+// BindingPolicy: its Namespace, the CustomResourceDefinition that defines its kind
+// and the objects found by the registered DependencyFunc for its kind.
+// Only dependencies of resources that the controller watches are returned,
+// as others can be neither resolved nor downsynced.
+func (c *Controller) dependenciesOf(objIdentifier util.ObjectIdentifier, obj *unstructured.Unstructured) sets.Set[util.ObjectIdentifier] {
+	candidates := []util.ObjectIdentifier{}
+	if namespace := objIdentifier.ObjectName.Namespace; namespace != "" {
+		candidates = append(candidates, util.ObjectIdentifier{GVK: namespaceGVK, Resource: namespacesResource,
+			ObjectName: cache.ObjectName{Name: namespace}})
+	}
+	if group := objIdentifier.GVK.Group; group != "" {
+		candidates = append(candidates, util.ObjectIdentifier{GVK: crdGVK, Resource: crdsResource,
+			ObjectName: cache.ObjectName{Name: objIdentifier.Resource + "." + group}})
+	}
+	if fn, have := getDependencyFunc(objIdentifier.GVK.GroupKind()); have {
+		candidates = append(candidates, fn(obj)...)
+	}
+	dependencies := sets.New[util.ObjectIdentifier]()
+	for _, candidate := range candidates {
+		if candidate == objIdentifier {
+			continue
+		}
+		lister, found := c.listers.Get(candidate.GVR())
+		if !found {
+			continue
+		}
+		if candidate.GVK.Kind == util.CRDKind {
+			// most groups are not defined by CRDs
+			if _, err := getObject(lister, "", candidate.ObjectName.Name); err != nil {
+				continue
+			}
+		}
+		dependencies.Insert(candidate)
+	}
+	return dependencies
+}
+
+// forgetBindingPolicy drops what is recorded for the given BindingPolicy.
+func (tracker *dependencyTracker) forgetBindingPolicy(bindingPolicyName string) {
+	tracker.Lock()
+	defer tracker.Unlock()
+	delete(tracker.dependencies, bindingPolicyName)
+	delete(tracker.dependents, bindingPolicyName)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+func TestPodSpecDependencies(t *testing.T) {
+	podSpec := map[string]interface{}{
+		"serviceAccountName": "runner",
+		"imagePullSecrets":   []interface{}{map[string]interface{}{"name": "pull"}},
+		"volumes": []interface{}{
+			map[string]interface{}{"name": "v1", "configMap": map[string]interface{}{"name": "cm-vol"}},
+			map[string]interface{}{"name": "v2", "secret": map[string]interface{}{"secretName": "sec-vol"}},
+			map[string]interface{}{"name": "v3", "persistentVolumeClaim": map[string]interface{}{"claimName": "data"}},
+			map[string]interface{}{"name": "v4", "projected": map[string]interface{}{"sources": []interface{}{
+				map[string]interface{}{"configMap": map[string]interface{}{"name": "cm-proj"}},
+			}}},
+		},
+		"initContainers": []interface{}{map[string]interface{}{"name": "init",
+			"envFrom": []interface{}{map[string]interface{}{"secretRef": map[string]interface{}{"name": "sec-env"}}}}},
+		"containers": []interface{}{map[string]interface{}{"name": "main",
+			"env": []interface{}{
+				map[string]interface{}{"name": "A", "value": "a"},
+				map[string]interface{}{"name": "B", "valueFrom": map[string]interface{}{
+					"configMapKeyRef": map[string]interface{}{"name": "cm-env", "key": "b"}}},
+			}}},
+	}
+	id := func(gvk schema.GroupVersionKind, resource, name string) util.ObjectIdentifier {
+		return util.ObjectIdentifier{GVK: gvk, Resource: resource, ObjectName: cache.ObjectName{Namespace: "ns", Name: name}}
+	}
+	expected := sets.New(
+		id(serviceAccountGVK, serviceAccountsResource, "runner"),
+		id(secretGVK, secretsResource, "pull"),
+		id(configMapGVK, configMapsResource, "cm-vol"),
+		id(secretGVK, secretsResource, "sec-vol"),
+		id(persistentVolumeClaimGVK, persistentVolumeClaimsResource, "data"),
+		id(configMapGVK, configMapsResource, "cm-proj"),
+		id(secretGVK, secretsResource, "sec-env"),
+		id(configMapGVK, configMapsResource, "cm-env"),
+	)
+
+	testCases := []struct {
+		name     string
+		kind     schema.GroupKind
+		object   map[string]interface{}
+		expected sets.Set[util.ObjectIdentifier]
+	}{
+		{name: "pod", kind: schema.GroupKind{Kind: "Pod"},
+			object:   map[string]interface{}{"spec": podSpec},
+			expected: expected},
+		{name: "deployment", kind: schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			object:   map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": podSpec}}},
+			expected: expected},
+		{name: "cronjob", kind: schema.GroupKind{Group: "batch", Kind: "CronJob"},
+			object: map[string]interface{}{"spec": map[string]interface{}{"jobTemplate": map[string]interface{}{
+				"spec": map[string]interface{}{"template": map[string]interface{}{"spec": podSpec}}}}},
+			expected: expected},
+		{name: "default service account", kind: schema.GroupKind{Kind: "Pod"},
+			object:   map[string]interface{}{"spec": map[string]interface{}{"serviceAccountName": "default"}},
+			expected: sets.New[util.ObjectIdentifier]()},
+		{name: "no pod spec", kind: schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			object:   map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+			expected: sets.New[util.ObjectIdentifier]()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fn, have := getDependencyFunc(tc.kind)
+			if !have {
+				t.Fatalf("no dependency function registered for %s", tc.kind)
+			}
+			obj := &unstructured.Unstructured{Object: tc.object}
+			obj.SetNamespace("ns")
+			actual := sets.New(fn(obj)...)
+			if !actual.Equal(tc.expected) {
+				t.Errorf("expected: %v, got: %v", tc.expected.UnsortedList(), actual.UnsortedList())
+			}
+		})
+	}
+
+	if err := RegisterDependencyFunc(schema.GroupKind{Kind: "Pod"}, podSpecDependencyFunc("spec")); err == nil {
+		t.Error("expected an error when registering a second dependency function for Pod")
+	}
+}
+
+func TestDependencyTracker(t *testing.T) {
+	id := func(name string) util.ObjectIdentifier {
+		return util.ObjectIdentifier{GVK: configMapGVK, Resource: configMapsResource, ObjectName: cache.ObjectName{Namespace: "ns", Name: name}}
+	}
+	tracker := newDependencyTracker()
+	if changed := tracker.setDependencies("bp", id("d1"), sets.New(id("a"), id("b"))); !changed.Equal(sets.New(id("a"), id("b"))) {
+		t.Errorf("unexpected changes when adding d1: %v", changed.UnsortedList())
+	}
+	if changed := tracker.setDependencies("bp", id("d2"), sets.New(id("b"))); !changed.Equal(sets.New(id("b"))) {
+		t.Errorf("unexpected changes when adding d2: %v", changed.UnsortedList())
+	}
+	if changed := tracker.setDependencies("bp", id("d1"), sets.New(id("a"), id("c"))); !changed.Equal(sets.New(id("b"), id("c"))) {
+		t.Errorf("unexpected changes when updating d1: %v", changed.UnsortedList())
+	}
+	for name, expected := range map[string]bool{"a": true, "b": true, "c": true, "d1": false} {
+		if actual := tracker.isDependency("bp", id(name)); actual != expected {
+			t.Errorf("isDependency(%s): expected %v, got %v", name, expected, actual)
+		}
+	}
+	if tracker.isDependency("other", id("a")) {
+		t.Error("dependency leaked to another BindingPolicy")
+	}
+	tracker.setDependencies("bp", id("d2"), sets.New[util.ObjectIdentifier]())
+	if tracker.isDependency("bp", id("b")) {
+		t.Error("b is still a dependency after d2 dropped it")
+	}
+	tracker.forgetBindingPolicy("bp")
+	if tracker.isDependency("bp", id("a")) {
+		t.Error("a is still a dependency after forgetting the BindingPolicy")
+	}
+}
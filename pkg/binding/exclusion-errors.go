@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kubestellar/kubestellar/pkg/abstract"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+const (
+	// exclusionErrorPrefix starts every error, in the status of a bindingpolicy,
+	// about a downsync exclusion that could not be evaluated.
+	exclusionErrorPrefix = "could not evaluate spec.downsyncExclusions"
+
+	// maxReportedExclusionErrors is the most errors about exclusions that are
+	// listed in the status of a bindingpolicy; the rest are only counted.
+	maxReportedExclusionErrors = 10
+)
+
+// exclusionErrorTracker remembers, for each bindingpolicy, the objects for which
+// some downsync exclusion could not be evaluated, and why.
+// Such objects are treated as excluded, and the errors are reported in the
+// status of the bindingpolicy.
+type exclusionErrorTracker struct {
+	sync.Mutex
+	// errors maps the name of a bindingpolicy to the errors for each object.
+	// Bindingpolicies and objects without errors have no entry.
+	errors map[string]map[util.ObjectIdentifier][]string
+}
+
+func newExclusionErrorTracker() *exclusionErrorTracker {
+	return &exclusionErrorTracker{errors: map[string]map[util.ObjectIdentifier][]string{}}
+}
+
+// setErrors records the exclusion errors of the given object for the given
+// bindingpolicy and returns whether they changed.
+func (et *exclusionErrorTracker) setErrors(bindingPolicyName string, objIdentifier util.ObjectIdentifier, errs []string) bool {
+	et.Lock()
+	defer et.Unlock()
+	byObject := et.errors[bindingPolicyName]
+	old := byObject[objIdentifier]
+	if abstract.SliceEqual(old, errs) {
+		return false
+	}
+	if len(errs) == 0 {
+		delete(byObject, objIdentifier)
+		if len(byObject) == 0 {
+			delete(et.errors, bindingPolicyName)
+		}
+		return true
+	}
+	if byObject == nil {
+		byObject = map[util.ObjectIdentifier][]string{}
+		et.errors[bindingPolicyName] = byObject
+	}
+	byObject[objIdentifier] = errs
+	return true
+}
+
+// forget removes the given bindingpolicy.
+func (et *exclusionErrorTracker) forget(bindingPolicyName string) {
+	et.Lock()
+	defer et.Unlock()
+	delete(et.errors, bindingPolicyName)
+}
+
+// report returns the errors to put in the status of the given bindingpolicy, in a stable order.
+func (et *exclusionErrorTracker) report(bindingPolicyName string) []string {
+	et.Lock()
+	defer et.Unlock()
+	var errs []string
+	for _, objErrs := range et.errors[bindingPolicyName] {
+		errs = append(errs, objErrs...)
+	}
+	sort.Strings(errs)
+	if len(errs) > maxReportedExclusionErrors {
+		errs = append(errs[:maxReportedExclusionErrors],
+			fmt.Sprintf("%s for %d more object(s)", exclusionErrorPrefix, len(errs)-maxReportedExclusionErrors))
+	}
+	return errs
+}
+
+// describeExclusionError describes, for the status of a bindingpolicy, the failure
+// to evaluate the downsync exclusion at the given index for the given object.
+func describeExclusionError(idx int, objIdentifier util.ObjectIdentifier, err error) string {
+	return fmt.Sprintf("%s[%d] for %s %s, so the object is excluded: %v", exclusionErrorPrefix, idx,
+		objIdentifier.GVK.GroupKind(), objIdentifier.ObjectName, err)
+}
+
+// withExclusionErrors returns the given errors from the status of the given bindingpolicy,
+// less those about exclusions that could not be evaluated, plus the current ones of that kind.
+func (c *Controller) withExclusionErrors(bindingPolicyName string, errs []string) []string {
+	var ans []string
+	for _, err := range errs {
+		if !strings.HasPrefix(err, exclusionErrorPrefix) {
+			ans = append(ans, err)
+		}
+	}
+	return append(ans, c.exclusionErrors.report(bindingPolicyName)...)
+}
+
+// noteExclusionErrors records the exclusion errors of the given object for the given bindingpolicy,
+// and enqueues its binding (whose syncing updates the status of the bindingpolicy) if they changed.
+func (c *Controller) noteExclusionErrors(bindingPolicyName string, objIdentifier util.ObjectIdentifier, errs []string) {
+	if c.exclusionErrors.setErrors(bindingPolicyName, objIdentifier, errs) {
+		c.enqueueBinding(bindingPolicyName)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kubestellar/kubestellar/pkg/abstract"
+)
+
+func TestExclusionErrors(t *testing.T) {
+	c := &Controller{exclusionErrors: newExclusionErrorTracker()}
+	for idx := 0; idx < maxReportedExclusionErrors+2; idx++ {
+		objIdentifier, _ := testWorkloadObject("ConfigMap", "configmaps", "app", fmt.Sprintf("cm-%02d", idx), nil)
+		if !c.exclusionErrors.setErrors("bp", objIdentifier, []string{describeExclusionError(0, objIdentifier, fmt.Errorf("boom"))}) {
+			t.Errorf("expected a change for object %d", idx)
+		}
+	}
+	errs := c.withExclusionErrors("bp", []string{"compile error", exclusionErrorPrefix + "[0] for stale"})
+	if len(errs) != 1+maxReportedExclusionErrors+1 || errs[0] != "compile error" {
+		t.Errorf("expected the compile error, %d exclusion errors and a count, got %v", maxReportedExclusionErrors, errs)
+	}
+	if expected := exclusionErrorPrefix + " for 2 more object(s)"; errs[len(errs)-1] != expected {
+		t.Errorf("expected last error %q, got %q", expected, errs[len(errs)-1])
+	}
+
+	objIdentifier, _ := testWorkloadObject("ConfigMap", "configmaps", "app", "cm-00", nil)
+	if !c.exclusionErrors.setErrors("bp", objIdentifier, nil) {
+		t.Error("expected a change when clearing the errors of an object")
+	}
+	if c.exclusionErrors.setErrors("bp", objIdentifier, nil) {
+		t.Error("expected no change when clearing again")
+	}
+	c.exclusionErrors.forget("bp")
+	if errs := c.withExclusionErrors("bp", []string{"compile error"}); !abstract.SliceEqual(errs, []string{"compile error"}) {
+		t.Errorf("expected only the compile error after forgetting, got %v", errs)
+	}
+}
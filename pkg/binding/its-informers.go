@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	clusterclientset "open-cluster-management.io/api/client/cluster/clientset/versioned"
+	clusterpkginformers "open-cluster-management.io/api/client/cluster/informers/externalversions"
+	clusterinformers "open-cluster-management.io/api/client/cluster/informers/externalversions/cluster/v1"
+	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+)
+
+// ITSInformers holds the informers that a binding controller uses on the ITSes.
+// There is one ITS per shard of the inventory; the inventory that the binding
+// controller sees is the union of the shards.
+// When one process serves several WDSes, their binding controllers share one ITSInformers
+// (see NewControllerSharingITS) rather than each watching the ITSes on its own.
+type ITSInformers struct {
+	factories []clusterpkginformers.SharedInformerFactory
+}
+
+// NewITSInformers returns the ITSInformers for the ITSes at the given configs,
+// one per shard of the inventory.
+func NewITSInformers(itsRestConfigs ...*rest.Config) (*ITSInformers, error) {
+	its := &ITSInformers{}
+	for _, itsRestConfig := range itsRestConfigs {
+		clusterClient, err := clusterclientset.NewForConfig(itsRestConfig)
+		if err != nil {
+			return nil, err
+		}
+		factory := clusterpkginformers.NewSharedInformerFactory(clusterClient, defaultResyncPeriod)
+		// Request the informer now, so that Start starts it.
+		factory.Cluster().V1().ManagedClusters().Informer()
+		its.factories = append(its.factories, factory)
+	}
+	return its, nil
+}
+
+// Start starts the informers, which run until the given channel is closed.
+func (its *ITSInformers) Start(stopCh <-chan struct{}) {
+	for _, factory := range its.factories {
+		factory.Start(stopCh)
+	}
+}
+
+func (its *ITSInformers) managedClusters() []clusterinformers.ManagedClusterInformer {
+	preInformers := make([]clusterinformers.ManagedClusterInformer, len(its.factories))
+	for idx, factory := range its.factories {
+		preInformers[idx] = factory.Cluster().V1().ManagedClusters()
+	}
+	return preInformers
+}
+
+// shardedClusterLister is a ManagedClusterLister over the union of the inventory shards.
+// A cluster name is expected to appear in only one shard; if it appears in several
+// then the first of those shards wins.
+type shardedClusterLister []clusterlisters.ManagedClusterLister
+
+var _ clusterlisters.ManagedClusterLister = shardedClusterLister{}
+
+func (scl shardedClusterLister) List(selector labels.Selector) ([]*clusterv1.ManagedCluster, error) {
+	if len(scl) == 1 {
+		return scl[0].List(selector)
+	}
+	seen := sets.New[string]()
+	var ans []*clusterv1.ManagedCluster
+	for _, lister := range scl {
+		clusters, err := lister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			if seen.Has(cluster.Name) {
+				continue
+			}
+			seen.Insert(cluster.Name)
+			ans = append(ans, cluster)
+		}
+	}
+	return ans, nil
+}
+
+func (scl shardedClusterLister) Get(name string) (*clusterv1.ManagedCluster, error) {
+	for _, lister := range scl {
+		cluster, err := lister.Get(name)
+		if err == nil || !errors.IsNotFound(err) {
+			return cluster, err
+		}
+	}
+	return nil, errors.NewNotFound(clusterv1.Resource("managedcluster"), name)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestShardedClusterLister(t *testing.T) {
+	makeLister := func(clusters ...*clusterv1.ManagedCluster) clusterlisters.ManagedClusterLister {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		for _, cluster := range clusters {
+			indexer.Add(cluster)
+		}
+		return clusterlisters.NewManagedClusterLister(indexer)
+	}
+	cluster := func(name, env string) *clusterv1.ManagedCluster {
+		return &clusterv1.ManagedCluster{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"env": env}}}
+	}
+	lister := shardedClusterLister{
+		makeLister(cluster("c1", "prod"), cluster("c2", "test")),
+		makeLister(cluster("c3", "prod"), cluster("c1", "test")),
+	}
+	clusters, err := lister.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	names := sets.New[string]()
+	for _, cluster := range clusters {
+		names.Insert(cluster.Name)
+	}
+	if expected := sets.New("c1", "c2", "c3"); len(clusters) != 3 || !names.Equal(expected) {
+		t.Errorf("expected clusters %v, got %v", sets.List(expected), clusters)
+	}
+	prod, err := lister.List(labels.SelectorFromSet(labels.Set{"env": "prod"}))
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(prod) != 2 {
+		t.Errorf("expected 2 prod clusters, got %v", prod)
+	}
+	c1, err := lister.Get("c1")
+	if err != nil || c1.Labels["env"] != "prod" {
+		t.Errorf("expected c1 from the first shard, got %v, %v", c1, err)
+	}
+	if _, err := lister.Get("c4"); !errors.IsNotFound(err) {
+		t.Errorf("expected NotFound for c4, got %v", err)
+	}
+}
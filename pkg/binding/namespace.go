@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// namespaceSelectorsDistinguish tells whether the `namespaceSelectors` of some
+// DownsyncObjectTest of some BindingPolicy give different verdicts for the
+// given two sets of Namespace labels.
+// Errs on the side of true.
+func (c *Controller) namespaceSelectorsDistinguish(oldLabels, newLabels map[string]string) bool {
+	bindingPolicies, err := c.listBindingPolicies()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return true
+	}
+	distinguishes := func(test *v1alpha1.DownsyncObjectTest) bool {
+		if len(test.NamespaceSelectors) == 0 || ALabelSelectorIsEmpty(test.NamespaceSelectors...) {
+			return false
+		}
+		return labelsMatchAny(c.logger, oldLabels, test.NamespaceSelectors) != labelsMatchAny(c.logger, newLabels, test.NamespaceSelectors)
+	}
+	for _, bindingPolicy := range bindingPolicies {
+		for idx := range bindingPolicy.Spec.Downsync {
+			if distinguishes(&bindingPolicy.Spec.Downsync[idx].DownsyncObjectTest) {
+				return true
+			}
+		}
+		for idx := range bindingPolicy.Spec.DownsyncExclusions {
+			if distinguishes(&bindingPolicy.Spec.DownsyncExclusions[idx]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requeueObjectsInNamespace enqueues the workload objects in the given namespace
+// whose resource has some downsync clause that might match them.
+func (c *Controller) requeueObjectsInNamespace(ctx context.Context, namespace string) error {
+	logger := klog.FromContext(ctx)
+
+	return c.listers.Iterator(func(key schema.GroupVersionResource, lister cache.GenericLister) error {
+		if len(c.bindingPolicyIndex.candidateClauses(key.Group, key.Resource, namespace)) == 0 {
+			return nil // continue iterating
+		}
+
+		objs, err := lister.ByNamespace(namespace).List(labels.Everything())
+		if err != nil {
+			return fmt.Errorf("failed to list objects for key %v in namespace %s: %w", key, namespace, err)
+		}
+
+		for _, obj := range objs {
+			logger.V(4).Info("Enqueuing workload object due to change in Namespace labels",
+				"listerKey", key, "obj", util.RefToRuntimeObj(obj))
+			c.enqueueObject(obj, key.Resource)
+		}
+		return nil
+	})
+}
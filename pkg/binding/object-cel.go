@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// celObjectKey is the name of the CEL variable that holds the object being tested.
+const celObjectKey = "obj"
+
+// objectCELPrograms caches the compiled `objectCELExpression`s of BindingPolicy objects.
+// Each BindingPolicy's expressions are compiled once per generation.
+type objectCELPrograms struct {
+	env *cel.Env
+
+	sync.Mutex
+	byPolicy map[string]*policyCELPrograms // BindingPolicy name -> programs
+}
+
+// policyCELPrograms holds the compilation results for one generation of one BindingPolicy.
+type policyCELPrograms struct {
+	generation int64
+	programs   map[v1alpha1.Expression]cel.Program
+	// errors holds the compile errors, in the order of the tests
+	errors []string
+}
+
+func newObjectCELPrograms() (*objectCELPrograms, error) {
+	env, err := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar(celObjectKey, decls.NewMapType(decls.String, decls.Dyn)),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	return &objectCELPrograms{env: env, byPolicy: map[string]*policyCELPrograms{}}, nil
+}
+
+// compileErrors returns the errors from compiling the `objectCELExpression`s of the given
+// BindingPolicy. The returned slice belongs to the caller.
+// `*bindingPolicy` is immutable.
+func (ocp *objectCELPrograms) compileErrors(bindingPolicy *v1alpha1.BindingPolicy) []string {
+	ocp.Lock()
+	defer ocp.Unlock()
+	programs := ocp.programsLocked(bindingPolicy)
+	if len(programs.errors) == 0 {
+		return nil
+	}
+	return append([]string{}, programs.errors...)
+}
+
+// evaluate returns whether the given expression, which appears in the given BindingPolicy,
+// evaluates to `true` for the given object.
+// An error is returned if the expression does not compile, fails to evaluate,
+// or does not evaluate to a boolean.
+// `*bindingPolicy` is immutable.
+func (ocp *objectCELPrograms) evaluate(bindingPolicy *v1alpha1.BindingPolicy, expression v1alpha1.Expression, obj map[string]interface{}) (bool, error) {
+	ocp.Lock()
+	program, have := ocp.programsLocked(bindingPolicy).programs[expression]
+	ocp.Unlock()
+	if !have {
+		return false, fmt.Errorf("expression %q did not compile", expression)
+	}
+	result, _, err := program.Eval(map[string]interface{}{celObjectKey: obj})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+	matched, is := result.Value().(bool)
+	if !is {
+		return false, fmt.Errorf("expression evaluated to a %s rather than a bool", result.Type().TypeName())
+	}
+	return matched, nil
+}
+
+// forget drops the cached programs of the named BindingPolicy.
+func (ocp *objectCELPrograms) forget(bindingPolicyName string) {
+	ocp.Lock()
+	defer ocp.Unlock()
+	delete(ocp.byPolicy, bindingPolicyName)
+}
+
+// programsLocked returns the programs for the current generation of the given
+// BindingPolicy, compiling them if necessary. The caller must hold the lock.
+func (ocp *objectCELPrograms) programsLocked(bindingPolicy *v1alpha1.BindingPolicy) *policyCELPrograms {
+	programs, have := ocp.byPolicy[bindingPolicy.Name]
+	if have && programs.generation == bindingPolicy.Generation {
+		return programs
+	}
+	programs = &policyCELPrograms{
+		generation: bindingPolicy.Generation,
+		programs:   map[v1alpha1.Expression]cel.Program{},
+	}
+	for idx, clause := range bindingPolicy.Spec.Downsync {
+		ocp.compileInto(programs, clause.ObjectCELExpression, fmt.Sprintf("spec.downsync[%d]", idx))
+	}
+	for idx, exclusion := range bindingPolicy.Spec.DownsyncExclusions {
+		ocp.compileInto(programs, exclusion.ObjectCELExpression, fmt.Sprintf("spec.downsyncExclusions[%d]", idx))
+	}
+	ocp.byPolicy[bindingPolicy.Name] = programs
+	return programs
+}
+
+// compileInto compiles the given expression, if present and not already compiled,
+// into the given programs. A compile error is recorded with the given path to the test.
+func (ocp *objectCELPrograms) compileInto(programs *policyCELPrograms, expression *v1alpha1.Expression, testPath string) {
+	if expression == nil || len(*expression) == 0 {
+		return
+	}
+	if _, have := programs.programs[*expression]; have {
+		return
+	}
+	program, err := ocp.compile(*expression)
+	if err != nil {
+		programs.errors = append(programs.errors, fmt.Sprintf("%s.objectCELExpression: %v", testPath, err))
+		return
+	}
+	programs.programs[*expression] = program
+}
+
+func (ocp *objectCELPrograms) compile(expression v1alpha1.Expression) (cel.Program, error) {
+	ast, issues := ocp.env.Compile(string(expression))
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", issues.Err())
+	}
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", outputType)
+	}
+	program, err := ocp.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create program: %w", err)
+	}
+	return program, nil
+}
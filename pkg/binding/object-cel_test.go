@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestObjectCELPrograms(t *testing.T) {
+	obj := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":        "cm",
+			"annotations": map[string]interface{}{"team": "x"},
+		},
+		"data": map[string]interface{}{"a": "1", "b": "2", "c": "3"},
+	}
+	testCases := []struct {
+		name          string
+		expression    v1alpha1.Expression
+		expectMatch   bool
+		expectErr     bool
+		expectCompile bool
+	}{
+		{name: "annotation matches", expression: `obj.metadata.annotations["team"] == "x"`, expectMatch: true, expectCompile: true},
+		{name: "annotation does not match", expression: `obj.metadata.annotations["team"] == "y"`, expectCompile: true},
+		{name: "data size", expression: `size(obj.data) > 2`, expectMatch: true, expectCompile: true},
+		{name: "missing field", expression: `obj.spec.replicas > 1`, expectErr: true, expectCompile: true},
+		{name: "not a bool", expression: `obj.metadata.name`, expectErr: true, expectCompile: true},
+		{name: "syntax error", expression: `obj.metadata.name ==`, expectErr: true},
+		{name: "wrong result type", expression: `1 + 2`, expectErr: true},
+	}
+
+	ocp, err := newObjectCELPrograms()
+	if err != nil {
+		t.Fatalf("failed to make objectCELPrograms: %v", err)
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expression := tc.expression
+			bindingPolicy := &v1alpha1.BindingPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "bp-" + tc.name, Generation: 1},
+				Spec: v1alpha1.BindingPolicySpec{Downsync: []v1alpha1.DownsyncPolicyClause{
+					{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{ObjectCELExpression: &expression}},
+				}},
+			}
+			if compileErrs := ocp.compileErrors(bindingPolicy); (len(compileErrs) == 0) != tc.expectCompile {
+				t.Errorf("expected compile success %v, got errors %v", tc.expectCompile, compileErrs)
+			}
+			matched, err := ocp.evaluate(bindingPolicy, expression, obj)
+			if (err != nil) != tc.expectErr {
+				t.Errorf("expected error %v, got %v", tc.expectErr, err)
+			}
+			if matched != tc.expectMatch {
+				t.Errorf("expected match %v, got %v", tc.expectMatch, matched)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
+)
+
+// overlapTracker remembers, for each bindingpolicy, its priority and the other
+// bindingpolicies whose resolutions overlap with its own, as of the last sync
+// of its binding. The binding of a bindingpolicy excludes objects from destinations
+// according to the resolutions and priorities of the others that overlap with it,
+// so this is what tells the controller which other bindings to revisit.
+type overlapTracker struct {
+	sync.Mutex
+	records map[string]overlapRecord
+}
+
+type overlapRecord struct {
+	priority int32
+	partners sets.Set[string]
+}
+
+func newOverlapTracker() *overlapTracker {
+	return &overlapTracker{records: map[string]overlapRecord{}}
+}
+
+// note records the priority and partners of the given bindingpolicy, and returns
+// the names of the bindingpolicies whose bindings may need to change as a result.
+// Those are all the old and new partners if the priority or the binding of the
+// given bindingpolicy changed, otherwise only the partners that were added or removed.
+func (ot *overlapTracker) note(bindingPolicyName string, priority int32, partners sets.Set[string], bindingChanged bool) sets.Set[string] {
+	ot.Lock()
+	defer ot.Unlock()
+	old, found := ot.records[bindingPolicyName]
+	if partners.Len() == 0 {
+		delete(ot.records, bindingPolicyName)
+	} else {
+		ot.records[bindingPolicyName] = overlapRecord{priority: priority, partners: partners}
+	}
+	if bindingChanged || (found && old.priority != priority) {
+		return partners.Union(old.partners)
+	}
+	return partners.SymmetricDifference(old.partners)
+}
+
+// forget removes the given bindingpolicy and returns its partners.
+func (ot *overlapTracker) forget(bindingPolicyName string) sets.Set[string] {
+	ot.Lock()
+	defer ot.Unlock()
+	old := ot.records[bindingPolicyName]
+	delete(ot.records, bindingPolicyName)
+	return old.partners
+}
+
+// updateOverlaps finds the bindingpolicies whose resolutions currently overlap with
+// the resolution of the given one, and enqueues the bindings of those that may need
+// to change because of the given one's priority or binding.
+func (c *Controller) updateOverlaps(bindingPolicyName string, bindingChanged bool) {
+	var priority int32
+	if bindingPolicy, err := c.bindingPolicyLister.Get(bindingPolicyName); err == nil {
+		priority = c.effectivePriority(bindingPolicy)
+	}
+	partners := c.bindingPolicyResolver.FindOverlaps(bindingPolicyName)
+	for _, partner := range sets.List(c.overlapTracker.note(bindingPolicyName, priority, partners, bindingChanged)) {
+		c.enqueueBinding(partner)
+	}
+}
+
+// forgetOverlaps enqueues the bindings of the bindingpolicies that overlapped
+// with the given one, which is going away.
+func (c *Controller) forgetOverlaps(bindingPolicyName string) {
+	for _, partner := range sets.List(c.overlapTracker.forget(bindingPolicyName)) {
+		c.enqueueBinding(partner)
+	}
+}
+
+// effectivePriority returns the priority with which this replica resolves the given bindingpolicy.
+// Priorities are compared only among the resolutions that one replica holds, so a sharded
+// replica rejects them and resolves every bindingpolicy with priority zero.
+func (c *Controller) effectivePriority(bindingPolicy *v1alpha1.BindingPolicy) int32 {
+	if c.sharder != sharding.Everything {
+		return 0
+	}
+	return bindingPolicy.Spec.Priority
+}
+
+// withEffectivePriority returns the given bindingpolicy as this replica resolves it,
+// along with the errors to report about its priority.
+// `*bindingPolicy` is immutable, a copy is returned if its priority is not in effect.
+func (c *Controller) withEffectivePriority(bindingPolicy *v1alpha1.BindingPolicy) (*v1alpha1.BindingPolicy, []string) {
+	priority := c.effectivePriority(bindingPolicy)
+	if priority == bindingPolicy.Spec.Priority {
+		return bindingPolicy, nil
+	}
+	resolved := bindingPolicy.DeepCopy()
+	resolved.Spec.Priority = priority
+	return resolved, []string{fmt.Sprintf("priority %d is ignored because the controller manager runs with --sharding, "+
+		"under which BindingPolicies are compared only with the others of the same replica", bindingPolicy.Spec.Priority)}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+func TestPriorityExclusions(t *testing.T) {
+	configMap := func(name string) util.ObjectIdentifier {
+		return util.ObjectIdentifier{GVK: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, Resource: "configmaps",
+			ObjectName: cache.ObjectName{Namespace: "ns1", Name: name}}
+	}
+	resolver := NewBindingPolicyResolver()
+	note := func(name string, priority int32, destinations []string, objects ...util.ObjectIdentifier) {
+		resolver.NoteBindingPolicy(&v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.BindingPolicySpec{Priority: priority}})
+		if err := resolver.SetDestinations(name, sets.New(destinations...)); err != nil {
+			t.Fatalf("failed to set destinations of %s: %v", name, err)
+		}
+		for _, objIdentifier := range objects {
+			if _, err := resolver.EnsureObjectData(name, objIdentifier, "uid-"+objIdentifier.ObjectName.Name, "1",
+				true, false, sets.New[string]()); err != nil {
+				t.Fatalf("failed to ensure object data in %s: %v", name, err)
+			}
+		}
+	}
+	note("baseline", 0, []string{"c1", "c2", "c3"}, configMap("a"), configMap("b"))
+	note("team", 10, []string{"c2"}, configMap("a"))
+	note("other-team", 10, []string{"c3"}, configMap("a"), configMap("b"))
+	note("peer", 0, []string{"c1"}, configMap("b"))
+
+	excludedDestinations := func(spec *v1alpha1.BindingSpec) map[string][]string {
+		result := map[string][]string{}
+		for _, clause := range spec.Workload.NamespaceScope {
+			result[clause.Name] = clause.ExcludedDestinations
+		}
+		return result
+	}
+	spec := resolver.GenerateBinding("baseline")
+	expected := map[string][]string{"a": {"c2", "c3"}, "b": {"c3"}}
+	if actual := excludedDestinations(spec); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected exclusions %v, got %v", expected, actual)
+	}
+	if !resolver.CompareBinding("baseline", spec) {
+		t.Error("expected the generated binding spec to match")
+	}
+	if resolver.CompareBinding("baseline", resolver.GenerateBinding("peer")) {
+		t.Error("expected another binding spec to not match")
+	}
+	expected = map[string][]string{"a": nil}
+	if actual := excludedDestinations(resolver.GenerateBinding("team")); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected exclusions %v, got %v", expected, actual)
+	}
+
+	// raising the priority of the baseline makes it win everywhere
+	note("baseline", 20, []string{"c1", "c2", "c3"})
+	if !reflect.DeepEqual(excludedDestinations(resolver.GenerateBinding("baseline")), map[string][]string{"a": nil, "b": nil}) {
+		t.Error("expected no exclusions after raising the priority")
+	}
+	if resolver.CompareBinding("baseline", spec) {
+		t.Error("expected the old binding spec to not match after raising the priority")
+	}
+
+	expectedOverlaps := sets.New("team", "other-team", "peer")
+	if actual := resolver.FindOverlaps("baseline"); !actual.Equal(expectedOverlaps) {
+		t.Errorf("expected overlaps %v, got %v", sets.List(expectedOverlaps), sets.List(actual))
+	}
+	if actual := resolver.FindOverlaps("team"); !actual.Equal(sets.New("baseline")) {
+		t.Errorf("expected only baseline to overlap with team, got %v", sets.List(actual))
+	}
+
+	// the others stop relating to a resolution once it no longer holds their objects
+	resolver.RemoveObjectIdentifier("peer", configMap("b"))
+	resolver.DeleteResolution("other-team")
+	if actual := resolver.FindOverlaps("baseline"); !actual.Equal(sets.New("team")) {
+		t.Errorf("expected only team to overlap with baseline, got %v", sets.List(actual))
+	}
+	if actual := resolver.FindOverlaps("peer"); actual.Len() != 0 {
+		t.Errorf("expected nothing to overlap with an empty resolution, got %v", sets.List(actual))
+	}
+}
+
+func TestOverlapTracker(t *testing.T) {
+	ot := newOverlapTracker()
+	if changed := ot.note("bp1", 0, sets.New("bp2", "bp3"), false); !changed.Equal(sets.New("bp2", "bp3")) {
+		t.Errorf("expected bp2 and bp3 to change, got %v", sets.List(changed))
+	}
+	if changed := ot.note("bp1", 0, sets.New("bp2", "bp3"), false); changed.Len() != 0 {
+		t.Errorf("expected nothing to change, got %v", sets.List(changed))
+	}
+	if changed := ot.note("bp1", 0, sets.New("bp3", "bp4"), false); !changed.Equal(sets.New("bp2", "bp4")) {
+		t.Errorf("expected bp2 and bp4 to change, got %v", sets.List(changed))
+	}
+	if changed := ot.note("bp1", 0, sets.New("bp3"), true); !changed.Equal(sets.New("bp3", "bp4")) {
+		t.Errorf("expected bp3 and bp4 to change, got %v", sets.List(changed))
+	}
+	if changed := ot.note("bp1", 5, sets.New("bp3"), false); !changed.Equal(sets.New("bp3")) {
+		t.Errorf("expected bp3 to change, got %v", sets.List(changed))
+	}
+	if changed := ot.forget("bp1"); !changed.Equal(sets.New("bp3")) {
+		t.Errorf("expected bp3 to change, got %v", sets.List(changed))
+	}
+}
+
+// shardOwningEverything is a sharded replica that happens to own every key.
+type shardOwningEverything struct{}
+
+func (shardOwningEverything) Owns(key string) bool { return true }
+
+func (shardOwningEverything) AddOwnershipChangeHandler(handler func()) func() { return func() {} }
+
+func TestPriorityRejectedWhenSharded(t *testing.T) {
+	bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp"},
+		Spec: v1alpha1.BindingPolicySpec{Priority: 5}}
+
+	lone := &Controller{sharder: sharding.Everything}
+	if resolved, errs := lone.withEffectivePriority(bindingPolicy); resolved != bindingPolicy || len(errs) != 0 {
+		t.Errorf("expected a lone replica to honor the priority, got priority %d and errors %v", resolved.Spec.Priority, errs)
+	}
+
+	sharded := &Controller{sharder: shardOwningEverything{}}
+	resolved, errs := sharded.withEffectivePriority(bindingPolicy)
+	if resolved.Spec.Priority != 0 || len(errs) != 1 {
+		t.Errorf("expected a sharded replica to reject the priority, got priority %d and errors %v", resolved.Spec.Priority, errs)
+	}
+	if bindingPolicy.Spec.Priority != 5 {
+		t.Errorf("expected the given BindingPolicy to be left alone, its priority is now %d", bindingPolicy.Spec.Priority)
+	}
+	if resolved, errs := sharded.withEffectivePriority(&v1alpha1.BindingPolicy{}); resolved.Spec.Priority != 0 || len(errs) != 0 {
+		t.Errorf("expected no errors for a BindingPolicy without priority, got %v", errs)
+	}
+}
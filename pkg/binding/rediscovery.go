@@ -0,0 +1,228 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// rediscoveryPeriod is how often the API discovery is repeated, to catch
+	// APIs that appear or disappear without a CRD event
+	// (e.g., those served by aggregated apiservers).
+	rediscoveryPeriod = 5 * time.Minute
+
+	// apiServiceSettleDelay is how long to wait after a change to an APIService
+	// before repeating the discovery, to give the apiserver time to notice.
+	apiServiceSettleDelay = 5 * time.Second
+)
+
+var apiServiceGVR = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+
+// runRediscovery repeats the API discovery periodically and soon after every change in the
+// availability of an APIService, until the given context is done.
+// Each round starts informers for the newly served resources and stops the informers
+// for the resources that are no longer served.
+// Call this after the initial informers have been started.
+func (c *Controller) runRediscovery(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	nudges := make(chan struct{}, 1)
+	nudge := func() {
+		select {
+		case nudges <- struct{}{}:
+		default:
+		}
+	}
+	if err := c.watchAPIServices(ctx, nudge); err != nil {
+		logger.Error(err, "Failed to watch APIServices, API discovery will only be repeated periodically")
+	}
+	ticker := time.NewTicker(rediscoveryPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-nudges:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(apiServiceSettleDelay):
+			}
+		}
+		c.rediscover(ctx)
+	}
+}
+
+// watchAPIServices calls the given nudge whenever an APIService is added or deleted
+// or changes its availability, after the initial listing.
+func (c *Controller) watchAPIServices(ctx context.Context, nudge func()) error {
+	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(c.dynamicClient, 0)
+	informer := informerFactory.ForResource(apiServiceGVR).Informer()
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				nudge()
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			oldU, isU1 := old.(*unstructured.Unstructured)
+			newU, isU2 := new.(*unstructured.Unstructured)
+			if isU1 && isU2 && apiServiceAvailable(oldU) == apiServiceAvailable(newU) {
+				return
+			}
+			nudge()
+		},
+		DeleteFunc: func(obj interface{}) {
+			nudge()
+		},
+	})
+	if err != nil {
+		return err
+	}
+	informerFactory.Start(ctx.Done())
+	return nil
+}
+
+// apiServiceAvailable returns the status of the Available condition of the given APIService.
+func apiServiceAvailable(apiService *unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(apiService.Object, "status", "conditions")
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if ok && conditionMap["type"] == "Available" {
+			status, _ := conditionMap["status"].(string)
+			return status
+		}
+	}
+	return ""
+}
+
+// rediscover does one round of API discovery and brings the set of informers in line with it.
+func (c *Controller) rediscover(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	crdDefined, ok := c.crdDefinedResources()
+	if !ok {
+		logger.V(2).Info("CRDs are not known yet, skipping this round of discovery")
+		return
+	}
+	apiResourceLists, _, err := doDiscovery(logger, c.kubernetesClient)
+	failedGroups := sets.New[string]()
+	if err != nil {
+		var failed *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &failed) {
+			logger.Error(err, "Failed to repeat API discovery")
+			return
+		}
+		for gv := range failed.Groups {
+			failedGroups.Insert(gv.Group)
+		}
+	}
+	watched := sets.New[schema.GroupVersionResource]()
+	_ = c.informers.Iterator(func(gvr schema.GroupVersionResource, _ cache.SharedIndexInformer) error {
+		watched.Insert(gvr)
+		return nil
+	})
+	toStartList, toStopList := c.diffDiscovery(apiResourceLists, failedGroups, watched, crdDefined)
+	if len(toStartList) > 0 {
+		c.startInformersForNewAPIResources(ctx, toStartList)
+	}
+	for _, gvr := range toStopList {
+		logger.Info("API is no longer served, ensuring the informer's absence.", "gvr", gvr)
+		c.stopInformer(ctx, gvr)
+	}
+	if len(toStopList) > 0 {
+		// take the objects of the APIs that are gone out of the resolutions
+		if err := c.migrateResolutions(ctx, sets.New(toStopList...), ""); err != nil {
+			logger.Error(err, "Failed to remove the objects of APIs that are no longer served from the resolutions")
+		}
+	}
+}
+
+// crdDefinedResources returns the resources that are defined by CRDs,
+// and whether they could be determined.
+func (c *Controller) crdDefinedResources() (sets.Set[schema.GroupResource], bool) {
+	crdLister, ok := c.listers.Get(apiextensionsv1.SchemeGroupVersion.WithResource("customresourcedefinitions"))
+	if !ok {
+		return nil, false
+	}
+	crds, err := crdLister.List(labels.Everything())
+	if err != nil {
+		return nil, false
+	}
+	ans := sets.New[schema.GroupResource]()
+	for _, crd := range crds {
+		crdM, ok := crd.(metav1.Object)
+		if !ok {
+			continue
+		}
+		// the name of a CRD is <plural>.<group>
+		plural, group, _ := strings.Cut(crdM.GetName(), ".")
+		ans.Insert(schema.GroupResource{Group: group, Resource: plural})
+	}
+	return ans, true
+}
+
+// diffDiscovery compares the results of an API discovery with the set of GVRs being watched.
+// It returns the resources that should start being watched and the GVRs that should stop being watched.
+// Nothing is stopped in a group whose discovery failed. Nothing is started or stopped for a
+// resource defined by a CRD, because handleCRD takes care of those (and discovery may lag
+// behind a change in the preferred version).
+func (c *Controller) diffDiscovery(apiResourceLists []*metav1.APIResourceList, failedGroups sets.Set[string],
+	watched sets.Set[schema.GroupVersionResource], crdDefined sets.Set[schema.GroupResource]) ([]APIResource, []schema.GroupVersionResource) {
+	toStartList := []APIResource{}
+	discovered := sets.New[schema.GroupVersionResource]()
+	for _, list := range apiResourceLists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			c.logger.Error(err, "Failed to parse a GroupVersion", "groupVersion", list.GroupVersion)
+			continue
+		}
+		for _, resource := range list.APIResources {
+			apiResource := APIResource{groupVersion: gv, resource: resource}
+			if !c.includedToWatch(apiResource) || !verbsSupportInformers(resource.Verbs) {
+				continue
+			}
+			gvr := gv.WithResource(resource.Name)
+			discovered.Insert(gvr)
+			if !watched.Has(gvr) && !crdDefined.Has(gvr.GroupResource()) {
+				toStartList = append(toStartList, apiResource)
+			}
+		}
+	}
+	toStopList := []schema.GroupVersionResource{}
+	for _, gvr := range watched.Difference(discovered).UnsortedList() {
+		if failedGroups.Has(gvr.Group) || crdDefined.Has(gvr.GroupResource()) {
+			continue
+		}
+		toStopList = append(toStopList, gvr)
+	}
+	return toStartList, toStopList
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2/ktesting"
+
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+func TestDiffDiscovery(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	ctlr := &Controller{logger: logger, resourceFilter: util.DefaultResourceFilter()}
+	informable := []string{"get", "list", "watch"}
+	apiResourceLists := []*metav1.APIResourceList{
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{
+			{Name: "deployments", Verbs: informable},
+			{Name: "deployments/scale", Verbs: []string{"get", "update"}},
+		}},
+		{GroupVersion: "metrics.example.com/v1beta1", APIResources: []metav1.APIResource{
+			{Name: "podmetrics", Verbs: informable},
+		}},
+		{GroupVersion: "crd.example.com/v1", APIResources: []metav1.APIResource{
+			{Name: "things", Verbs: informable},
+		}},
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "events", Verbs: informable},
+		}},
+	}
+	watched := sets.New(
+		schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		schema.GroupVersionResource{Group: "gone.example.com", Version: "v1", Resource: "widgets"},
+		schema.GroupVersionResource{Group: "failed.example.com", Version: "v1", Resource: "gadgets"},
+		schema.GroupVersionResource{Group: "crd.example.com", Version: "v1alpha1", Resource: "things"},
+	)
+	crdDefined := sets.New(schema.GroupResource{Group: "crd.example.com", Resource: "things"})
+	toStartList, toStopList := ctlr.diffDiscovery(apiResourceLists, sets.New("failed.example.com"), watched, crdDefined)
+	if len(toStartList) != 1 || toStartList[0].groupVersion.Group != "metrics.example.com" || toStartList[0].resource.Name != "podmetrics" {
+		t.Errorf("expected to start only podmetrics, got %v", toStartList)
+	}
+	expectedToStop := schema.GroupVersionResource{Group: "gone.example.com", Version: "v1", Resource: "widgets"}
+	if len(toStopList) != 1 || toStopList[0] != expectedToStop {
+		t.Errorf("expected to stop only %v, got %v", expectedToStop, toStopList)
+	}
+}
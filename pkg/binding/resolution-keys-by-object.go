@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// resolutionKeysByObject indexes the keys of bindingpolicy resolutions by the
+// objects in them.
+// A resolution's lock may be held while calling into this index, never the reverse.
+type resolutionKeysByObject struct {
+	sync.RWMutex
+	keys map[util.ObjectIdentifier]sets.Set[string]
+}
+
+func newResolutionKeysByObject() *resolutionKeysByObject {
+	return &resolutionKeysByObject{keys: map[util.ObjectIdentifier]sets.Set[string]{}}
+}
+
+// add records that the resolution associated with the given key holds the given object.
+func (index *resolutionKeysByObject) add(objIdentifier util.ObjectIdentifier, bindingPolicyKey string) {
+	index.Lock()
+	defer index.Unlock()
+	keys := index.keys[objIdentifier]
+	if keys == nil {
+		keys = sets.New[string]()
+		index.keys[objIdentifier] = keys
+	}
+	keys.Insert(bindingPolicyKey)
+}
+
+// remove records that the resolution associated with the given key does not hold the given object.
+func (index *resolutionKeysByObject) remove(objIdentifier util.ObjectIdentifier, bindingPolicyKey string) {
+	index.Lock()
+	defer index.Unlock()
+	keys := index.keys[objIdentifier]
+	keys.Delete(bindingPolicyKey)
+	if keys.Len() == 0 {
+		delete(index.keys, objIdentifier)
+	}
+}
+
+// sharedWith returns, for each other resolution that holds some object of the given one,
+// the objects that both hold, indexed by the other's key.
+// The given key is the one associated with the given resolution.
+func (index *resolutionKeysByObject) sharedWith(bindingPolicyKey string,
+	bindingPolicyResolution *bindingPolicyResolution) map[string]sets.Set[util.ObjectIdentifier] {
+	bindingPolicyResolution.RLock()
+	defer bindingPolicyResolution.RUnlock()
+	index.RLock()
+	defer index.RUnlock()
+
+	shared := map[string]sets.Set[util.ObjectIdentifier]{}
+	for objIdentifier := range bindingPolicyResolution.objectIdentifierToData {
+		for otherKey := range index.keys[objIdentifier] {
+			if otherKey == bindingPolicyKey {
+				continue
+			}
+			if shared[otherKey] == nil {
+				shared[otherKey] = sets.New[util.ObjectIdentifier]()
+			}
+			shared[otherKey].Insert(objIdentifier)
+		}
+	}
+	return shared
+}
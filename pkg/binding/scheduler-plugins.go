@@ -0,0 +1,245 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// Names of the built-in scheduler plugins
+const (
+	LabelMatchPluginName    = "LabelMatch"
+	AvailabilityPluginName  = "Availability"
+	CapacityPluginName      = "Capacity"
+	SpreadByLabelPluginName = "SpreadByLabel"
+	MaxClustersPluginName   = "MaxClusters"
+)
+
+func init() {
+	for name, factory := range map[string]SchedulerPluginFactory{
+		AvailabilityPluginName:  newAvailabilityPlugin,
+		CapacityPluginName:      newCapacityPlugin,
+		SpreadByLabelPluginName: newSpreadByLabelPlugin,
+		MaxClustersPluginName:   newMaxClustersPlugin,
+	} {
+		if err := RegisterSchedulerPlugin(name, factory); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// labelMatchPlugin passes the clusters whose labels match any of the BindingPolicy's `clusterSelectors`.
+// It is always the first filter, so it is not in the registry.
+type labelMatchPlugin struct{}
+
+var _ FilterPlugin = labelMatchPlugin{}
+
+func (labelMatchPlugin) Name() string { return LabelMatchPluginName }
+
+func (labelMatchPlugin) Filter(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, cluster *clusterv1.ManagedCluster) (bool, string) {
+	for _, ls := range bindingPolicy.Spec.ClusterSelectors {
+		sel, err := metav1.LabelSelectorAsSelector(&ls)
+		if err != nil {
+			continue // reported by validateClusterSelectors
+		}
+		if sel.Matches(labels.Set(cluster.Labels)) {
+			return true, ""
+		}
+	}
+	return false, "labels do not match any clusterSelector"
+}
+
+// validateClusterSelectors returns an error if any of the `clusterSelectors` is not valid.
+func validateClusterSelectors(bindingPolicy *v1alpha1.BindingPolicy) error {
+	for idx, ls := range bindingPolicy.Spec.ClusterSelectors {
+		if _, err := metav1.LabelSelectorAsSelector(&ls); err != nil {
+			return fmt.Errorf("spec.clusterSelectors[%d]: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// availabilityPlugin passes the clusters whose ManagedClusterConditionAvailable condition is True.
+type availabilityPlugin struct{}
+
+var _ FilterPlugin = availabilityPlugin{}
+
+func newAvailabilityPlugin(args map[string]string) (SchedulerPlugin, error) {
+	if err := checkPluginArgs(args); err != nil {
+		return nil, err
+	}
+	return availabilityPlugin{}, nil
+}
+
+func (availabilityPlugin) Name() string { return AvailabilityPluginName }
+
+func (availabilityPlugin) Filter(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, cluster *clusterv1.ManagedCluster) (bool, string) {
+	if meta.IsStatusConditionTrue(cluster.Status.Conditions, clusterv1.ManagedClusterConditionAvailable) {
+		return true, ""
+	}
+	return false, "cluster is not available"
+}
+
+// capacityPlugin considers the allocatable quantity of one resource.
+type capacityPlugin struct {
+	resource clusterv1.ResourceName
+	min      *resource.Quantity
+}
+
+var _ FilterPlugin = &capacityPlugin{}
+var _ ScorePlugin = &capacityPlugin{}
+
+func newCapacityPlugin(args map[string]string) (SchedulerPlugin, error) {
+	if err := checkPluginArgs(args, "resource", "min"); err != nil {
+		return nil, err
+	}
+	plugin := &capacityPlugin{resource: clusterv1.ResourceCPU}
+	if name, have := args["resource"]; have {
+		plugin.resource = clusterv1.ResourceName(name)
+	}
+	if minStr, have := args["min"]; have {
+		min, err := resource.ParseQuantity(minStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min %q: %w", minStr, err)
+		}
+		plugin.min = &min
+	}
+	return plugin, nil
+}
+
+func (plugin *capacityPlugin) Name() string { return CapacityPluginName }
+
+func (plugin *capacityPlugin) Filter(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, cluster *clusterv1.ManagedCluster) (bool, string) {
+	if plugin.min == nil {
+		return true, ""
+	}
+	allocatable := cluster.Status.Allocatable[plugin.resource]
+	if allocatable.Cmp(*plugin.min) < 0 {
+		return false, fmt.Sprintf("allocatable %s is %s, less than %s", plugin.resource, allocatable.String(), plugin.min.String())
+	}
+	return true, ""
+}
+
+// Score gives MaxClusterScore to the clusters with the most allocatable quantity
+// and proportionally less to the others.
+func (plugin *capacityPlugin) Score(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, cluster *clusterv1.ManagedCluster, clusters []*clusterv1.ManagedCluster) int64 {
+	var most float64
+	for _, other := range clusters {
+		allocatable := other.Status.Allocatable[plugin.resource]
+		if value := allocatable.AsApproximateFloat64(); value > most {
+			most = value
+		}
+	}
+	if most <= 0 {
+		return 0
+	}
+	allocatable := cluster.Status.Allocatable[plugin.resource]
+	return int64(allocatable.AsApproximateFloat64() / most * float64(MaxClusterScore))
+}
+
+// spreadByLabelPlugin reorders the ranked clusters to alternate among the values of a cluster label.
+// Among the clusters with a given value, the ranking order is preserved.
+// The values take turns in the order of their first appearance in the ranking.
+// Clusters lacking the label are treated as having the empty value.
+type spreadByLabelPlugin struct {
+	labelKey string
+}
+
+var _ SelectPlugin = spreadByLabelPlugin{}
+
+func newSpreadByLabelPlugin(args map[string]string) (SchedulerPlugin, error) {
+	if err := checkPluginArgs(args, "labelKey"); err != nil {
+		return nil, err
+	}
+	labelKey := args["labelKey"]
+	if errs := validation.IsQualifiedName(labelKey); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid labelKey %q: %v", labelKey, errs)
+	}
+	return spreadByLabelPlugin{labelKey: labelKey}, nil
+}
+
+func (spreadByLabelPlugin) Name() string { return SpreadByLabelPluginName }
+
+func (plugin spreadByLabelPlugin) Select(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, ranked []*clusterv1.ManagedCluster) []*clusterv1.ManagedCluster {
+	var values []string
+	groups := map[string][]*clusterv1.ManagedCluster{}
+	for _, cluster := range ranked {
+		value := cluster.Labels[plugin.labelKey]
+		if _, have := groups[value]; !have {
+			values = append(values, value)
+		}
+		groups[value] = append(groups[value], cluster)
+	}
+	spread := make([]*clusterv1.ManagedCluster, 0, len(ranked))
+	for round := 0; len(spread) < len(ranked); round++ {
+		for _, value := range values {
+			if group := groups[value]; round < len(group) {
+				spread = append(spread, group[round])
+			}
+		}
+	}
+	return spread
+}
+
+// maxClustersPlugin keeps only the first `count` clusters.
+type maxClustersPlugin struct {
+	count int
+}
+
+var _ SelectPlugin = maxClustersPlugin{}
+
+func newMaxClustersPlugin(args map[string]string) (SchedulerPlugin, error) {
+	if err := checkPluginArgs(args, "count"); err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(args["count"])
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("count must be a positive integer, not %q", args["count"])
+	}
+	return maxClustersPlugin{count: count}, nil
+}
+
+func (maxClustersPlugin) Name() string { return MaxClustersPluginName }
+
+func (plugin maxClustersPlugin) Select(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, ranked []*clusterv1.ManagedCluster) []*clusterv1.ManagedCluster {
+	if len(ranked) > plugin.count {
+		return ranked[:plugin.count]
+	}
+	return ranked
+}
+
+// checkPluginArgs returns an error if the given args include any name that is not allowed.
+func checkPluginArgs(args map[string]string, allowed ...string) error {
+	for name := range args {
+		if !SliceContains(allowed, name) {
+			return fmt.Errorf("unknown argument %q", name)
+		}
+	}
+	return nil
+}
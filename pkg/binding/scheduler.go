@@ -0,0 +1,237 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// MaxClusterScore is the highest score that a ScorePlugin may give to a cluster.
+const MaxClusterScore int64 = 100
+
+// SchedulerPlugin is implemented by every scheduling plugin.
+// A plugin also implements at least one of FilterPlugin, ScorePlugin and SelectPlugin,
+// and takes part in the corresponding phases of scheduling.
+// A plugin is created for one scheduling of one BindingPolicy,
+// so it may keep state across phases.
+type SchedulerPlugin interface {
+	Name() string
+}
+
+// FilterPlugin is a SchedulerPlugin that takes part in the Filter phase.
+type FilterPlugin interface {
+	SchedulerPlugin
+
+	// Filter returns whether the given cluster may be a destination of the given BindingPolicy.
+	// When the answer is false, the returned string says why.
+	Filter(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, cluster *clusterv1.ManagedCluster) (bool, string)
+}
+
+// ScorePlugin is a SchedulerPlugin that takes part in the Score phase.
+type ScorePlugin interface {
+	SchedulerPlugin
+
+	// Score returns the desirability of the given cluster as a destination of the given
+	// BindingPolicy, in the range [0, MaxClusterScore].
+	// The given clusters are the ones that passed the Filter phase.
+	Score(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, cluster *clusterv1.ManagedCluster, clusters []*clusterv1.ManagedCluster) int64
+}
+
+// SelectPlugin is a SchedulerPlugin that takes part in the Select phase.
+type SelectPlugin interface {
+	SchedulerPlugin
+
+	// Select is given the candidate clusters, most desirable first, and returns the
+	// clusters that remain candidates, most desirable first.
+	// The given slice may be mutated.
+	Select(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, ranked []*clusterv1.ManagedCluster) []*clusterv1.ManagedCluster
+}
+
+// SchedulerPluginFactory makes a plugin from the arguments given in a BindingPolicy.
+// An error is returned if the arguments are not valid.
+type SchedulerPluginFactory func(args map[string]string) (SchedulerPlugin, error)
+
+var (
+	schedulerPluginRegistryLock sync.RWMutex
+	schedulerPluginRegistry     = map[string]SchedulerPluginFactory{}
+)
+
+// RegisterSchedulerPlugin makes a plugin available, under the given name, for use in
+// the `scheduling` of BindingPolicy objects.
+// An error is returned if the name is already registered.
+func RegisterSchedulerPlugin(name string, factory SchedulerPluginFactory) error {
+	schedulerPluginRegistryLock.Lock()
+	defer schedulerPluginRegistryLock.Unlock()
+	if _, have := schedulerPluginRegistry[name]; have {
+		return fmt.Errorf("scheduler plugin %q is already registered", name)
+	}
+	schedulerPluginRegistry[name] = factory
+	return nil
+}
+
+func getSchedulerPluginFactory(name string) (SchedulerPluginFactory, bool) {
+	schedulerPluginRegistryLock.RLock()
+	defer schedulerPluginRegistryLock.RUnlock()
+	factory, have := schedulerPluginRegistry[name]
+	return factory, have
+}
+
+// Scheduler chooses the destinations of a BindingPolicy.
+type Scheduler interface {
+	// Schedule returns the names of the chosen destinations among the given clusters,
+	// and a description of each way in which the `spreadConstraints` are not met.
+	// An error is returned if the `scheduling` or `spreadConstraints` of the
+	// BindingPolicy are not valid.
+	// Neither the BindingPolicy nor the clusters are mutated.
+	Schedule(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, clusters []*clusterv1.ManagedCluster) (sets.Set[string], []string, error)
+}
+
+// NewScheduler returns a Scheduler that uses the registered plugins.
+func NewScheduler() Scheduler {
+	return scheduler{}
+}
+
+type scheduler struct{}
+
+type weightedScorePlugin struct {
+	ScorePlugin
+	weight int64
+}
+
+func (scheduler) Schedule(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, clusters []*clusterv1.ManagedCluster) (sets.Set[string], []string, error) {
+	logger := klog.FromContext(ctx)
+	filters, scorers, selectors, err := pluginsForBindingPolicy(bindingPolicy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Filter phase
+	candidates := make([]*clusterv1.ManagedCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		passed := true
+		for _, filter := range filters {
+			if ok, reason := filter.Filter(ctx, bindingPolicy, cluster); !ok {
+				logger.V(5).Info("Cluster filtered out", "bindingPolicy", bindingPolicy.Name, "cluster", cluster.Name, "plugin", filter.Name(), "reason", reason)
+				passed = false
+				break
+			}
+		}
+		if passed {
+			candidates = append(candidates, cluster)
+		}
+	}
+
+	// Score phase
+	scores := make(map[string]int64, len(candidates))
+	for _, cluster := range candidates {
+		var total int64
+		for _, scorer := range scorers {
+			score := scorer.Score(ctx, bindingPolicy, cluster, candidates)
+			if score < 0 {
+				score = 0
+			} else if score > MaxClusterScore {
+				score = MaxClusterScore
+			}
+			total += scorer.weight * score
+		}
+		scores[cluster.Name] = total
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		scoreI, scoreJ := scores[candidates[i].Name], scores[candidates[j].Name]
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	// Spread constraints
+	candidates, unmet := applySpreadConstraints(candidates, bindingPolicy.Spec.SpreadConstraints)
+	if len(unmet) > 0 {
+		logger.V(4).Info("Spread constraints not met", "bindingPolicy", bindingPolicy.Name, "unmet", unmet)
+	}
+
+	// Select phase
+	for _, selector := range selectors {
+		candidates = selector.Select(ctx, bindingPolicy, candidates)
+	}
+
+	destinations := sets.New[string]()
+	for _, cluster := range candidates {
+		destinations.Insert(cluster.Name)
+	}
+	return destinations, unmet, nil
+}
+
+// pluginsForBindingPolicy instantiates the plugins for scheduling the given BindingPolicy.
+// The label match implied by the `clusterSelectors` is always the first filter.
+// For a BindingPolicy that wants singleton reported state, at most one cluster is selected.
+func pluginsForBindingPolicy(bindingPolicy *v1alpha1.BindingPolicy) ([]FilterPlugin, []weightedScorePlugin, []SelectPlugin, error) {
+	if err := validateClusterSelectors(bindingPolicy); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := validateSpreadConstraints(bindingPolicy); err != nil {
+		return nil, nil, nil, err
+	}
+	filters := []FilterPlugin{labelMatchPlugin{}}
+	scorers := []weightedScorePlugin{}
+	selectors := []SelectPlugin{}
+	if bindingPolicy.Spec.Scheduling != nil {
+		for idx, pluginSpec := range bindingPolicy.Spec.Scheduling.Plugins {
+			factory, have := getSchedulerPluginFactory(pluginSpec.Name)
+			if !have {
+				return nil, nil, nil, fmt.Errorf("spec.scheduling.plugins[%d]: unknown plugin %q", idx, pluginSpec.Name)
+			}
+			plugin, err := factory(pluginSpec.Args)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("spec.scheduling.plugins[%d] (%s): %w", idx, pluginSpec.Name, err)
+			}
+			if pluginSpec.Weight < 0 {
+				return nil, nil, nil, fmt.Errorf("spec.scheduling.plugins[%d] (%s): weight must not be negative", idx, pluginSpec.Name)
+			}
+			weight := int64(pluginSpec.Weight)
+			if weight == 0 {
+				weight = 1
+			}
+			if filter, is := plugin.(FilterPlugin); is {
+				filters = append(filters, filter)
+			}
+			if scorer, is := plugin.(ScorePlugin); is {
+				scorers = append(scorers, weightedScorePlugin{scorer, weight})
+			}
+			if selector, is := plugin.(SelectPlugin); is {
+				selectors = append(selectors, selector)
+			}
+		}
+	}
+	if bindingPolicy.Spec.WantSingletonReportedState {
+		// if the bindingpolicy requires a singleton status, then we should only
+		// have one destination
+		// TODO: this should be replaced once we have proper enforcement or error reporting for this
+		selectors = append(selectors, maxClustersPlugin{count: 1})
+	}
+	return filters, scorers, selectors, nil
+}
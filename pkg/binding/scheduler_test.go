@@ -0,0 +1,186 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func testCluster(name, region, cpu string, available bool) *clusterv1.ManagedCluster {
+	status := metav1.ConditionFalse
+	if available {
+		status = metav1.ConditionTrue
+	}
+	return &clusterv1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"env": "prod", "region": region}},
+		Status: clusterv1.ManagedClusterStatus{
+			Conditions:  []metav1.Condition{{Type: clusterv1.ManagedClusterConditionAvailable, Status: status}},
+			Allocatable: clusterv1.ResourceList{clusterv1.ResourceCPU: resource.MustParse(cpu)},
+		},
+	}
+}
+
+// TestSchedule tests the Scheduler with the built-in plugins
+func TestSchedule(t *testing.T) {
+	clusters := []*clusterv1.ManagedCluster{
+		testCluster("c1", "east", "4", true),
+		testCluster("c2", "east", "16", true),
+		testCluster("c3", "west", "8", true),
+		testCluster("c4", "west", "32", false),
+		testCluster("c5", "north", "2", true),
+	}
+	prod := []metav1.LabelSelector{{MatchLabels: map[string]string{"env": "prod"}}}
+	testCases := []struct {
+		name        string
+		spec        v1alpha1.BindingPolicySpec
+		expected    sets.Set[string]
+		expectsErr  bool
+		expectUnmet int
+	}{
+		{
+			name:     "label match only",
+			spec:     v1alpha1.BindingPolicySpec{ClusterSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"region": "west"}}}},
+			expected: sets.New("c3", "c4"),
+		},
+		{
+			name:     "no cluster selectors",
+			spec:     v1alpha1.BindingPolicySpec{},
+			expected: sets.New[string](),
+		},
+		{
+			name:     "singleton picks first by name",
+			spec:     v1alpha1.BindingPolicySpec{ClusterSelectors: prod, WantSingletonReportedState: true},
+			expected: sets.New("c1"),
+		},
+		{
+			name: "availability and capacity",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod, Scheduling: &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{
+				{Name: AvailabilityPluginName},
+				{Name: CapacityPluginName, Args: map[string]string{"min": "4"}},
+			}}},
+			expected: sets.New("c1", "c2", "c3"),
+		},
+		{
+			name: "most capacity",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod, Scheduling: &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{
+				{Name: CapacityPluginName},
+				{Name: MaxClustersPluginName, Args: map[string]string{"count": "2"}},
+			}}},
+			expected: sets.New("c4", "c2"),
+		},
+		{
+			name: "spread by region",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod, Scheduling: &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{
+				{Name: AvailabilityPluginName},
+				{Name: CapacityPluginName},
+				{Name: SpreadByLabelPluginName, Args: map[string]string{"labelKey": "region"}},
+				{Name: MaxClustersPluginName, Args: map[string]string{"count": "3"}},
+			}}},
+			expected: sets.New("c2", "c3", "c5"),
+		},
+		{
+			name: "one per region",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod,
+				Scheduling:        &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{{Name: CapacityPluginName}}},
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{TopologyKey: "region", PerGroupCount: 1}}},
+			expected: sets.New("c2", "c4", "c5"),
+		},
+		{
+			name: "two per region",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod,
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{TopologyKey: "region", PerGroupCount: 2}}},
+			expected:    sets.New("c1", "c2", "c3", "c4", "c5"),
+			expectUnmet: 1,
+		},
+		{
+			name: "two per region with skew limit",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod,
+				Scheduling:        &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{{Name: AvailabilityPluginName}}},
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{TopologyKey: "region", PerGroupCount: 2, MaxSkew: 1}}},
+			expected:    sets.New("c1", "c2", "c3", "c5"),
+			expectUnmet: 2,
+		},
+		{
+			name: "skew limit without count",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod,
+				Scheduling: &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{
+					{Name: AvailabilityPluginName},
+					{Name: CapacityPluginName},
+				}},
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{TopologyKey: "region", MaxSkew: 1}}},
+			expected: sets.New("c1", "c2", "c3", "c5"),
+		},
+		{
+			name: "missing topology label",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod,
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{TopologyKey: "site", PerGroupCount: 1}}},
+			expected:    sets.New[string](),
+			expectUnmet: 1,
+		},
+		{
+			name: "invalid topology key",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod,
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{TopologyKey: "not a key"}}},
+			expectsErr: true,
+		},
+		{
+			name: "unknown plugin",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod, Scheduling: &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{
+				{Name: "NoSuchPlugin"},
+			}}},
+			expectsErr: true,
+		},
+		{
+			name: "invalid argument",
+			spec: v1alpha1.BindingPolicySpec{ClusterSelectors: prod, Scheduling: &v1alpha1.SchedulingSpec{Plugins: []v1alpha1.SchedulingPlugin{
+				{Name: MaxClustersPluginName, Args: map[string]string{"count": "zero"}},
+			}}},
+			expectsErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp"}, Spec: tc.spec}
+			actual, unmet, err := NewScheduler().Schedule(context.Background(), bindingPolicy, clusters)
+			if tc.expectsErr {
+				if err == nil {
+					t.Errorf("expected an error, got destinations %v", sets.List(actual))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !actual.Equal(tc.expected) {
+				t.Errorf("expected: %v, got: %v", sets.List(tc.expected), sets.List(actual))
+			}
+			if len(unmet) != tc.expectUnmet {
+				t.Errorf("expected %d unmet constraints, got: %v", tc.expectUnmet, unmet)
+			}
+		})
+	}
+}
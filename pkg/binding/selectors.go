@@ -18,6 +18,7 @@ package binding
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -25,6 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/klog/v2"
 
 	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
@@ -34,6 +38,11 @@ import (
 // when an object is updated, we iterate over all bindingpolicies and update
 // resolutions that are affected by the update. Every changed resolution leads
 // to queueing its relevant binding for syncing.
+// The object is tested only against the clauses that the bindingPolicyIndex
+// finds for it, except for bindingpolicies that are not yet indexed in their
+// current generation.
+// An object that is not matched but is a dependency of a matched object, for a
+// bindingpolicy that wants dependencies, is put in that bindingpolicy's resolution too.
 func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.ObjectIdentifier) error {
 	bindingPolicies, err := c.listBindingPolicies()
 	if err != nil {
@@ -42,6 +51,12 @@ func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.O
 
 	logger := klog.FromContext(ctx)
 
+	if _, watched := c.listers.Get(objIdentifier.GVR()); !watched {
+		// e.g., a version of a CRD that is no longer the preferred one, see handleCRD
+		logger.V(3).Info("Removing object of unwatched resource from resolutions", "object", objIdentifier, "numPolicies", len(bindingPolicies))
+		return c.removeObjectFromBindingPolicies(ctx, objIdentifier, bindingPolicies)
+	}
+
 	obj, err := c.getObjectFromIdentifier(objIdentifier)
 	if errors.IsNotFound(err) {
 		logger.V(3).Info("Removing non-existent object from resolutions", "object", objIdentifier, "numPolicies", len(bindingPolicies))
@@ -50,6 +65,19 @@ func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.O
 	} else if err != nil {
 		return fmt.Errorf("failed to get runtime.Object from object identifier (%v): %w", objIdentifier, err)
 	}
+	candidates := c.bindingPolicyIndex.candidateClauses(objIdentifier.GVK.Group, objIdentifier.Resource, objIdentifier.ObjectName.Namespace)
+
+	// The informer holds only the object's metadata; fetch the rest if a test or the dependencies need it.
+	if c.needObjectContent(bindingPolicies, objIdentifier, candidates) {
+		fullObj, err := c.fullObjectGetter.Get(ctx, objIdentifier, obj.(metav1.Object).GetResourceVersion())
+		if errors.IsNotFound(err) {
+			logger.V(3).Info("Removing non-existent object from resolutions", "object", objIdentifier, "numPolicies", len(bindingPolicies))
+			return c.removeObjectFromBindingPolicies(ctx, objIdentifier, bindingPolicies)
+		} else if err != nil {
+			return fmt.Errorf("failed to fetch object (identifier: %v): %w", objIdentifier, err)
+		}
+		obj = fullObj
+	}
 
 	objMR := obj.(mrObject)
 	objBeingDeleted := isBeingDeleted(obj)
@@ -65,7 +93,26 @@ func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.O
 			continue // resolution does not exist, skip
 		}
 
-		matchedAny, createOnly, matchedStatusCollectorsSet := c.testObject(ctx, objIdentifier, objMR.GetLabels(), bindingPolicy.Spec.Downsync)
+		var result objectTestResult
+		if !c.bindingPolicyIndex.isCurrent(bindingPolicy) {
+			result = c.testObject(ctx, bindingPolicy, objIdentifier, objMR, nil)
+		} else if clauses, isCandidate := candidates[bindingPolicy.GetName()]; isCandidate {
+			result = c.testObject(ctx, bindingPolicy, objIdentifier, objMR, clauses)
+		}
+		isDependency := !result.matched && c.dependencyTracker.isDependency(bindingPolicy.GetName(), objIdentifier)
+		if isDependency && !result.excluded {
+			// the exclusions apply to dependencies too, and have not been tested when no clause matches
+			result.excluded, result.exclusionErrors = c.testExclusions(ctx, bindingPolicy, &objectUnderTest{identifier: objIdentifier, obj: objMR})
+		}
+		matchedAny := result.matched
+		c.noteExclusionErrors(bindingPolicy.GetName(), objIdentifier, result.exclusionErrors)
+		c.updateDependencies(ctx, bindingPolicy.GetName(), objIdentifier, obj, matchedAny && result.wantDependencies && !objBeingDeleted)
+		if isDependency && !result.excluded {
+			if err := c.ensureDependencyInResolution(ctx, bindingPolicy, objIdentifier, objMR); err != nil {
+				return err
+			}
+			continue
+		}
 		if !matchedAny {
 			// if previously selected, remove
 			if resolutionUpdated := c.bindingPolicyResolver.RemoveObjectIdentifier(bindingPolicy.GetName(),
@@ -86,7 +133,7 @@ func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.O
 
 		// obj is selected by bindingpolicy, update the bindingpolicy resolver
 		resolutionUpdated, err := c.bindingPolicyResolver.EnsureObjectData(bindingPolicy.GetName(),
-			objIdentifier, string(objMR.GetUID()), objMR.GetResourceVersion(), createOnly, matchedStatusCollectorsSet)
+			objIdentifier, string(objMR.GetUID()), objMR.GetResourceVersion(), result.createOnly, result.orphan, result.statusCollectors)
 		if err != nil {
 			if errorIsBindingPolicyResolutionNotFound(err) {
 				// this case can occur if a bindingpolicy resolution was deleted AFTER
@@ -117,22 +164,23 @@ func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.O
 
 		}
 
-		// make sure object has singleton status if needed
-		if !objBeingDeleted && c.bindingPolicyResolver.ResolutionRequiresSingletonReportedState(bindingPolicy.GetName()) {
-			if err := c.handleSingletonLabel(ctx, obj, objGVR, util.BindingPolicyLabelSingletonStatusValueSet); err != nil {
-				return fmt.Errorf("failed to add singleton label to object: %w", err)
-			}
-
+		// the object needs singleton status if a singleton bindingpolicy selects it
+		if c.bindingPolicyResolver.ResolutionRequiresSingletonReportedState(bindingPolicy.GetName()) {
 			isSelectedBySingletonBinding = true
 		}
 	}
 
-	// if the binding-policies matching cycles end and the object is not selected by a singleton binding,
-	// we need to update the singleton label value for the object.
+	// Once the binding-policies matching cycles end, the singleton label value of the object
+	// is updated by the one replica that maintains it, which also tests the object against
+	// the singleton bindingpolicies that other replicas resolve.
 	// NOTE that this takes care of the case where the object was previously selected by a singleton binding
 	// and is no longer selected by any binding.
-	if !objBeingDeleted && !isSelectedBySingletonBinding {
-		if err := c.handleSingletonLabel(ctx, obj, objGVR, util.BindingPolicyLabelSingletonStatusValueUnset); err != nil {
+	if !objBeingDeleted && c.ownsSingletonLabel(objIdentifier) {
+		labelValue := util.BindingPolicyLabelSingletonStatusValueUnset
+		if isSelectedBySingletonBinding || c.selectedBySingletonBindingPolicyOfOthers(ctx, bindingPolicies, objIdentifier, objMR) {
+			labelValue = util.BindingPolicyLabelSingletonStatusValueSet
+		}
+		if err := c.handleSingletonLabel(ctx, objMR, objGVR, labelValue); err != nil {
 			return fmt.Errorf("failed to update singleton label for object: %w", err)
 		}
 	}
@@ -142,21 +190,12 @@ func (c *Controller) updateResolutions(ctx context.Context, objIdentifier util.O
 
 // handleSingletonLabel adds the singleton label to the object in the cluster,
 // or matches the label value to the expectedLabelValue if needed.
+// The label is patched, conditioned on the object's resourceVersion.
 //
 // The method parameter `obj` is not mutated by this function.
-func (c *Controller) handleSingletonLabel(ctx context.Context, obj runtime.Object, objGVR schema.GroupVersionResource,
+func (c *Controller) handleSingletonLabel(ctx context.Context, obj mrObject, objGVR schema.GroupVersionResource,
 	expectedLabelValue string) error {
-	unstructuredObj, ok := obj.(*unstructured.Unstructured)
-	if !ok {
-		return fmt.Errorf("failed to convert runtime.Object to unstructured.Unstructured")
-	}
-
-	labels := unstructuredObj.GetLabels() // gets a copy of the labels
-	if labels == nil {
-		labels = make(map[string]string)
-	}
-
-	val, found := labels[util.BindingPolicyLabelSingletonStatusKey]
+	val, found := obj.GetLabels()[util.BindingPolicyLabelSingletonStatusKey]
 
 	if !found && expectedLabelValue == util.BindingPolicyLabelSingletonStatusValueUnset {
 		return nil
@@ -164,25 +203,25 @@ func (c *Controller) handleSingletonLabel(ctx context.Context, obj runtime.Objec
 	if found && val == expectedLabelValue {
 		return nil
 	}
-	labels[util.BindingPolicyLabelSingletonStatusKey] = expectedLabelValue
-
-	unstructuredObj = unstructuredObj.DeepCopy() // avoid mutating the original object
-	unstructuredObj.SetLabels(labels)
 
-	if unstructuredObj.GetNamespace() == metav1.NamespaceNone {
-		_, err := c.dynamicClient.Resource(objGVR).Update(ctx, unstructuredObj, metav1.UpdateOptions{})
-		if errors.IsNotFound(err) {
-			return nil // object was deleted after getting into this function. This is not an error.
-		}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels":          map[string]string{util.BindingPolicyLabelSingletonStatusKey: expectedLabelValue},
+			"resourceVersion": obj.GetResourceVersion(),
+		},
+	})
+	if err != nil {
 		return err
 	}
 
-	_, err := c.dynamicClient.Resource(objGVR).Namespace(unstructuredObj.GetNamespace()).Update(ctx, unstructuredObj,
-		metav1.UpdateOptions{})
+	var client dynamic.ResourceInterface = c.dynamicClient.Resource(objGVR)
+	if obj.GetNamespace() != metav1.NamespaceNone {
+		client = c.dynamicClient.Resource(objGVR).Namespace(obj.GetNamespace())
+	}
+	_, err = client.Patch(ctx, obj.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
 	if errors.IsNotFound(err) {
 		return nil // object was deleted after getting into this function. This is not an error.
 	}
-
 	return err
 }
 
@@ -190,6 +229,8 @@ func (c *Controller) removeObjectFromBindingPolicies(ctx context.Context, objIde
 	bindingPolicies []*v1alpha1.BindingPolicy) error {
 	logger := klog.FromContext(ctx)
 	for _, bindingPolicy := range bindingPolicies {
+		c.noteExclusionErrors(bindingPolicy.GetName(), objIdentifier, nil)
+		c.updateDependencies(ctx, bindingPolicy.GetName(), objIdentifier, nil, false)
 		if resolutionUpdated := c.bindingPolicyResolver.RemoveObjectIdentifier(bindingPolicy.GetName(),
 			objIdentifier); resolutionUpdated {
 			// enqueue binding to be synced since object was removed from its bindingpolicy's resolution
@@ -202,3 +243,52 @@ func (c *Controller) removeObjectFromBindingPolicies(ctx context.Context, objIde
 
 	return nil
 }
+
+// updateDependencies records the dependencies of the given object for the given
+// bindingpolicy and enqueues the objects whose status as a dependency has changed.
+// The dependencies are found only if `want` is true, otherwise there are none.
+func (c *Controller) updateDependencies(ctx context.Context, bindingPolicyName string, objIdentifier util.ObjectIdentifier,
+	obj runtime.Object, want bool) {
+	dependencies := sets.New[util.ObjectIdentifier]()
+	if want {
+		if unstructuredObj, ok := obj.(*unstructured.Unstructured); ok {
+			dependencies = c.dependenciesOf(objIdentifier, unstructuredObj)
+		}
+	}
+	changed := c.dependencyTracker.setDependencies(bindingPolicyName, objIdentifier, dependencies)
+	if changed.Len() == 0 {
+		return
+	}
+	klog.FromContext(ctx).V(4).Info("Enqueuing objects whose status as dependency changed", "bindingPolicy", bindingPolicyName,
+		"dependent", objIdentifier, "changed", changed)
+	for dependency := range changed {
+		c.enqueueObjectIdentifier(dependency)
+	}
+}
+
+// ensureDependencyInResolution puts the given object, which is a dependency of
+// an object selected by the given bindingpolicy, in that bindingpolicy's resolution.
+// The dependency gets the bindingpolicy's deletion policy.
+func (c *Controller) ensureDependencyInResolution(ctx context.Context, bindingPolicy *v1alpha1.BindingPolicy, objIdentifier util.ObjectIdentifier,
+	objMR mrObject) error {
+	logger := klog.FromContext(ctx)
+	bindingPolicyName := bindingPolicy.GetName()
+	orphan := bindingPolicy.Spec.DeletionPolicy == v1alpha1.DeletionPolicyOrphan
+	resolutionUpdated, err := c.bindingPolicyResolver.EnsureObjectData(bindingPolicyName, objIdentifier,
+		string(objMR.GetUID()), objMR.GetResourceVersion(), false, orphan, sets.New[string]())
+	if err != nil {
+		if errorIsBindingPolicyResolutionNotFound(err) {
+			logger.V(4).Info("skipped EnsureObjectData for dependency because bindingpolicy was deleted",
+				"objectIdentifier", objIdentifier, "bindingpolicy", bindingPolicyName)
+			return nil
+		}
+		return fmt.Errorf("failed to update resolution for bindingpolicy %s for dependency (identifier: %v): %w",
+			bindingPolicyName, objIdentifier, err)
+	}
+	if resolutionUpdated {
+		logger.V(4).Info("Enqueued Binding for syncing due to a noting of a dependency in its resolution",
+			"binding", bindingPolicyName, "objectIdentifier", objIdentifier)
+		c.enqueueBinding(bindingPolicyName)
+	}
+	return nil
+}
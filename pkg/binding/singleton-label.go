@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// singletonPoliciesOfOthers remembers, for each bindingpolicy that wants singleton
+// reported state and is resolved by another replica, the generation last seen.
+// The replica that maintains the singleton label of an object tests the object against
+// these bindingpolicies too, so it revisits the workload objects when one of them changes.
+type singletonPoliciesOfOthers struct {
+	sync.Mutex
+	generations map[string]int64
+}
+
+func newSingletonPoliciesOfOthers() *singletonPoliciesOfOthers {
+	return &singletonPoliciesOfOthers{generations: map[string]int64{}}
+}
+
+// note records the given bindingpolicy, which another replica resolves, under the given name;
+// nil means that no other replica resolves a bindingpolicy of that name.
+// Returns whether the singleton labels of the workload objects may need to change as a result.
+func (sp *singletonPoliciesOfOthers) note(bindingPolicyName string, bindingPolicy *v1alpha1.BindingPolicy) bool {
+	sp.Lock()
+	defer sp.Unlock()
+	oldGeneration, had := sp.generations[bindingPolicyName]
+	if bindingPolicy == nil || !bindingPolicy.Spec.WantSingletonReportedState {
+		delete(sp.generations, bindingPolicyName)
+		return had
+	}
+	sp.generations[bindingPolicyName] = bindingPolicy.Generation
+	return !had || oldGeneration != bindingPolicy.Generation
+}
+
+// ownsSingletonLabel tells whether this replica maintains the singleton label of the identified object.
+// That is the replica that maintains the object's singleton status, whichever replicas
+// resolve the bindingpolicies that select the object.
+func (c *Controller) ownsSingletonLabel(objIdentifier util.ObjectIdentifier) bool {
+	return c.sharder.Owns(util.SingletonStatusKey(objIdentifier))
+}
+
+// noteBindingPolicyOfOthers keeps track of the given bindingpolicy, which another replica
+// resolves, or of its absence (nil), and requeues the workload objects if that may change
+// their singleton labels.
+func (c *Controller) noteBindingPolicyOfOthers(ctx context.Context, bindingPolicyName string, bindingPolicy *v1alpha1.BindingPolicy) error {
+	if !c.singletonPoliciesOfOthers.note(bindingPolicyName, bindingPolicy) {
+		return nil
+	}
+	if bindingPolicy == nil || !bindingPolicy.Spec.WantSingletonReportedState {
+		c.objectCELPrograms.forget(bindingPolicyName)
+	}
+	return c.requeueWorkloadObjects(ctx, bindingPolicyName)
+}
+
+// selectedBySingletonBindingPolicyOfOthers tells whether the given object is selected by a
+// bindingpolicy that wants singleton reported state and is resolved by another replica.
+// The given object includes its content if some of those bindingpolicies need it,
+// as needObjectContent sees to for the replica that maintains the object's singleton label.
+func (c *Controller) selectedBySingletonBindingPolicyOfOthers(ctx context.Context, bindingPolicies []*v1alpha1.BindingPolicy,
+	objIdentifier util.ObjectIdentifier, obj mrObject) bool {
+	for _, bindingPolicy := range bindingPolicies {
+		if !bindingPolicy.Spec.WantSingletonReportedState || c.sharder.Owns(bindingPolicy.Name) {
+			continue
+		}
+		if c.testObject(ctx, bindingPolicy, objIdentifier, obj, nil).matched {
+			return true
+		}
+	}
+	return false
+}
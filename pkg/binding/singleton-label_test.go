@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	controllisters "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// keySharder is a sharded replica that owns the given keys.
+type keySharder sets.Set[string]
+
+func (ks keySharder) Owns(key string) bool { return sets.Set[string](ks).Has(key) }
+
+func (keySharder) AddOwnershipChangeHandler(handler func()) func() { return func() {} }
+
+// TestSingletonLabelAcrossReplicas tests that, of two replicas, only the one that maintains
+// an object's singleton label writes it, testing the object in full against a singleton
+// BindingPolicy with a CEL expression that the other replica resolves.
+func TestSingletonLabelAcrossReplicas(t *testing.T) {
+	ctx := context.Background()
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	fullObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"namespace": "app", "name": "cm", "resourceVersion": "1"},
+		"data":       map[string]interface{}{"enabled": "true"},
+	}}
+	objIdentifier := util.IdentifierForObject(fullObj, "configmaps")
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "ConfigMapList"}, fullObj)
+
+	// the informers hold only the metadata
+	objIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	setInformerObject := func(labels map[string]string, resourceVersion string) {
+		partial := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "cm", Labels: labels, ResourceVersion: resourceVersion}}
+		if err := objIndexer.Update(partial); err != nil {
+			t.Fatalf("failed to update indexer: %v", err)
+		}
+	}
+	setInformerObject(nil, "1")
+	listers := util.NewConcurrentMap[schema.GroupVersionResource, cache.GenericLister]()
+	listers.Set(gvr, cache.NewGenericLister(objIndexer, gvr.GroupResource()))
+
+	celExpression := v1alpha1.Expression("obj.data.enabled == 'true'")
+	bindingPolicy := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp", Generation: 1}, Spec: v1alpha1.BindingPolicySpec{
+		Downsync: []v1alpha1.DownsyncPolicyClause{
+			{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"configmaps"}, ObjectCELExpression: &celExpression}},
+		},
+		WantSingletonReportedState: true,
+	}}
+	policyIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := policyIndexer.Add(bindingPolicy); err != nil {
+		t.Fatalf("failed to add BindingPolicy: %v", err)
+	}
+
+	newReplica := func(ownedKeys ...string) *Controller {
+		ocp, err := newObjectCELPrograms()
+		if err != nil {
+			t.Fatalf("failed to make objectCELPrograms: %v", err)
+		}
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		t.Cleanup(queue.ShutDown)
+		return &Controller{logger: klog.Background(), sharder: keySharder(sets.New(ownedKeys...)),
+			dynamicClient: dynamicClient, fullObjectGetter: util.NewFullObjectGetter(dynamicClient, util.DefaultFullObjectCacheSize),
+			listers: listers, bindingPolicyLister: controllisters.NewBindingPolicyLister(policyIndexer),
+			bindingPolicyResolver: NewBindingPolicyResolver(), bindingPolicyIndex: newBindingPolicyIndex(),
+			objectCELPrograms: ocp, exclusionErrors: newExclusionErrorTracker(), dependencyTracker: newDependencyTracker(),
+			singletonPoliciesOfOthers: newSingletonPoliciesOfOthers(), workqueue: queue}
+	}
+	policyOwner := newReplica(bindingPolicy.Name)
+	policyOwner.bindingPolicyIndex.noteBindingPolicy(bindingPolicy)
+	policyOwner.bindingPolicyResolver.NoteBindingPolicy(bindingPolicy)
+	labelOwner := newReplica(util.SingletonStatusKey(objIdentifier))
+	if !labelOwner.singletonPoliciesOfOthers.note(bindingPolicy.Name, bindingPolicy) {
+		t.Error("expected a new singleton BindingPolicy of another replica to call for revisiting the objects")
+	}
+
+	numPatches := func() int {
+		count := 0
+		for _, action := range dynamicClient.Actions() {
+			if action.GetVerb() == "patch" {
+				count++
+			}
+		}
+		return count
+	}
+	labelValue := func() string {
+		obj, err := dynamicClient.Resource(gvr).Namespace("app").Get(ctx, "cm", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get object: %v", err)
+		}
+		return obj.GetLabels()[util.BindingPolicyLabelSingletonStatusKey]
+	}
+
+	if err := policyOwner.updateResolutions(ctx, objIdentifier); err != nil {
+		t.Fatalf("policy owner failed to update resolutions: %v", err)
+	}
+	if objIdentifiers, _ := policyOwner.bindingPolicyResolver.GetObjectIdentifiers(bindingPolicy.Name); !objIdentifiers.Has(objIdentifier) {
+		t.Error("expected the policy owner to put the object in the resolution")
+	}
+	if numPatches() != 0 {
+		t.Error("expected the policy owner to leave the singleton label alone")
+	}
+	if err := labelOwner.updateResolutions(ctx, objIdentifier); err != nil {
+		t.Fatalf("label owner failed to update resolutions: %v", err)
+	}
+	if value := labelValue(); value != util.BindingPolicyLabelSingletonStatusValueSet {
+		t.Errorf("expected the label owner to set the singleton label, got %q", value)
+	}
+
+	// the label change comes back through the informers of both replicas
+	setInformerObject(map[string]string{util.BindingPolicyLabelSingletonStatusKey: util.BindingPolicyLabelSingletonStatusValueSet}, "2")
+	for _, replica := range []*Controller{policyOwner, labelOwner} {
+		if err := replica.updateResolutions(ctx, objIdentifier); err != nil {
+			t.Fatalf("failed to update resolutions: %v", err)
+		}
+	}
+	if count := numPatches(); count != 1 || labelValue() != util.BindingPolicyLabelSingletonStatusValueSet {
+		t.Errorf("expected the singleton label to stay set after one patch, got %q after %d patches", labelValue(), count)
+	}
+
+	// once the BindingPolicy is gone, the label owner clears the label
+	if err := policyIndexer.Delete(bindingPolicy); err != nil {
+		t.Fatalf("failed to delete BindingPolicy: %v", err)
+	}
+	if !labelOwner.singletonPoliciesOfOthers.note(bindingPolicy.Name, nil) {
+		t.Error("expected a deleted singleton BindingPolicy of another replica to call for revisiting the objects")
+	}
+	if err := labelOwner.updateResolutions(ctx, objIdentifier); err != nil {
+		t.Fatalf("label owner failed to update resolutions: %v", err)
+	}
+	if value := labelValue(); value != util.BindingPolicyLabelSingletonStatusValueUnset {
+		t.Errorf("expected the label owner to clear the singleton label, got %q", value)
+	}
+}
+
+func TestSingletonPoliciesOfOthers(t *testing.T) {
+	sp := newSingletonPoliciesOfOthers()
+	singleton := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp", Generation: 1},
+		Spec: v1alpha1.BindingPolicySpec{WantSingletonReportedState: true}}
+	plain := &v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp", Generation: 3}}
+	steps := []struct {
+		name          string
+		bindingPolicy *v1alpha1.BindingPolicy
+		generation    int64
+		expected      bool
+	}{
+		{name: "not singleton", bindingPolicy: plain, expected: false},
+		{name: "new singleton", bindingPolicy: singleton, generation: 1, expected: true},
+		{name: "same generation", bindingPolicy: singleton, generation: 1, expected: false},
+		{name: "new generation", bindingPolicy: singleton, generation: 2, expected: true},
+		{name: "no longer singleton", bindingPolicy: plain, expected: true},
+		{name: "singleton again", bindingPolicy: singleton, generation: 2, expected: true},
+		{name: "gone", bindingPolicy: nil, expected: true},
+		{name: "still gone", bindingPolicy: nil, expected: false},
+	}
+	for _, step := range steps {
+		bindingPolicy := step.bindingPolicy
+		if bindingPolicy == singleton {
+			bindingPolicy = singleton.DeepCopy()
+			bindingPolicy.Generation = step.generation
+		}
+		if actual := sp.note("bp", bindingPolicy); actual != step.expected {
+			t.Errorf("%s: expected %v, got %v", step.name, step.expected, actual)
+		}
+	}
+}
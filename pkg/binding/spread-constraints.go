@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// validateSpreadConstraints returns an error if any of the `spreadConstraints` is not valid.
+func validateSpreadConstraints(bindingPolicy *v1alpha1.BindingPolicy) error {
+	for idx, constraint := range bindingPolicy.Spec.SpreadConstraints {
+		if errs := validation.IsQualifiedName(constraint.TopologyKey); len(errs) > 0 {
+			return fmt.Errorf("spec.spreadConstraints[%d]: invalid topologyKey %q: %v", idx, constraint.TopologyKey, errs)
+		}
+		if constraint.PerGroupCount < 0 {
+			return fmt.Errorf("spec.spreadConstraints[%d]: perGroupCount must not be negative", idx)
+		}
+		if constraint.MaxSkew < 0 {
+			return fmt.Errorf("spec.spreadConstraints[%d]: maxSkew must not be negative", idx)
+		}
+	}
+	return nil
+}
+
+// applySpreadConstraints narrows the given clusters, most desirable first, to meet the given
+// constraints as far as possible. Returns the remaining clusters, most desirable first,
+// and a description of each way in which the constraints are not met.
+func applySpreadConstraints(ranked []*clusterv1.ManagedCluster, constraints []v1alpha1.SpreadConstraint) ([]*clusterv1.ManagedCluster, []string) {
+	var unmet []string
+	for idx, constraint := range constraints {
+		var problems []string
+		ranked, problems = applySpreadConstraint(ranked, constraint)
+		for _, problem := range problems {
+			unmet = append(unmet, fmt.Sprintf("spreadConstraints[%d]: %s", idx, problem))
+		}
+	}
+	return ranked, unmet
+}
+
+func applySpreadConstraint(ranked []*clusterv1.ManagedCluster, constraint v1alpha1.SpreadConstraint) ([]*clusterv1.ManagedCluster, []string) {
+	key := constraint.TopologyKey
+	perGroupCount := int(constraint.PerGroupCount)
+	maxSkew := int(constraint.MaxSkew)
+
+	// values are in the order of their first appearance in the ranking
+	var values []string
+	sizes := map[string]int{}
+	for _, cluster := range ranked {
+		value, have := cluster.Labels[key]
+		if !have {
+			continue
+		}
+		if _, seen := sizes[value]; !seen {
+			values = append(values, value)
+		}
+		sizes[value]++
+	}
+
+	var unmet []string
+	if len(values) == 0 {
+		if perGroupCount > 0 {
+			unmet = append(unmet, fmt.Sprintf("no candidate cluster has the label %q", key))
+		}
+		return []*clusterv1.ManagedCluster{}, unmet
+	}
+
+	// quotas holds the number of destinations to take from each group
+	quotas := make(map[string]int, len(values))
+	for _, value := range values {
+		quota := sizes[value]
+		if perGroupCount > 0 {
+			if quota < perGroupCount {
+				unmet = append(unmet, fmt.Sprintf("group %s=%s has %d candidate clusters, fewer than perGroupCount %d", key, value, quota, perGroupCount))
+			} else {
+				quota = perGroupCount
+			}
+		}
+		quotas[value] = quota
+	}
+	if maxSkew > 0 {
+		least := quotas[values[0]]
+		for _, quota := range quotas {
+			if quota < least {
+				least = quota
+			}
+		}
+		for value, quota := range quotas {
+			if quota > least+maxSkew {
+				quotas[value] = least + maxSkew
+			}
+		}
+	}
+
+	narrowed := make([]*clusterv1.ManagedCluster, 0, len(ranked))
+	for _, cluster := range ranked {
+		value, have := cluster.Labels[key]
+		if !have || quotas[value] == 0 {
+			continue
+		}
+		quotas[value]--
+		narrowed = append(narrowed, cluster)
+	}
+	return narrowed, unmet
+}
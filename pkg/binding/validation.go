@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"path"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// BindingPolicyValidator checks BindingPolicy objects for the mistakes
+// that can be found without looking at any other object.
+type BindingPolicyValidator struct {
+	celPrograms *objectCELPrograms
+}
+
+// NewBindingPolicyValidator returns a BindingPolicyValidator.
+func NewBindingPolicyValidator() (*BindingPolicyValidator, error) {
+	celPrograms, err := newObjectCELPrograms()
+	if err != nil {
+		return nil, err
+	}
+	return &BindingPolicyValidator{celPrograms: celPrograms}, nil
+}
+
+// Validate returns descriptions of the mistakes in the given BindingPolicy, if any.
+// These are: malformed label selectors and name patterns, `objectCELExpression`s that
+// do not compile, `"*"` listed with other names, tests that match every object,
+// and invalid scheduling and spreading configuration.
+// `*bindingPolicy` is immutable.
+func (v *BindingPolicyValidator) Validate(bindingPolicy *v1alpha1.BindingPolicy) []string {
+	var errs []string
+	if _, _, _, err := pluginsForBindingPolicy(bindingPolicy); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for idx := range bindingPolicy.Spec.Downsync {
+		errs = append(errs, v.validateDownsyncObjectTest(&bindingPolicy.Spec.Downsync[idx].DownsyncObjectTest,
+			fmt.Sprintf("spec.downsync[%d]", idx))...)
+	}
+	for idx := range bindingPolicy.Spec.DownsyncExclusions {
+		errs = append(errs, v.validateDownsyncObjectTest(&bindingPolicy.Spec.DownsyncExclusions[idx],
+			fmt.Sprintf("spec.downsyncExclusions[%d]", idx))...)
+	}
+	return errs
+}
+
+// validateDownsyncObjectTest returns descriptions of the mistakes in the given
+// test, which is found at the given path in its BindingPolicy.
+func (v *BindingPolicyValidator) validateDownsyncObjectTest(test *v1alpha1.DownsyncObjectTest, testPath string) []string {
+	var errs []string
+	for _, names := range []struct {
+		field string
+		list  []string
+	}{{"resources", test.Resources}, {"namespaces", test.Namespaces}, {"objectNames", test.ObjectNames}} {
+		if len(names.list) > 1 && SliceContains(names.list, "*") {
+			errs = append(errs, fmt.Sprintf("%s.%s: \"*\" must be the only entry when present", testPath, names.field))
+		}
+	}
+	for idx, pattern := range test.ObjectNames {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Sprintf("%s.objectNames[%d]: invalid pattern %q: %v", testPath, idx, pattern, err))
+		}
+	}
+	for _, selectors := range []struct {
+		field string
+		list  []metav1.LabelSelector
+	}{{"namespaceSelectors", test.NamespaceSelectors}, {"objectSelectors", test.ObjectSelectors}} {
+		for idx := range selectors.list {
+			if _, err := metav1.LabelSelectorAsSelector(&selectors.list[idx]); err != nil {
+				errs = append(errs, fmt.Sprintf("%s.%s[%d]: %v", testPath, selectors.field, idx, err))
+			}
+		}
+	}
+	if expression := test.ObjectCELExpression; expression != nil && len(*expression) > 0 {
+		if _, err := v.celPrograms.compile(*expression); err != nil {
+			errs = append(errs, fmt.Sprintf("%s.objectCELExpression: %v", testPath, err))
+		}
+	}
+	if testMatchesEverything(test) {
+		errs = append(errs, fmt.Sprintf("%s: at least one criterion must discriminate among objects", testPath))
+	}
+	return errs
+}
+
+// testMatchesEverything tells whether every criterion of the given test matches every object.
+func testMatchesEverything(test *v1alpha1.DownsyncObjectTest) bool {
+	allNames := func(names []string) bool { return len(names) == 0 || SliceContains(names, "*") }
+	allLabels := func(selectors []metav1.LabelSelector) bool {
+		return len(selectors) == 0 || ALabelSelectorIsEmpty(selectors...)
+	}
+	return test.APIGroup == nil && allNames(test.Resources) && allNames(test.Namespaces) &&
+		allLabels(test.NamespaceSelectors) && allLabels(test.ObjectSelectors) && allNames(test.ObjectNames) &&
+		(test.ObjectCELExpression == nil || len(*test.ObjectCELExpression) == 0)
+}
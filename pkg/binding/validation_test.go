@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestBindingPolicyValidator(t *testing.T) {
+	validator, err := NewBindingPolicyValidator()
+	if err != nil {
+		t.Fatalf("failed to create validator: %v", err)
+	}
+	apps := "apps"
+	goodCEL, badCEL := v1alpha1.Expression(`obj.metadata.name == "x"`), v1alpha1.Expression(`obj.metadata.name ==`)
+	badSelector := metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "a", Operator: "Near"}}}
+	testCases := []struct {
+		name     string
+		spec     v1alpha1.BindingPolicySpec
+		expected []string // substrings of the expected errors, in order
+	}{
+		{name: "good", spec: v1alpha1.BindingPolicySpec{
+			ClusterSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"env": "prod"}}},
+			Downsync: []v1alpha1.DownsyncPolicyClause{
+				{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{APIGroup: &apps, Resources: []string{"*"}}},
+				{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{ObjectNames: []string{"*-local"}, ObjectCELExpression: &goodCEL}},
+			},
+			DownsyncExclusions: []v1alpha1.DownsyncObjectTest{{Namespaces: []string{"kube-system"}}},
+		}},
+		{name: "bad cluster selector", spec: v1alpha1.BindingPolicySpec{
+			ClusterSelectors: []metav1.LabelSelector{badSelector},
+		}, expected: []string{"spec.clusterSelectors[0]"}},
+		{name: "wildcard mixed with names", spec: v1alpha1.BindingPolicySpec{
+			Downsync: []v1alpha1.DownsyncPolicyClause{
+				{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{APIGroup: &apps, Resources: []string{"*", "deployments"}, Namespaces: []string{"ns1", "*"}}},
+			},
+		}, expected: []string{"spec.downsync[0].resources", "spec.downsync[0].namespaces"}},
+		{name: "bad selectors, pattern and CEL", spec: v1alpha1.BindingPolicySpec{
+			DownsyncExclusions: []v1alpha1.DownsyncObjectTest{{
+				APIGroup:            &apps,
+				NamespaceSelectors:  []metav1.LabelSelector{badSelector},
+				ObjectSelectors:     []metav1.LabelSelector{{}, badSelector},
+				ObjectNames:         []string{"[x"},
+				ObjectCELExpression: &badCEL,
+			}},
+		}, expected: []string{"spec.downsyncExclusions[0].objectNames[0]", "spec.downsyncExclusions[0].namespaceSelectors[0]",
+			"spec.downsyncExclusions[0].objectSelectors[1]", "spec.downsyncExclusions[0].objectCELExpression"}},
+		{name: "all wildcards", spec: v1alpha1.BindingPolicySpec{
+			Downsync: []v1alpha1.DownsyncPolicyClause{
+				{DownsyncObjectTest: v1alpha1.DownsyncObjectTest{Resources: []string{"*"}, ObjectSelectors: []metav1.LabelSelector{{}}}},
+			},
+		}, expected: []string{"spec.downsync[0]: at least one criterion"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := validator.Validate(&v1alpha1.BindingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "bp1"}, Spec: testCase.spec})
+			if len(actual) != len(testCase.expected) {
+				t.Fatalf("expected %d errors, got %d: %v", len(testCase.expected), len(actual), actual)
+			}
+			for idx, expected := range testCase.expected {
+				if !strings.Contains(actual[idx], expected) {
+					t.Errorf("expected error %d to mention %q, got %q", idx, expected, actual[idx])
+				}
+			}
+		})
+	}
+}
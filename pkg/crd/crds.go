@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/kubestellar/kubestellar/pkg/abstract"
 	"github.com/kubestellar/kubestellar/pkg/util"
 )
 
@@ -60,7 +61,11 @@ const (
 	FieldManager = "kubestellar"
 )
 
-func ApplyCRDs(ctx context.Context, controllerName string, clientset kubernetes.Interface, clientsetExt apiextensionsclientset.Interface, logger logr.Logger) error {
+// ApplyCRDs creates or updates the KubeStellar CRDs and waits for them to be accepted.
+// The CRDs have several versions, of which v1alpha1 is the storage version.
+// `conversion` says how the apiserver reaches the conversion webhook; when it is nil,
+// only the storage version is served (see applyVersionStrategy).
+func ApplyCRDs(ctx context.Context, controllerName string, clientset kubernetes.Interface, clientsetExt apiextensionsclientset.Interface, conversion *apiextensionsv1.WebhookClientConfig, logger logr.Logger) error {
 	ctxLimited, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 
@@ -79,6 +84,7 @@ func ApplyCRDs(ctx context.Context, controllerName string, clientset kubernetes.
 		if err != nil {
 			return fmt.Errorf("unable to convert from Unstructured to CRD, name=%s: %w", crdU.GetName(), err)
 		}
+		applyVersionStrategy(desiredCRD, nil, conversion)
 		crdIfc := clientsetExt.ApiextensionsV1().CustomResourceDefinitions()
 		_, err = crdIfc.Create(ctx, desiredCRD, metav1.CreateOptions{FieldManager: controllerName})
 		if err == nil {
@@ -90,6 +96,7 @@ func ApplyCRDs(ctx context.Context, controllerName string, clientset kubernetes.
 			if err != nil {
 				return fmt.Errorf("failed to fetch an existing CRD, name=%s: %w", desiredCRD.Name, err)
 			}
+			applyVersionStrategy(desiredCRD, existingCRD, conversion)
 			if apiequality.Semantic.DeepEqual(existingCRD.Spec, desiredCRD.Spec) {
 				logger.Info("Existing CRD is acceptable, name=%s", desiredCRD.Name)
 				continue
@@ -111,6 +118,50 @@ func ApplyCRDs(ctx context.Context, controllerName string, clientset kubernetes.
 	return nil
 }
 
+// applyVersionStrategy adjusts the versions and the conversion of the given desired CRD,
+// taking the given existing CRD (if not nil) into account.
+// Without a conversion webhook the versions cannot be converted among themselves,
+// so only the storage version is served.
+// With a conversion webhook, every version is served, and the apiserver is told
+// to reach the webhook to convert among them.
+// In both cases, a version in the existing CRD's `status.storedVersions` is kept
+// (served but not stored) even if the desired CRD drops it, so that the existing
+// objects stored in that version keep working.
+func applyVersionStrategy(desired, existing *apiextensionsv1.CustomResourceDefinition, conversion *apiextensionsv1.WebhookClientConfig) {
+	if conversion == nil {
+		var storageVersions []apiextensionsv1.CustomResourceDefinitionVersion
+		for _, version := range desired.Spec.Versions {
+			if version.Storage {
+				storageVersions = append(storageVersions, version)
+			}
+		}
+		desired.Spec.Versions = storageVersions
+		desired.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter}
+	} else {
+		desired.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ClientConfig:             conversion.DeepCopy(),
+				ConversionReviewVersions: []string{"v1"},
+			},
+		}
+	}
+	if existing == nil {
+		return
+	}
+	desiredVersions := abstract.SliceMapToK8sSet(desired.Spec.Versions,
+		func(version apiextensionsv1.CustomResourceDefinitionVersion) string { return version.Name })
+	storedVersions := sets.New(existing.Status.StoredVersions...)
+	for _, version := range existing.Spec.Versions {
+		if desiredVersions.Has(version.Name) || !storedVersions.Has(version.Name) {
+			continue
+		}
+		version.Served = true
+		version.Storage = false
+		desired.Spec.Versions = append(desired.Spec.Versions, version)
+	}
+}
+
 // Convert GroupVersionKind to GroupVersionResource
 func groupVersionKindToResource(clientset kubernetes.Interface, gvk schema.GroupVersionKind, logger logr.Logger) (*schema.GroupVersionResource, error) {
 	gv := gvk.GroupVersion().String()
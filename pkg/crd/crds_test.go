@@ -18,6 +18,7 @@ package crd
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -55,18 +56,6 @@ func TestBindingPolicyAdditionalValidations(t *testing.T) {
 		t.Fatal("Failed to convert CRD to apiextensions.CustomResourceDefinition")
 	}
 
-	// We assume there is only one version in the CRD
-	ver := bpCRD.Spec.Versions[0]
-	crdSchema := ver.Schema
-	if crdSchema == nil {
-		crdSchema = bpCRD.Spec.Validation
-	}
-
-	schemaValidator, _, err := validation.NewSchemaValidator(crdSchema.OpenAPIV3Schema)
-	if err != nil {
-		t.Fatal("Failed to create a validator")
-	}
-
 	testCases := []struct {
 		name     string
 		manifest string
@@ -109,15 +98,28 @@ func TestBindingPolicyAdditionalValidations(t *testing.T) {
 		},
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			err := validateCustomResourceYaml(tc.manifest, schemaValidator)
-			if tc.wantErr != (err != nil) {
-				t.Errorf("wantErr: %v, error, %s", tc.wantErr, err)
-			} else if err != nil {
-				t.Logf("Got expected validation error, %s", err)
-			}
-		})
+	// The additional validations apply to every version in the CRD
+	for _, ver := range bpCRD.Spec.Versions {
+		crdSchema := ver.Schema
+		if crdSchema == nil {
+			crdSchema = bpCRD.Spec.Validation
+		}
+
+		schemaValidator, _, err := validation.NewSchemaValidator(crdSchema.OpenAPIV3Schema)
+		if err != nil {
+			t.Fatal("Failed to create a validator")
+		}
+
+		for _, tc := range testCases {
+			t.Run(ver.Name+"/"+tc.name, func(t *testing.T) {
+				err := validateCustomResourceYaml(tc.manifest, schemaValidator)
+				if tc.wantErr != (err != nil) {
+					t.Errorf("wantErr: %v, error, %s", tc.wantErr, err)
+				} else if err != nil {
+					t.Logf("Got expected validation error, %s", err)
+				}
+			})
+		}
 	}
 }
 
@@ -260,3 +262,51 @@ spec:
         app.kubernetes.io/part-of: wec-gpu
   wantSingletonReportedState: true
 `
+
+func TestApplyVersionStrategy(t *testing.T) {
+	newCRD := func(versions ...string) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		for idx, version := range versions {
+			crd.Spec.Versions = append(crd.Spec.Versions,
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: version, Served: true, Storage: idx == 0})
+		}
+		return crd
+	}
+	versionsOf := func(crd *apiextensionsv1.CustomResourceDefinition) []string {
+		var ans []string
+		for _, version := range crd.Spec.Versions {
+			ans = append(ans, fmt.Sprintf("%s/served=%v/storage=%v", version.Name, version.Served, version.Storage))
+		}
+		return ans
+	}
+	url := "https://controller-manager.wds1-system.svc:9443/convert"
+	conversion := &apiextensionsv1.WebhookClientConfig{URL: &url}
+
+	desired := newCRD("v1alpha1", "v1beta1")
+	applyVersionStrategy(desired, nil, nil)
+	if expected, actual := []string{"v1alpha1/served=true/storage=true"}, versionsOf(desired); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("without conversion webhook: expected versions %v, got %v", expected, actual)
+	}
+	if desired.Spec.Conversion.Strategy != apiextensionsv1.NoneConverter {
+		t.Errorf("without conversion webhook: expected strategy None, got %v", desired.Spec.Conversion.Strategy)
+	}
+
+	desired = newCRD("v1alpha1", "v1beta1")
+	applyVersionStrategy(desired, nil, conversion)
+	if expected, actual := []string{"v1alpha1/served=true/storage=true", "v1beta1/served=true/storage=false"}, versionsOf(desired); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("with conversion webhook: expected versions %v, got %v", expected, actual)
+	}
+	if desired.Spec.Conversion.Strategy != apiextensionsv1.WebhookConverter || *desired.Spec.Conversion.Webhook.ClientConfig.URL != url {
+		t.Errorf("with conversion webhook: expected strategy Webhook at %s, got %#v", url, desired.Spec.Conversion)
+	}
+
+	// A version that still has stored objects is kept, even when no longer desired.
+	existing := newCRD("v0", "v1alpha1")
+	existing.Spec.Versions = append(existing.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: "v0beta1", Served: true})
+	existing.Status.StoredVersions = []string{"v0", "v1alpha1"}
+	desired = newCRD("v1alpha1", "v1beta1")
+	applyVersionStrategy(desired, existing, nil)
+	if expected, actual := []string{"v1alpha1/served=true/storage=true", "v0/served=true/storage=false"}, versionsOf(desired); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("with stored versions: expected versions %v, got %v", expected, actual)
+	}
+}
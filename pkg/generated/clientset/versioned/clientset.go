@@ -27,17 +27,20 @@ import (
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
 
 	controlv1alpha1 "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/typed/control/v1alpha1"
+	controlv1beta1 "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/typed/control/v1beta1"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	ControlV1alpha1() controlv1alpha1.ControlV1alpha1Interface
+	ControlV1beta1() controlv1beta1.ControlV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	controlV1alpha1 *controlv1alpha1.ControlV1alpha1Client
+	controlV1beta1  *controlv1beta1.ControlV1beta1Client
 }
 
 // ControlV1alpha1 retrieves the ControlV1alpha1Client
@@ -45,6 +48,11 @@ func (c *Clientset) ControlV1alpha1() controlv1alpha1.ControlV1alpha1Interface {
 	return c.controlV1alpha1
 }
 
+// ControlV1beta1 retrieves the ControlV1beta1Client
+func (c *Clientset) ControlV1beta1() controlv1beta1.ControlV1beta1Interface {
+	return c.controlV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -93,6 +101,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.controlV1beta1, err = controlv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -115,6 +127,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.controlV1alpha1 = controlv1alpha1.New(c)
+	cs.controlV1beta1 = controlv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
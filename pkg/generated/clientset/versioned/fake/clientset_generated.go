@@ -28,6 +28,8 @@ import (
 	clientset "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned"
 	controlv1alpha1 "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/typed/control/v1alpha1"
 	fakecontrolv1alpha1 "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/typed/control/v1alpha1/fake"
+	controlv1beta1 "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/typed/control/v1beta1"
+	fakecontrolv1beta1 "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/typed/control/v1beta1/fake"
 )
 
 // NewSimpleClientset returns a clientset that will respond with the provided objects.
@@ -84,3 +86,8 @@ var (
 func (c *Clientset) ControlV1alpha1() controlv1alpha1.ControlV1alpha1Interface {
 	return &fakecontrolv1alpha1.FakeControlV1alpha1{Fake: &c.Fake}
 }
+
+// ControlV1beta1 retrieves the ControlV1beta1Client
+func (c *Clientset) ControlV1beta1() controlv1beta1.ControlV1beta1Interface {
+	return &fakecontrolv1beta1.FakeControlV1beta1{Fake: &c.Fake}
+}
@@ -26,6 +26,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	controlv1alpha1 "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	controlv1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
 )
 
 var scheme = runtime.NewScheme()
@@ -33,6 +34,7 @@ var codecs = serializer.NewCodecFactory(scheme)
 
 var localSchemeBuilder = runtime.SchemeBuilder{
 	controlv1alpha1.AddToScheme,
+	controlv1beta1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
@@ -26,6 +26,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	controlv1alpha1 "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	controlv1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
 )
 
 var Scheme = runtime.NewScheme()
@@ -33,6 +34,7 @@ var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	controlv1alpha1.AddToScheme,
+	controlv1beta1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
@@ -0,0 +1,128 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"net/http"
+
+	rest "k8s.io/client-go/rest"
+
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
+	"github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/scheme"
+)
+
+type ControlV1beta1Interface interface {
+	RESTClient() rest.Interface
+	BindingsGetter
+	BindingPoliciesGetter
+	CombinedStatusesGetter
+	CustomTransformsGetter
+	StatusCollectorsGetter
+}
+
+// ControlV1beta1Client is used to interact with features provided by the control.kubestellar.io group.
+type ControlV1beta1Client struct {
+	restClient rest.Interface
+}
+
+func (c *ControlV1beta1Client) Bindings() BindingInterface {
+	return newBindings(c)
+}
+
+func (c *ControlV1beta1Client) BindingPolicies() BindingPolicyInterface {
+	return newBindingPolicies(c)
+}
+
+func (c *ControlV1beta1Client) CombinedStatuses(namespace string) CombinedStatusInterface {
+	return newCombinedStatuses(c, namespace)
+}
+
+func (c *ControlV1beta1Client) CustomTransforms() CustomTransformInterface {
+	return newCustomTransforms(c)
+}
+
+func (c *ControlV1beta1Client) StatusCollectors() StatusCollectorInterface {
+	return newStatusCollectors(c)
+}
+
+// NewForConfig creates a new ControlV1beta1Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*ControlV1beta1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new ControlV1beta1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*ControlV1beta1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &ControlV1beta1Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new ControlV1beta1Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *ControlV1beta1Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new ControlV1beta1Client for the given RESTClient.
+func New(c rest.Interface) *ControlV1beta1Client {
+	return &ControlV1beta1Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1beta1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *ControlV1beta1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
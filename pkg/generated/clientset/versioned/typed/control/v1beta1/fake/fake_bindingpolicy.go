@@ -0,0 +1,133 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
+)
+
+// FakeBindingPolicies implements BindingPolicyInterface
+type FakeBindingPolicies struct {
+	Fake *FakeControlV1beta1
+}
+
+var bindingpoliciesResource = v1beta1.SchemeGroupVersion.WithResource("bindingpolicies")
+
+var bindingpoliciesKind = v1beta1.SchemeGroupVersion.WithKind("BindingPolicy")
+
+// Get takes name of the bindingPolicy, and returns the corresponding bindingPolicy object, and an error if there is any.
+func (c *FakeBindingPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.BindingPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(bindingpoliciesResource, name), &v1beta1.BindingPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BindingPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of BindingPolicies that match those selectors.
+func (c *FakeBindingPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.BindingPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(bindingpoliciesResource, bindingpoliciesKind, opts), &v1beta1.BindingPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.BindingPolicyList{ListMeta: obj.(*v1beta1.BindingPolicyList).ListMeta}
+	for _, item := range obj.(*v1beta1.BindingPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested bindingPolicies.
+func (c *FakeBindingPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(bindingpoliciesResource, opts))
+}
+
+// Create takes the representation of a bindingPolicy and creates it.  Returns the server's representation of the bindingPolicy, and an error, if there is any.
+func (c *FakeBindingPolicies) Create(ctx context.Context, bindingPolicy *v1beta1.BindingPolicy, opts v1.CreateOptions) (result *v1beta1.BindingPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(bindingpoliciesResource, bindingPolicy), &v1beta1.BindingPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BindingPolicy), err
+}
+
+// Update takes the representation of a bindingPolicy and updates it. Returns the server's representation of the bindingPolicy, and an error, if there is any.
+func (c *FakeBindingPolicies) Update(ctx context.Context, bindingPolicy *v1beta1.BindingPolicy, opts v1.UpdateOptions) (result *v1beta1.BindingPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(bindingpoliciesResource, bindingPolicy), &v1beta1.BindingPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BindingPolicy), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBindingPolicies) UpdateStatus(ctx context.Context, bindingPolicy *v1beta1.BindingPolicy, opts v1.UpdateOptions) (*v1beta1.BindingPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(bindingpoliciesResource, "status", bindingPolicy), &v1beta1.BindingPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BindingPolicy), err
+}
+
+// Delete takes name of the bindingPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeBindingPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(bindingpoliciesResource, name, opts), &v1beta1.BindingPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBindingPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(bindingpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.BindingPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched bindingPolicy.
+func (c *FakeBindingPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.BindingPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(bindingpoliciesResource, name, pt, data, subresources...), &v1beta1.BindingPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BindingPolicy), err
+}
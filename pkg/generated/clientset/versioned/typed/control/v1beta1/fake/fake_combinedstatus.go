@@ -0,0 +1,130 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
+)
+
+// FakeCombinedStatuses implements CombinedStatusInterface
+type FakeCombinedStatuses struct {
+	Fake *FakeControlV1beta1
+	ns   string
+}
+
+var combinedstatusesResource = v1beta1.SchemeGroupVersion.WithResource("combinedstatuses")
+
+var combinedstatusesKind = v1beta1.SchemeGroupVersion.WithKind("CombinedStatus")
+
+// Get takes name of the combinedStatus, and returns the corresponding combinedStatus object, and an error if there is any.
+func (c *FakeCombinedStatuses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.CombinedStatus, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(combinedstatusesResource, c.ns, name), &v1beta1.CombinedStatus{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CombinedStatus), err
+}
+
+// List takes label and field selectors, and returns the list of CombinedStatuses that match those selectors.
+func (c *FakeCombinedStatuses) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.CombinedStatusList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(combinedstatusesResource, combinedstatusesKind, c.ns, opts), &v1beta1.CombinedStatusList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.CombinedStatusList{ListMeta: obj.(*v1beta1.CombinedStatusList).ListMeta}
+	for _, item := range obj.(*v1beta1.CombinedStatusList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested combinedStatuses.
+func (c *FakeCombinedStatuses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(combinedstatusesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a combinedStatus and creates it.  Returns the server's representation of the combinedStatus, and an error, if there is any.
+func (c *FakeCombinedStatuses) Create(ctx context.Context, combinedStatus *v1beta1.CombinedStatus, opts v1.CreateOptions) (result *v1beta1.CombinedStatus, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(combinedstatusesResource, c.ns, combinedStatus), &v1beta1.CombinedStatus{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CombinedStatus), err
+}
+
+// Update takes the representation of a combinedStatus and updates it. Returns the server's representation of the combinedStatus, and an error, if there is any.
+func (c *FakeCombinedStatuses) Update(ctx context.Context, combinedStatus *v1beta1.CombinedStatus, opts v1.UpdateOptions) (result *v1beta1.CombinedStatus, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(combinedstatusesResource, c.ns, combinedStatus), &v1beta1.CombinedStatus{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CombinedStatus), err
+}
+
+// Delete takes name of the combinedStatus and deletes it. Returns an error if one occurs.
+func (c *FakeCombinedStatuses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(combinedstatusesResource, c.ns, name, opts), &v1beta1.CombinedStatus{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeCombinedStatuses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(combinedstatusesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.CombinedStatusList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched combinedStatus.
+func (c *FakeCombinedStatuses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.CombinedStatus, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(combinedstatusesResource, c.ns, name, pt, data, subresources...), &v1beta1.CombinedStatus{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CombinedStatus), err
+}
@@ -0,0 +1,133 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
+)
+
+// FakeStatusCollectors implements StatusCollectorInterface
+type FakeStatusCollectors struct {
+	Fake *FakeControlV1beta1
+}
+
+var statuscollectorsResource = v1beta1.SchemeGroupVersion.WithResource("statuscollectors")
+
+var statuscollectorsKind = v1beta1.SchemeGroupVersion.WithKind("StatusCollector")
+
+// Get takes name of the statusCollector, and returns the corresponding statusCollector object, and an error if there is any.
+func (c *FakeStatusCollectors) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.StatusCollector, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(statuscollectorsResource, name), &v1beta1.StatusCollector{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatusCollector), err
+}
+
+// List takes label and field selectors, and returns the list of StatusCollectors that match those selectors.
+func (c *FakeStatusCollectors) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.StatusCollectorList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(statuscollectorsResource, statuscollectorsKind, opts), &v1beta1.StatusCollectorList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.StatusCollectorList{ListMeta: obj.(*v1beta1.StatusCollectorList).ListMeta}
+	for _, item := range obj.(*v1beta1.StatusCollectorList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested statusCollectors.
+func (c *FakeStatusCollectors) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(statuscollectorsResource, opts))
+}
+
+// Create takes the representation of a statusCollector and creates it.  Returns the server's representation of the statusCollector, and an error, if there is any.
+func (c *FakeStatusCollectors) Create(ctx context.Context, statusCollector *v1beta1.StatusCollector, opts v1.CreateOptions) (result *v1beta1.StatusCollector, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(statuscollectorsResource, statusCollector), &v1beta1.StatusCollector{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatusCollector), err
+}
+
+// Update takes the representation of a statusCollector and updates it. Returns the server's representation of the statusCollector, and an error, if there is any.
+func (c *FakeStatusCollectors) Update(ctx context.Context, statusCollector *v1beta1.StatusCollector, opts v1.UpdateOptions) (result *v1beta1.StatusCollector, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(statuscollectorsResource, statusCollector), &v1beta1.StatusCollector{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatusCollector), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeStatusCollectors) UpdateStatus(ctx context.Context, statusCollector *v1beta1.StatusCollector, opts v1.UpdateOptions) (*v1beta1.StatusCollector, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(statuscollectorsResource, "status", statusCollector), &v1beta1.StatusCollector{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatusCollector), err
+}
+
+// Delete takes name of the statusCollector and deletes it. Returns an error if one occurs.
+func (c *FakeStatusCollectors) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(statuscollectorsResource, name, opts), &v1beta1.StatusCollector{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeStatusCollectors) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(statuscollectorsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.StatusCollectorList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched statusCollector.
+func (c *FakeStatusCollectors) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.StatusCollector, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(statuscollectorsResource, name, pt, data, subresources...), &v1beta1.StatusCollector{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatusCollector), err
+}
@@ -0,0 +1,91 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+
+	controlv1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
+	versioned "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/kubestellar/kubestellar/pkg/generated/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1beta1"
+)
+
+// CombinedStatusInformer provides access to a shared informer and lister for
+// CombinedStatuses.
+type CombinedStatusInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.CombinedStatusLister
+}
+
+type combinedStatusInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewCombinedStatusInformer constructs a new informer for CombinedStatus type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewCombinedStatusInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredCombinedStatusInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredCombinedStatusInformer constructs a new informer for CombinedStatus type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredCombinedStatusInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ControlV1beta1().CombinedStatuses(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ControlV1beta1().CombinedStatuses(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&controlv1beta1.CombinedStatus{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *combinedStatusInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredCombinedStatusInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *combinedStatusInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&controlv1beta1.CombinedStatus{}, f.defaultInformer)
+}
+
+func (f *combinedStatusInformer) Lister() v1beta1.CombinedStatusLister {
+	return v1beta1.NewCombinedStatusLister(f.Informer().GetIndexer())
+}
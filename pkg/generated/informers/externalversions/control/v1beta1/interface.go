@@ -0,0 +1,73 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	internalinterfaces "github.com/kubestellar/kubestellar/pkg/generated/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// Bindings returns a BindingInformer.
+	Bindings() BindingInformer
+	// BindingPolicies returns a BindingPolicyInformer.
+	BindingPolicies() BindingPolicyInformer
+	// CombinedStatuses returns a CombinedStatusInformer.
+	CombinedStatuses() CombinedStatusInformer
+	// CustomTransforms returns a CustomTransformInformer.
+	CustomTransforms() CustomTransformInformer
+	// StatusCollectors returns a StatusCollectorInformer.
+	StatusCollectors() StatusCollectorInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// Bindings returns a BindingInformer.
+func (v *version) Bindings() BindingInformer {
+	return &bindingInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// BindingPolicies returns a BindingPolicyInformer.
+func (v *version) BindingPolicies() BindingPolicyInformer {
+	return &bindingPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CombinedStatuses returns a CombinedStatusInformer.
+func (v *version) CombinedStatuses() CombinedStatusInformer {
+	return &combinedStatusInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CustomTransforms returns a CustomTransformInformer.
+func (v *version) CustomTransforms() CustomTransformInformer {
+	return &customTransformInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// StatusCollectors returns a StatusCollectorInformer.
+func (v *version) StatusCollectors() StatusCollectorInformer {
+	return &statusCollectorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -25,6 +25,7 @@ import (
 	cache "k8s.io/client-go/tools/cache"
 
 	v1alpha1 "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
 )
 
 // GenericInformer is type of SharedIndexInformer which will locate and delegate to other
@@ -65,6 +66,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("statuscollectors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Control().V1alpha1().StatusCollectors().Informer()}, nil
 
+		// Group=control.kubestellar.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("bindings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Control().V1beta1().Bindings().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("bindingpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Control().V1beta1().BindingPolicies().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("combinedstatuses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Control().V1beta1().CombinedStatuses().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("customtransforms"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Control().V1beta1().CustomTransforms().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("statuscollectors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Control().V1beta1().StatusCollectors().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,100 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1beta1 "github.com/kubestellar/kubestellar/api/control/v1beta1"
+)
+
+// CombinedStatusLister helps list CombinedStatuses.
+// All objects returned here must be treated as read-only.
+type CombinedStatusLister interface {
+	// List lists all CombinedStatuses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.CombinedStatus, err error)
+	// CombinedStatuses returns an object that can list and get CombinedStatuses.
+	CombinedStatuses(namespace string) CombinedStatusNamespaceLister
+	CombinedStatusListerExpansion
+}
+
+// combinedStatusLister implements the CombinedStatusLister interface.
+type combinedStatusLister struct {
+	indexer cache.Indexer
+}
+
+// NewCombinedStatusLister returns a new CombinedStatusLister.
+func NewCombinedStatusLister(indexer cache.Indexer) CombinedStatusLister {
+	return &combinedStatusLister{indexer: indexer}
+}
+
+// List lists all CombinedStatuses in the indexer.
+func (s *combinedStatusLister) List(selector labels.Selector) (ret []*v1beta1.CombinedStatus, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.CombinedStatus))
+	})
+	return ret, err
+}
+
+// CombinedStatuses returns an object that can list and get CombinedStatuses.
+func (s *combinedStatusLister) CombinedStatuses(namespace string) CombinedStatusNamespaceLister {
+	return combinedStatusNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// CombinedStatusNamespaceLister helps list and get CombinedStatuses.
+// All objects returned here must be treated as read-only.
+type CombinedStatusNamespaceLister interface {
+	// List lists all CombinedStatuses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.CombinedStatus, err error)
+	// Get retrieves the CombinedStatus from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.CombinedStatus, error)
+	CombinedStatusNamespaceListerExpansion
+}
+
+// combinedStatusNamespaceLister implements the CombinedStatusNamespaceLister
+// interface.
+type combinedStatusNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all CombinedStatuses in the indexer for a given namespace.
+func (s combinedStatusNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.CombinedStatus, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.CombinedStatus))
+	})
+	return ret, err
+}
+
+// Get retrieves the CombinedStatus from the indexer for a given namespace and name.
+func (s combinedStatusNamespaceLister) Get(name string) (*v1beta1.CombinedStatus, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("combinedstatus"), name)
+	}
+	return obj.(*v1beta1.CombinedStatus), nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// BindingListerExpansion allows custom methods to be added to
+// BindingLister.
+type BindingListerExpansion interface{}
+
+// BindingPolicyListerExpansion allows custom methods to be added to
+// BindingPolicyLister.
+type BindingPolicyListerExpansion interface{}
+
+// CombinedStatusListerExpansion allows custom methods to be added to
+// CombinedStatusLister.
+type CombinedStatusListerExpansion interface{}
+
+// CombinedStatusNamespaceListerExpansion allows custom methods to be added to
+// CombinedStatusNamespaceLister.
+type CombinedStatusNamespaceListerExpansion interface{}
+
+// CustomTransformListerExpansion allows custom methods to be added to
+// CustomTransformLister.
+type CustomTransformListerExpansion interface{}
+
+// StatusCollectorListerExpansion allows custom methods to be added to
+// StatusCollectorLister.
+type StatusCollectorListerExpansion interface{}
@@ -64,6 +64,17 @@ func GVRString(gvr schema.GroupVersionResource) string {
 
 type RegisterFn = func(k8smetrics.Registerable) error
 
+// PrometheusRegisterFn returns a RegisterFn that registers with a plain
+// Prometheus registry, such as the one that controller-runtime serves.
+func PrometheusRegisterFn(reg prometheus.Registerer) RegisterFn {
+	return func(registerable k8smetrics.Registerable) error {
+		if !registerable.Create(nil) {
+			return nil // hidden
+		}
+		return reg.Register(registerable)
+	}
+}
+
 func Must(err error) {
 	if err != nil {
 		panic(err)
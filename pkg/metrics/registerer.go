@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TrackingRegisterer is a prometheus.Registerer that remembers what it has registered,
+// so that all of it can be unregistered at once when its user goes away.
+type TrackingRegisterer struct {
+	prometheus.Registerer
+
+	mutex      sync.Mutex
+	collectors []prometheus.Collector
+}
+
+var _ prometheus.Registerer = &TrackingRegisterer{}
+
+// NewTrackingRegisterer returns a TrackingRegisterer that registers with the given one.
+func NewTrackingRegisterer(reg prometheus.Registerer) *TrackingRegisterer {
+	return &TrackingRegisterer{Registerer: reg}
+}
+
+func (tr *TrackingRegisterer) Register(collector prometheus.Collector) error {
+	if err := tr.Registerer.Register(collector); err != nil {
+		return err
+	}
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	tr.collectors = append(tr.collectors, collector)
+	return nil
+}
+
+func (tr *TrackingRegisterer) MustRegister(collectors ...prometheus.Collector) {
+	for _, collector := range collectors {
+		Must(tr.Register(collector))
+	}
+}
+
+func (tr *TrackingRegisterer) Unregister(collector prometheus.Collector) bool {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	for idx, registered := range tr.collectors {
+		if registered == collector {
+			tr.collectors = append(tr.collectors[:idx], tr.collectors[idx+1:]...)
+			break
+		}
+	}
+	return tr.Registerer.Unregister(collector)
+}
+
+// UnregisterAll unregisters everything that was registered through this TrackingRegisterer.
+func (tr *TrackingRegisterer) UnregisterAll() {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	for _, collector := range tr.collectors {
+		tr.Registerer.Unregister(collector)
+	}
+	tr.collectors = nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	k8smetrics "k8s.io/component-base/metrics"
+)
+
+func TestTrackingRegisterer(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	newSampler := func() Sampler {
+		return NewSampler(func() float64 { return 1 }, &k8smetrics.KubeOpts{Name: "widgets", Help: "number of widgets"})
+	}
+	registerForWDS := func(wds string) *TrackingRegisterer {
+		tracking := NewTrackingRegisterer(prometheus.WrapRegistererWith(prometheus.Labels{"wds": wds}, registry))
+		MustRegister(PrometheusRegisterFn(tracking), newSampler())
+		return tracking
+	}
+	numSeries := func() int {
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatalf("failed to gather: %v", err)
+		}
+		ans := 0
+		for _, family := range families {
+			ans += len(family.Metric)
+		}
+		return ans
+	}
+
+	wds1 := registerForWDS("wds1")
+	registerForWDS("wds2")
+	if actual := numSeries(); actual != 2 {
+		t.Fatalf("expected a series for each WDS, got %d", actual)
+	}
+	wds1.UnregisterAll()
+	if actual := numSeries(); actual != 1 {
+		t.Fatalf("expected only the series of wds2 after unregistering wds1, got %d", actual)
+	}
+	// The same metrics can be registered again for a WDS that comes back.
+	registerForWDS("wds1")
+	if actual := numSeries(); actual != 2 {
+		t.Fatalf("expected a series for each WDS after wds1 came back, got %d", actual)
+	}
+}
@@ -17,17 +17,13 @@ limitations under the License.
 package ocm
 
 import (
-	"context"
 	"fmt"
 	"os"
 
-	clusterclientset "open-cluster-management.io/api/client/cluster/clientset/versioned"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
 	workv1 "open-cluster-management.io/api/work/v1"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -60,25 +56,3 @@ func GetOCMClient(kubeconfig *rest.Config) client.Client {
 	}
 	return c
 }
-
-func FindClustersBySelectors(ctx context.Context, client clusterclientset.Interface, selectors []metav1.LabelSelector) (sets.Set[string], error) {
-	// in order to support OR between label selectors in a straightforward manner, we perform List for each selector.
-	// additionally, to support complex selectors (such as set selectors), we avoid conversion to maps.
-	clusterNames := sets.New[string]()
-	for _, s := range selectors {
-		ls, err := metav1.LabelSelectorAsSelector(&s)
-		if err != nil {
-			return clusterNames, fmt.Errorf("failed to convert metav1.LabelSelector to labels.Selector: %w", err)
-		}
-		clusters, err := client.ClusterV1().ManagedClusters().List(ctx, metav1.ListOptions{LabelSelector: ls.String()})
-		if err != nil {
-			return nil, fmt.Errorf("error listing clusters with selector %s: %w", ls, err)
-		}
-
-		for _, cluster := range clusters.Items {
-			clusterNames.Insert(cluster.GetName())
-		}
-	}
-
-	return clusterNames, nil
-}
@@ -0,0 +1,255 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	coordinationlisters "k8s.io/client-go/listers/coordination/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// GroupLabel is the label, on the Leases of the replicas, whose value names the group of
+	// replicas that share the work.
+	GroupLabel = "sharding.kubestellar.io/group"
+
+	// LeaseDuration is how long a replica is considered alive after renewing its Lease.
+	LeaseDuration = 30 * time.Second
+
+	// RenewInterval is how often a replica renews its Lease, and re-examines the Leases of the others.
+	RenewInterval = 10 * time.Second
+)
+
+// LeaseSharder is a Sharder that divides the keys among the live members of a group of replicas.
+// Each member renews a Lease of its own; a member whose Lease is not renewed for LeaseDuration
+// is considered gone and its keys move to the remaining members.
+// Because each member reaches its own conclusions, at its own time, two members may both
+// own a key (or none may) for a few seconds after the membership changes.
+type LeaseSharder struct {
+	logger    logr.Logger
+	client    kubernetes.Interface
+	namespace string
+	group     string
+	identity  string
+	leaseName string
+	now       func() time.Time
+
+	leaseLister coordinationlisters.LeaseLister
+
+	mutex         sync.RWMutex
+	members       []string // sorted
+	handlers      map[int]func()
+	nextHandlerID int
+}
+
+var _ Sharder = &LeaseSharder{}
+
+// NewLeaseSharder makes a LeaseSharder for the given member of the given group,
+// whose Leases are in the given namespace. Call Start before using it.
+func NewLeaseSharder(logger logr.Logger, client kubernetes.Interface, namespace, group, identity string) *LeaseSharder {
+	return &LeaseSharder{
+		logger:    logger.WithValues("shardGroup", group, "shardIdentity", identity),
+		client:    client,
+		namespace: namespace,
+		group:     group,
+		identity:  identity,
+		leaseName: group + "-" + identity,
+		now:       time.Now,
+		handlers:  map[int]func(){},
+	}
+}
+
+// Start creates (or takes over) the Lease of this member and waits until the Leases of
+// the others are known. Afterwards, until the given context is done, it keeps renewing
+// the Lease and following the membership. Call Leave on the way out.
+func (ls *LeaseSharder) Start(ctx context.Context) error {
+	if err := ls.renew(ctx); err != nil {
+		return fmt.Errorf("failed to create the Lease of shard member %s: %w", ls.identity, err)
+	}
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(ls.client, 0,
+		informers.WithNamespace(ls.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = labels.Set{GroupLabel: ls.group}.String()
+		}))
+	leaseInformer := informerFactory.Coordination().V1().Leases()
+	ls.leaseLister = leaseInformer.Lister()
+	_, err := leaseInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { ls.updateMembers() },
+		UpdateFunc: func(old, new interface{}) { ls.updateMembers() },
+		DeleteFunc: func(obj interface{}) { ls.updateMembers() },
+	})
+	if err != nil {
+		return err
+	}
+	informerFactory.Start(ctx.Done())
+	if ok := cache.WaitForCacheSync(ctx.Done(), leaseInformer.Informer().HasSynced); !ok {
+		return fmt.Errorf("failed to wait for the Leases of group %s to sync", ls.group)
+	}
+	ls.updateMembers()
+	ls.logger.Info("Joined the group of shard members", "members", ls.Members())
+	go ls.run(ctx)
+	return nil
+}
+
+func (ls *LeaseSharder) run(ctx context.Context) {
+	ticker := time.NewTicker(RenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := ls.renew(ctx); err != nil {
+			ls.logger.Error(err, "Failed to renew the Lease of this shard member")
+		}
+		// the Leases of others may have expired without any event
+		ls.updateMembers()
+	}
+}
+
+// renew creates or updates the Lease of this member.
+func (ls *LeaseSharder) renew(ctx context.Context) error {
+	leases := ls.client.CoordinationV1().Leases(ls.namespace)
+	now := metav1.NewMicroTime(ls.now())
+	durationSeconds := int32(LeaseDuration / time.Second)
+	lease, err := leases.Get(ctx, ls.leaseName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ls.leaseName,
+				Namespace: ls.namespace,
+				Labels:    map[string]string{GroupLabel: ls.group},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &ls.identity,
+				LeaseDurationSeconds: &durationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		_, err = leases.Create(ctx, lease, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	lease = lease.DeepCopy()
+	if lease.Labels == nil {
+		lease.Labels = map[string]string{}
+	}
+	lease.Labels[GroupLabel] = ls.group
+	lease.Spec.HolderIdentity = &ls.identity
+	lease.Spec.LeaseDurationSeconds = &durationSeconds
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// Leave deletes the Lease of this member, so that the others take over its keys
+// without waiting for the Lease to expire.
+func (ls *LeaseSharder) Leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), RenewInterval)
+	defer cancel()
+	err := ls.client.CoordinationV1().Leases(ls.namespace).Delete(ctx, ls.leaseName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		ls.logger.Error(err, "Failed to delete the Lease of this shard member")
+		return
+	}
+	ls.logger.Info("Left the group of shard members")
+}
+
+// updateMembers recomputes the live members and, if they changed, calls the handlers.
+func (ls *LeaseSharder) updateMembers() {
+	leases, err := ls.leaseLister.Leases(ls.namespace).List(labels.Everything())
+	if err != nil {
+		ls.logger.Error(err, "Failed to list the Leases of the shard members")
+		return
+	}
+	members := liveMembers(leases, ls.now())
+	ls.mutex.Lock()
+	if slices.Equal(members, ls.members) {
+		ls.mutex.Unlock()
+		return
+	}
+	ls.members = members
+	handlers := make([]func(), 0, len(ls.handlers))
+	for _, handler := range ls.handlers {
+		handlers = append(handlers, handler)
+	}
+	ls.mutex.Unlock()
+	ls.logger.Info("Shard members changed", "members", members)
+	for _, handler := range handlers {
+		handler()
+	}
+}
+
+// liveMembers returns the sorted identities of the holders of the given Leases
+// that have been renewed within their duration before the given time.
+func liveMembers(leases []*coordinationv1.Lease, now time.Time) []string {
+	members := []string{}
+	for _, lease := range leases {
+		spec := lease.Spec
+		if spec.HolderIdentity == nil || *spec.HolderIdentity == "" || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		if !spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second).After(now) {
+			continue
+		}
+		members = append(members, *spec.HolderIdentity)
+	}
+	slices.Sort(members)
+	return slices.Compact(members)
+}
+
+// Members returns the sorted identities of the members that are currently considered alive.
+func (ls *LeaseSharder) Members() []string {
+	ls.mutex.RLock()
+	defer ls.mutex.RUnlock()
+	return slices.Clone(ls.members)
+}
+
+func (ls *LeaseSharder) Owns(key string) bool {
+	ls.mutex.RLock()
+	defer ls.mutex.RUnlock()
+	return OwnerOf(ls.members, key) == ls.identity
+}
+
+func (ls *LeaseSharder) AddOwnershipChangeHandler(handler func()) func() {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+	id := ls.nextHandlerID
+	ls.nextHandlerID++
+	ls.handlers[id] = handler
+	return func() {
+		ls.mutex.Lock()
+		defer ls.mutex.Unlock()
+		delete(ls.handlers, id)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/klog/v2"
+)
+
+// TestLeaseSharder tests that members of a group follow each other joining, leaving,
+// and failing to renew their Leases, and divide the keys among themselves.
+func TestLeaseSharder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := fake.NewSimpleClientset()
+	start := time.Now()
+	var elapsed atomic.Int64 // shared clock of the members, as a time.Duration since start
+	newMember := func(identity string) *LeaseSharder {
+		ls := NewLeaseSharder(klog.Background(), client, "kubestellar", "wds1", identity)
+		ls.now = func() time.Time { return start.Add(time.Duration(elapsed.Load())) }
+		if err := ls.Start(ctx); err != nil {
+			t.Fatalf("failed to start member %s: %v", identity, err)
+		}
+		return ls
+	}
+	expectMembers := func(ls *LeaseSharder, expected ...string) {
+		t.Helper()
+		err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true,
+			func(context.Context) (bool, error) { return slices.Equal(ls.Members(), expected), nil })
+		if err != nil {
+			t.Fatalf("expected %s to see members %v, got %v", ls.identity, expected, ls.Members())
+		}
+	}
+	var changes atomic.Int32
+	expectChange := func(what string) {
+		t.Helper()
+		// the handlers are called after the members are updated
+		err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true,
+			func(context.Context) (bool, error) { return changes.Swap(0) > 0, nil })
+		if err != nil {
+			t.Errorf("expected the ownership change handler to be called when %s", what)
+		}
+	}
+	keys := make([]string, 100)
+	for idx := range keys {
+		keys[idx] = fmt.Sprintf("policy-%d", idx)
+	}
+	expectPartition := func(members ...*LeaseSharder) {
+		t.Helper()
+		for _, key := range keys {
+			owners := 0
+			for _, member := range members {
+				if member.Owns(key) {
+					owners++
+				}
+			}
+			if owners != 1 {
+				t.Errorf("expected key %s to have one owner, got %d", key, owners)
+			}
+		}
+	}
+
+	a := newMember("a")
+	expectMembers(a, "a")
+	removeHandler := a.AddOwnershipChangeHandler(func() { changes.Add(1) })
+	defer removeHandler()
+	expectPartition(a)
+
+	b := newMember("b")
+	expectMembers(a, "a", "b")
+	expectMembers(b, "a", "b")
+	expectChange("a member joins")
+	expectPartition(a, b)
+
+	// a member that leaves deletes its Lease, and the others take over at once
+	b.Leave()
+	if _, err := client.CoordinationV1().Leases("kubestellar").Get(ctx, b.leaseName, metav1.GetOptions{}); err == nil {
+		t.Error("expected the Lease of a member that left to be deleted")
+	}
+	expectMembers(a, "a")
+	expectChange("a member leaves")
+	expectPartition(a)
+
+	// a member that stops renewing is dropped once its Lease expires
+	c := newMember("c")
+	expectMembers(a, "a", "c")
+	expectChange("a member joins")
+	elapsed.Store(int64(LeaseDuration / 2))
+	if err := a.renew(ctx); err != nil {
+		t.Fatalf("failed to renew: %v", err)
+	}
+	lease, err := client.CoordinationV1().Leases("kubestellar").Get(ctx, a.leaseName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the Lease: %v", err)
+	}
+	if renewTime := lease.Spec.RenewTime; renewTime == nil || !renewTime.Time.Equal(a.now()) {
+		t.Errorf("expected the renewal to record the current time, got %v", renewTime)
+	}
+	expectMembers(a, "a", "c")
+	elapsed.Store(int64(LeaseDuration + time.Second))
+	a.updateMembers()
+	expectMembers(a, "a")
+	expectChange("a member's Lease expires")
+	expectPartition(a)
+	if err := c.renew(ctx); err != nil {
+		t.Fatalf("failed to renew: %v", err)
+	}
+	expectMembers(a, "a", "c")
+	expectChange("an expired member renews its Lease")
+}
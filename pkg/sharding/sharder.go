@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding divides work among the active replicas of a controller.
+package sharding
+
+import (
+	"hash/fnv"
+)
+
+// Sharder tells whether this replica is responsible for a given key.
+// The answer can change over time, as replicas come and go.
+type Sharder interface {
+	// Owns tells whether this replica is currently responsible for the given key.
+	Owns(key string) bool
+
+	// AddOwnershipChangeHandler registers a func to call after the ownership of keys
+	// may have changed. The handler must not block.
+	// The returned func unregisters the handler.
+	AddOwnershipChangeHandler(handler func()) (remove func())
+}
+
+// Everything is the Sharder of a lone replica, which owns every key.
+var Everything Sharder = everything{}
+
+type everything struct{}
+
+func (everything) Owns(key string) bool { return true }
+
+func (everything) AddOwnershipChangeHandler(handler func()) func() { return func() {} }
+
+// OwnerOf returns the member that owns the given key, by rendezvous
+// (highest random weight) hashing; or "" if there are no members.
+// When a member comes or goes, only the keys that it gains or loses change owner.
+func OwnerOf(members []string, key string) string {
+	var owner string
+	var highest uint64
+	for _, member := range members {
+		weight := weigh(member, key)
+		if owner == "" || weight > highest || weight == highest && member < owner {
+			owner, highest = member, weight
+		}
+	}
+	return owner
+}
+
+func weigh(member, key string) uint64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(member))
+	_, _ = hasher.Write([]byte{0})
+	_, _ = hasher.Write([]byte(key))
+	return mix(hasher.Sum64())
+}
+
+// mix finalizes a hash, so that similar member names get unrelated weights
+// (this is the finalizer of MurmurHash3).
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
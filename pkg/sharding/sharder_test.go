@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOwnerOf(t *testing.T) {
+	if owner := OwnerOf(nil, "policy"); owner != "" {
+		t.Errorf("expected no owner without members, got %q", owner)
+	}
+	members := []string{"replica-a", "replica-b", "replica-c"}
+	keys := make([]string, 3000)
+	counts := map[string]int{}
+	owners := map[string]string{}
+	for idx := range keys {
+		keys[idx] = fmt.Sprintf("policy-%d", idx)
+		owner := OwnerOf(members, keys[idx])
+		owners[keys[idx]] = owner
+		counts[owner]++
+	}
+	for _, member := range members {
+		if counts[member] < len(keys)/len(members)*8/10 {
+			t.Errorf("member %s owns too few keys: %v", member, counts)
+		}
+	}
+	// When a member goes away, only its keys move.
+	remaining := []string{"replica-a", "replica-c"}
+	for _, key := range keys {
+		owner := OwnerOf(remaining, key)
+		if owners[key] != "replica-b" && owner != owners[key] {
+			t.Errorf("key %s moved from %s to %s", key, owners[key], owner)
+		}
+		if owner == "replica-b" {
+			t.Errorf("key %s is owned by a departed member", key)
+		}
+	}
+}
+
+func TestLiveMembers(t *testing.T) {
+	now := time.Now()
+	makeLease := func(holder string, renewedAgo time.Duration) *coordinationv1.Lease {
+		renewTime := metav1.NewMicroTime(now.Add(-renewedAgo))
+		durationSeconds := int32(LeaseDuration / time.Second)
+		return &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			RenewTime:            &renewTime,
+			LeaseDurationSeconds: &durationSeconds,
+		}}
+	}
+	leases := []*coordinationv1.Lease{
+		makeLease("replica-c", time.Second),
+		makeLease("replica-a", LeaseDuration-time.Second),
+		makeLease("replica-b", LeaseDuration+time.Second),
+		{},
+	}
+	members := liveMembers(leases, now)
+	if expected := []string{"replica-a", "replica-c"}; !slices.Equal(members, expected) {
+		t.Errorf("expected members %v, got %v", expected, members)
+	}
+}
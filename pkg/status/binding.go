@@ -33,7 +33,7 @@ func (c *Controller) syncBinding(ctx context.Context, key string) error {
 	}
 
 	// NoteBindingResolution does not use the resolution if isDeleted is true
-	changedCombinedStatuses := c.combinedStatusResolver.NoteBindingResolution(key, resolution, isDeleted,
+	changedCombinedStatuses := c.combinedStatusResolver.NoteBindingResolution(ctx, key, resolution, isDeleted,
 		c.workStatusIndexer, c.statusCollectorLister)
 	for combinedStatus := range changedCombinedStatuses {
 		c.workqueue.AddAfter(combinedStatusRef(combinedStatus.ObjectName.AsNamespacedName().String()), queueingDelay)
@@ -17,10 +17,11 @@ limitations under the License.
 package status
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtime2 "k8s.io/apimachinery/pkg/util/runtime"
@@ -62,7 +63,7 @@ type CombinedStatusResolver interface {
 	//
 	// The returned set contains the identifiers of combinedstatus objects
 	// that should be queued for syncing.
-	NoteBindingResolution(bindingName string, bindingResolution *binding.Resolution, deleted bool,
+	NoteBindingResolution(ctx context.Context, bindingName string, bindingResolution *binding.Resolution, deleted bool,
 		workStatusIndexer cache.Indexer,
 		statusCollectorLister controllisters.StatusCollectorLister) sets.Set[util.ObjectIdentifier]
 
@@ -78,7 +79,7 @@ type CombinedStatusResolver interface {
 	//
 	// The returned set contains the identifiers of combinedstatus objects
 	// that should be queued for syncing.
-	NoteStatusCollector(statusCollector *v1alpha1.StatusCollector, deleted bool,
+	NoteStatusCollector(ctx context.Context, statusCollector *v1alpha1.StatusCollector, deleted bool,
 		workStatusIndexer cache.Indexer) sets.Set[util.ObjectIdentifier]
 
 	// NoteWorkStatus notes a workstatus in the combinedstatus resolutions
@@ -86,7 +87,7 @@ type CombinedStatusResolver interface {
 	//
 	// The returned set contains the identifiers of combinedstatus objects
 	// that should be queued for syncing.
-	NoteWorkStatus(workStatus *workStatus) sets.Set[util.ObjectIdentifier]
+	NoteWorkStatus(ctx context.Context, workStatus *workStatus) sets.Set[util.ObjectIdentifier]
 
 	// ResolutionExists returns true if a combinedstatus resolution is
 	// associated with the given name. The name is expected to follow the
@@ -105,10 +106,12 @@ type CombinedStatusResolver interface {
 
 // NewCombinedStatusResolver creates a new CombinedStatusResolver.
 func NewCombinedStatusResolver(celEvaluator *celEvaluator,
-	wdsListers util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister]) CombinedStatusResolver {
+	wdsListers util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister],
+	fullObjectGetter *util.FullObjectGetter) CombinedStatusResolver {
 	return &combinedStatusResolver{
 		celEvaluator:              celEvaluator,
 		wdsListers:                wdsListers,
+		fullObjectGetter:          fullObjectGetter,
 		bindingNameToResolutions:  make(map[string]map[util.ObjectIdentifier]*combinedStatusResolution),
 		resolutionNameToKey:       make(map[string]resolutionKey),
 		statusCollectorNameToSpec: make(map[string]*v1alpha1.StatusCollectorSpec),
@@ -123,8 +126,9 @@ type resolutionKey struct {
 }
 
 type combinedStatusResolver struct {
-	celEvaluator *celEvaluator
-	wdsListers   util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister]
+	celEvaluator     *celEvaluator
+	wdsListers       util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister]
+	fullObjectGetter *util.FullObjectGetter // fetches what the listers, which hold only metadata, lack
 
 	sync.RWMutex
 
@@ -178,7 +182,7 @@ func (c *combinedStatusResolver) CompareCombinedStatus(bindingName string,
 //
 // The returned set contains the identifiers of combinedstatus objects
 // that should be queued for syncing.
-func (c *combinedStatusResolver) NoteBindingResolution(bindingName string, bindingResolution *binding.Resolution,
+func (c *combinedStatusResolver) NoteBindingResolution(ctx context.Context, bindingName string, bindingResolution *binding.Resolution,
 	deleted bool, workStatusIndexer cache.Indexer,
 	statusCollectorLister controllisters.StatusCollectorLister) sets.Set[util.ObjectIdentifier] {
 	c.Lock()
@@ -263,7 +267,7 @@ func (c *combinedStatusResolver) NoteBindingResolution(bindingName string, bindi
 
 	// evaluate workstatuses associated with members of workloadIdentifiersToEvaluate and return the combinedstatus
 	// identifiers that should be queued for syncing
-	return combinedStatusIdentifiersToQueue.Union(c.evaluateWorkStatusesPerBindingReadLocked(bindingName,
+	return combinedStatusIdentifiersToQueue.Union(c.evaluateWorkStatusesPerBindingReadLocked(ctx, bindingName,
 		workloadIdentifiersToEvaluate, destinationsSet, workStatusIndexer))
 }
 
@@ -298,7 +302,7 @@ func (c *combinedStatusResolver) deleteResolutionsForBindingWriteLocked(bindingN
 // The returned set contains the identifiers of combinedstatus objects
 // that should be queued for syncing.
 // TODO: handle errors
-func (c *combinedStatusResolver) NoteWorkStatus(workStatus *workStatus) sets.Set[util.ObjectIdentifier] {
+func (c *combinedStatusResolver) NoteWorkStatus(ctx context.Context, workStatus *workStatus) sets.Set[util.ObjectIdentifier] {
 	c.RLock()
 	defer c.RUnlock()
 
@@ -311,7 +315,7 @@ func (c *combinedStatusResolver) NoteWorkStatus(workStatus *workStatus) sets.Set
 			continue
 		}
 
-		content := c.getCombinedContentMap(workStatus, resolution)
+		content := c.getCombinedContentMap(ctx, workStatus, resolution)
 
 		// this call logs errors, but does not return them for now
 		if resolution.evaluateWorkStatus(c.celEvaluator, workStatus.wecName, content) {
@@ -335,7 +339,7 @@ func (c *combinedStatusResolver) NoteWorkStatus(workStatus *workStatus) sets.Set
 //
 // The returned set contains the identifiers of combinedstatus objects
 // that should be queued for syncing.
-func (c *combinedStatusResolver) NoteStatusCollector(statusCollector *v1alpha1.StatusCollector, deleted bool,
+func (c *combinedStatusResolver) NoteStatusCollector(ctx context.Context, statusCollector *v1alpha1.StatusCollector, deleted bool,
 	workStatusIndexer cache.Indexer) sets.Set[util.ObjectIdentifier] {
 	c.Lock()
 	defer c.Unlock()
@@ -362,7 +366,7 @@ func (c *combinedStatusResolver) NoteStatusCollector(statusCollector *v1alpha1.S
 
 			if resolution.updateStatusCollector(statusCollector.Name, &statusCollector.Spec) { // true if changed
 				// evaluate ALL workstatuses associated with the (binding, workload object) pair
-				combinedStatusIdentifiersToQueue.Insert(c.evaluateWorkStatusesPerBindingReadLocked(bindingName,
+				combinedStatusIdentifiersToQueue.Insert(c.evaluateWorkStatusesPerBindingReadLocked(ctx, bindingName,
 					sets.New(workloadObjectIdentifier), resolution.collectionDestinations,
 					workStatusIndexer).UnsortedList()...)
 			}
@@ -429,7 +433,7 @@ func (c *combinedStatusResolver) fetchMissingStatusCollectorSpecsLocked(statusCo
 // The returned set contains the identifiers of combinedstatus objects that
 // should be queued for syncing.
 // The method is expected to be called with the read lock held.
-func (c *combinedStatusResolver) evaluateWorkStatusesPerBindingReadLocked(bindingName string,
+func (c *combinedStatusResolver) evaluateWorkStatusesPerBindingReadLocked(ctx context.Context, bindingName string,
 	workloadObjIdentifiersToEvaluate sets.Set[util.ObjectIdentifier], destinations sets.Set[string],
 	workStatusIndexer cache.Indexer) sets.Set[util.ObjectIdentifier] {
 	combinedStatusesToQueue := sets.Set[util.ObjectIdentifier]{}
@@ -457,7 +461,7 @@ func (c *combinedStatusResolver) evaluateWorkStatusesPerBindingReadLocked(bindin
 			}
 
 			csResolution := c.bindingNameToResolutions[bindingName][workStatus.sourceObjectIdentifier]
-			content := c.getCombinedContentMap(workStatus, csResolution)
+			content := c.getCombinedContentMap(ctx, workStatus, csResolution)
 
 			// evaluate workstatus
 			if csResolution.evaluateWorkStatus(c.celEvaluator, workStatus.wecName, content) {
@@ -471,7 +475,7 @@ func (c *combinedStatusResolver) evaluateWorkStatusesPerBindingReadLocked(bindin
 }
 
 // getCombinedContentMap returns a map of content for the given workstatus.
-func (c *combinedStatusResolver) getCombinedContentMap(workStatus *workStatus,
+func (c *combinedStatusResolver) getCombinedContentMap(ctx context.Context, workStatus *workStatus,
 	resolution *combinedStatusResolution) map[string]interface{} {
 	content := map[string]interface{}{
 		returnedKey:  workStatus.Content(),
@@ -479,7 +483,7 @@ func (c *combinedStatusResolver) getCombinedContentMap(workStatus *workStatus,
 	}
 
 	if resolution.requiresSourceObjectMetaOrSpec() {
-		objMap, err := c.getObjectMetaAndSpec(workStatus.sourceObjectIdentifier)
+		objMap, err := c.getObjectMetaAndSpec(ctx, workStatus.sourceObjectIdentifier)
 		if err != nil {
 			runtime2.HandleError(fmt.Errorf("failed to get meta & spec for source object %s: %w",
 				workStatus.sourceObjectIdentifier, err))
@@ -495,26 +499,27 @@ func (c *combinedStatusResolver) getCombinedContentMap(workStatus *workStatus,
 // with the given workload object identifier.
 // The function is guaranteed not to return a key of `status` in the map.
 // If the resource contains any other subresources, they are fetched as well.
-func (c *combinedStatusResolver) getObjectMetaAndSpec(objectIdentifier util.ObjectIdentifier) (map[string]interface{}, error) {
-	// fetch object
+// The listers hold only metadata, so the object is fetched from the WDS
+// unless it has not changed since the last fetch.
+// The returned map must not be mutated.
+func (c *combinedStatusResolver) getObjectMetaAndSpec(ctx context.Context, objectIdentifier util.ObjectIdentifier) (map[string]interface{}, error) {
 	lister, exists := c.wdsListers.Get(objectIdentifier.GVR())
 	if !exists {
 		return nil, fmt.Errorf("lister not found for gvr %s", objectIdentifier.GVR())
 	}
-
-	obj, err := getObject(lister, objectIdentifier.ObjectName.Namespace, objectIdentifier.ObjectName.Name)
+	partialObj, err := getObject(lister, objectIdentifier.ObjectName.Namespace, objectIdentifier.ObjectName.Name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get object (%v) with gvr (%v): %w", objectIdentifier.ObjectName,
+		return nil, fmt.Errorf("failed to get object (%v) with gvr (%v) from lister: %w", objectIdentifier.ObjectName,
 			objectIdentifier.GVR(), err)
 	}
 
-	unstrObj, ok := obj.(*unstructured.Unstructured)
-	if !ok {
-		return nil, fmt.Errorf("object cannot be cast to *unstructured.Unstructured: object: %s",
-			util.RefToRuntimeObj(obj))
+	obj, err := c.fullObjectGetter.Get(ctx, objectIdentifier, partialObj.(metav1.Object).GetResourceVersion())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object (%v) with gvr (%v): %w", objectIdentifier.ObjectName,
+			objectIdentifier.GVR(), err)
 	}
 
-	return unstrObj.Object, nil
+	return obj.Object, nil
 }
 
 func getObject(lister cache.GenericLister, namespace, name string) (runtime.Object, error) {
@@ -39,6 +39,13 @@ func (c *Controller) syncCombinedStatus(ctx context.Context, ref string) error {
 
 	bindingName, sourceObjectIdentifier, exists := c.combinedStatusResolver.ResolutionExists(name) // name is unique
 	if !exists {
+		// the resolution of a binding owned by another replica is not here to be found
+		if combinedStatus, err := c.combinedStatusLister.CombinedStatuses(ns).Get(name); err == nil {
+			if bindingName := combinedStatus.Labels["status.kubestellar.io/binding-policy"]; bindingName != "" && !c.sharder.Owns(bindingName) {
+				logger.V(4).Info("Leaving CombinedStatus to the owner of its Binding", "ns", ns, "name", name, "binding", bindingName)
+				return nil
+			}
+		}
 		// if a resolution is not associated to the combined status, then it must be deleted
 		return c.deleteCombinedStatus(ctx, ns, name)
 	}
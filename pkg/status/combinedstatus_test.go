@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	ksfake "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned/fake"
+	controllisters "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+)
+
+// keySharder is a sharded replica that owns the given keys.
+type keySharder sets.Set[string]
+
+func (ks keySharder) Owns(key string) bool { return sets.Set[string](ks).Has(key) }
+
+func (keySharder) AddOwnershipChangeHandler(handler func()) func() { return func() {} }
+
+// TestSyncCombinedStatusWithoutResolution tests that a CombinedStatus that this replica has
+// no resolution for is deleted, unless its Binding belongs to another replica.
+func TestSyncCombinedStatusWithoutResolution(t *testing.T) {
+	testCases := []struct {
+		name          string
+		labels        map[string]string
+		expectDeleted bool
+	}{
+		{name: "binding of another replica", labels: map[string]string{"status.kubestellar.io/binding-policy": "theirs"}, expectDeleted: false},
+		{name: "binding of this replica", labels: map[string]string{"status.kubestellar.io/binding-policy": "ours"}, expectDeleted: true},
+		{name: "no binding", expectDeleted: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			combinedStatus := &v1alpha1.CombinedStatus{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "cs", Labels: tc.labels}}
+			client := ksfake.NewSimpleClientset(combinedStatus)
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if err := indexer.Add(combinedStatus); err != nil {
+				t.Fatalf("failed to add CombinedStatus: %v", err)
+			}
+			c := &Controller{logger: klog.Background(), wdsKsClient: client,
+				combinedStatusLister:   controllisters.NewCombinedStatusLister(indexer),
+				combinedStatusResolver: NewCombinedStatusResolver(nil, nil, nil),
+				sharder:                keySharder(sets.New("ours"))}
+			if err := c.syncCombinedStatus(context.Background(), "app/cs"); err != nil {
+				t.Fatalf("failed to sync CombinedStatus: %v", err)
+			}
+			deleted := false
+			for _, action := range client.Actions() {
+				deleted = deleted || action.GetVerb() == "delete"
+			}
+			if deleted != tc.expectDeleted {
+				t.Errorf("expected deletion: %v, got %v", tc.expectDeleted, deleted)
+			}
+		})
+	}
+}
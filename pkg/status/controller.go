@@ -45,6 +45,7 @@ import (
 	ksclient "github.com/kubestellar/kubestellar/pkg/generated/clientset/versioned"
 	ksinformers "github.com/kubestellar/kubestellar/pkg/generated/informers/externalversions"
 	controllisters "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/sharding"
 	"github.com/kubestellar/kubestellar/pkg/util"
 )
 
@@ -63,15 +64,18 @@ type Controller struct {
 	wdsName      string
 	wdsDynClient dynamic.Interface
 	wdsKsClient  ksclient.Interface
-	itsDynClient dynamic.Interface
+	// fullObjectGetter fetches what the listers, which hold only metadata, lack
+	fullObjectGetter *util.FullObjectGetter
+	// itsDynClients access the ITSes, one per inventory shard
+	itsDynClients []dynamic.Interface
 
 	statusCollectorInformer cache.SharedIndexInformer
 	statusCollectorLister   controllisters.StatusCollectorLister
 	combinedStatusInformer  cache.SharedIndexInformer
 	combinedStatusLister    controllisters.CombinedStatusLister
-	workStatusInformer      cache.SharedIndexInformer
+	workStatusSynced        []cache.InformerSynced
 	workStatusLister        cache.GenericLister
-	workStatusIndexer       cache.Indexer
+	workStatusIndexer       cache.Indexer // holds the WorkStatus objects from all the ITSes
 	workqueue               workqueue.RateLimitingInterface
 	// all wds listers are used to retrieve objects and update status
 	// without having to re-create new caches for this controller
@@ -80,6 +84,9 @@ type Controller struct {
 	celEvaluator            *celEvaluator
 	bindingResolutionBroker binding.ResolutionBroker
 	combinedStatusResolver  CombinedStatusResolver
+
+	// sharder tells which Bindings, and which singleton statuses, this replica is responsible for
+	sharder sharding.Sharder
 }
 
 // bindingRef is a workqueue item that references a Binding
@@ -105,8 +112,10 @@ type statusCollectorRef string
 // that is a singleton status
 type singletonWorkStatusRef workStatusRef
 
-// Create a new  status controller
-func NewController(wdsRestConfig *rest.Config, itsRestConfig *rest.Config, wdsName string,
+// Create a new  status controller.
+// There is one ITS rest config per shard of the inventory; WorkStatus objects
+// are collected from all of them.
+func NewController(wdsRestConfig *rest.Config, itsRestConfigs []*rest.Config, wdsName string,
 	bindingResolutionBroker binding.ResolutionBroker) (*Controller, error) {
 	ratelimiter := workqueue.NewMaxOfRateLimiter(
 		workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, 1000*time.Second),
@@ -118,9 +127,12 @@ func NewController(wdsRestConfig *rest.Config, itsRestConfig *rest.Config, wdsNa
 		return nil, err
 	}
 
-	itsDynClient, err := dynamic.NewForConfig(itsRestConfig)
-	if err != nil {
-		return nil, err
+	itsDynClients := make([]dynamic.Interface, len(itsRestConfigs))
+	for idx, itsRestConfig := range itsRestConfigs {
+		itsDynClients[idx], err = dynamic.NewForConfig(itsRestConfig)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	wdsKsClient, err := ksclient.NewForConfig(wdsRestConfig)
@@ -135,15 +147,32 @@ func NewController(wdsRestConfig *rest.Config, itsRestConfig *rest.Config, wdsNa
 		wdsName:                 wdsName,
 		logger:                  log.Log.WithName(ControllerName),
 		wdsDynClient:            wdsDynClient,
+		fullObjectGetter:        util.NewFullObjectGetter(wdsDynClient, util.DefaultFullObjectCacheSize),
 		wdsKsClient:             wdsKsClient,
-		itsDynClient:            itsDynClient,
-		workqueue:               workqueue.NewRateLimitingQueue(ratelimiter),
+		itsDynClients:           itsDynClients,
+		workqueue:               workqueue.NewRateLimitingQueueWithConfig(ratelimiter, workqueue.RateLimitingQueueConfig{Name: ControllerName + "-" + wdsName}),
 		bindingResolutionBroker: bindingResolutionBroker,
+		sharder:                 sharding.Everything,
 	}
 
 	return controller, nil
 }
 
+// UseSharder has this controller handle only the CombinedStatuses of the Bindings, and the
+// singleton statuses of the workload objects, that the given Sharder says this replica owns.
+// The Sharder must be the one given to the binding controller.
+// By default all of them are handled. Call this before Start.
+func (c *Controller) UseSharder(sharder sharding.Sharder) {
+	c.sharder = sharder
+}
+
+// UseFullObjectGetter has this controller fetch the content of workload objects through
+// the given FullObjectGetter, which may be shared with the binding controller of the same WDS.
+// By default the controller has one of its own, of DefaultFullObjectCacheSize. Call this before Start.
+func (c *Controller) UseFullObjectGetter(fullObjectGetter *util.FullObjectGetter) {
+	c.fullObjectGetter = fullObjectGetter
+}
+
 // Start the status controller
 func (c *Controller) Start(parentCtx context.Context, workers int, cListers chan interface{}) error {
 	logger := klog.FromContext(parentCtx).WithName(ControllerName)
@@ -195,7 +224,7 @@ func (c *Controller) run(ctx context.Context, workers int, cListers chan interfa
 	}
 
 	c.celEvaluator = celEvaluator
-	c.combinedStatusResolver = NewCombinedStatusResolver(celEvaluator, c.listers)
+	c.combinedStatusResolver = NewCombinedStatusResolver(celEvaluator, c.listers, c.fullObjectGetter)
 
 	c.bindingResolutionBroker.RegisterCallback(func(bindingPolicyKey string) {
 		// add binding to workqueue
@@ -294,15 +323,12 @@ func (c *Controller) enqueueCombinedStatus(obj metav1.Object) {
 func (c *Controller) runWorkStatusInformer(ctx context.Context) {
 	logger := klog.FromContext(ctx)
 
-	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(c.itsDynClient, 0*time.Minute)
-
 	gvr := schema.GroupVersionResource{Group: util.WorkStatusGroup,
 		Version:  util.WorkStatusVersion,
 		Resource: util.WorkStatusResource}
 
-	c.workStatusInformer = informerFactory.ForResource(gvr).Informer()
 	// add indexer on key from (wecName, sourceRef) for workstatus fetching efficiency
-	c.workStatusInformer.AddIndexers(cache.Indexers{
+	indexers := cache.Indexers{
 		workStatusIdentificationIndexKey: func(obj interface{}) ([]string, error) {
 			wecName := obj.(metav1.Object).GetNamespace()
 			sourceRef, err := util.GetWorkStatusSourceRef(obj.(runtime.Object))
@@ -314,14 +340,78 @@ func (c *Controller) runWorkStatusInformer(ctx context.Context) {
 			}
 
 			return []string{util.KeyFromSourceRefAndWecName(sourceRef, wecName)}, nil
-		}})
-	c.workStatusIndexer = c.workStatusInformer.GetIndexer()
-	c.workStatusLister = cache.NewGenericLister(c.workStatusInformer.GetIndexer(), gvr.GroupResource())
+		}}
+
+	// With several inventory shards, the WorkStatus objects of all the ITSes are mirrored
+	// into one indexer. This works because a WorkStatus is in the namespace of its WEC
+	// and every WEC is in just one shard.
+	var mirror cache.Indexer
+	if len(c.itsDynClients) > 1 {
+		mirror = cache.NewIndexer(cache.DeletionHandlingMetaNamespaceKeyFunc, indexers)
+		c.workStatusIndexer = mirror
+	}
+	c.workStatusSynced = make([]cache.InformerSynced, len(c.itsDynClients))
+	for idx, itsDynClient := range c.itsDynClients {
+		informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(itsDynClient, 0*time.Minute)
+		workStatusInformer := informerFactory.ForResource(gvr).Informer()
+		if mirror == nil {
+			workStatusInformer.AddIndexers(indexers)
+			c.workStatusIndexer = workStatusInformer.GetIndexer()
+		}
+		// the handler fills the mirror, so it is the handler's sync that counts
+		registration, err := c.addWorkStatusEventHandler(workStatusInformer, mirror)
+		if err != nil {
+			c.logger.Error(err, "failed to add workstatus informer event handler")
+			return
+		}
+		c.workStatusSynced[idx] = registration.HasSynced
+		informerFactory.Start(ctx.Done())
+	}
+	c.workStatusLister = cache.NewGenericLister(c.workStatusIndexer, gvr.GroupResource())
+
+	c.logger.Info("waiting for workstatus cache to sync")
+	if ok := cache.WaitForCacheSync(ctx.Done(), c.workStatusSynced...); !ok {
+		c.logger.Info("failed to wait for workstatus caches to sync")
+	}
+	c.logger.Info("workstatus cache synced")
+
+	// take up the singleton statuses that this replica comes to own
+	removeOwnershipChangeHandler := c.sharder.AddOwnershipChangeHandler(c.requeueSingletonWorkStatuses)
+	defer removeOwnershipChangeHandler()
+
+	<-ctx.Done()
+}
 
-	// add the event handler functions
-	c.workStatusInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: c.handleWorkStatus,
+// requeueSingletonWorkStatuses enqueues every WorkStatus that is about a singleton status.
+// It is called when the ownership of the singleton statuses may have changed.
+func (c *Controller) requeueSingletonWorkStatuses() {
+	for _, obj := range c.workStatusIndexer.List() {
+		if _, ok := obj.(metav1.Object).GetLabels()[util.BindingPolicyLabelSingletonStatusKey]; ok {
+			c.handleWorkStatus(obj)
+		}
+	}
+}
+
+// addWorkStatusEventHandler adds the event handler functions to the given WorkStatus informer.
+// If `mirror` is not nil then the handler first brings it up to date.
+// The returned registration has synced once the handler has seen the informer's initial list.
+func (c *Controller) addWorkStatusEventHandler(workStatusInformer cache.SharedIndexInformer, mirror cache.Indexer) (cache.ResourceEventHandlerRegistration, error) {
+	logger := c.logger
+	return workStatusInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if mirror != nil {
+				if err := mirror.Add(obj); err != nil {
+					logger.Error(err, "Failed to mirror WorkStatus", "object", util.RefToRuntimeObj(obj.(runtime.Object)))
+				}
+			}
+			c.handleWorkStatus(obj)
+		},
 		UpdateFunc: func(old, new interface{}) {
+			if mirror != nil {
+				if err := mirror.Update(new); err != nil {
+					logger.Error(err, "Failed to mirror WorkStatus", "object", util.RefToRuntimeObj(new.(runtime.Object)))
+				}
+			}
 			if shouldSkipUpdate(old, new) {
 				return
 			}
@@ -342,22 +432,17 @@ func (c *Controller) runWorkStatusInformer(ctx context.Context) {
 			c.handleWorkStatus(new)
 		},
 		DeleteFunc: func(obj interface{}) {
+			if mirror != nil {
+				if err := mirror.Delete(obj); err != nil {
+					logger.Error(err, "Failed to unmirror WorkStatus")
+				}
+			}
 			if shouldSkipDelete(obj) {
 				return
 			}
 			c.handleWorkStatus(obj)
 		},
 	})
-
-	informerFactory.Start(ctx.Done())
-
-	c.logger.Info("waiting for workstatus cache to sync")
-	if ok := cache.WaitForCacheSync(ctx.Done(), c.workStatusInformer.HasSynced); !ok {
-		c.logger.Info("failed to wait for workstatus caches to sync")
-	}
-	c.logger.Info("workstatus cache synced")
-
-	<-ctx.Done()
 }
 
 func shouldSkipUpdate(old, new interface{}) bool {
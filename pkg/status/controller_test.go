@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// TestWorkStatusMirrorSynced tests that the mirror of the WorkStatuses of an inventory shard
+// holds all of them once the registration of the WorkStatus event handler has synced.
+func TestWorkStatusMirrorSynced(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gvr := schema.GroupVersionResource{Group: util.WorkStatusGroup, Version: util.WorkStatusVersion, Resource: util.WorkStatusResource}
+	var objs []runtime.Object
+	for idx := 0; idx < 20; idx++ {
+		objs = append(objs, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": gvr.GroupVersion().String(),
+			"kind":       "WorkStatus",
+			"metadata":   map[string]interface{}{"namespace": "wec1", "name": fmt.Sprintf("ws%d", idx)},
+			"spec": map[string]interface{}{"sourceRef": map[string]interface{}{
+				"group": "", "version": "v1", "resource": "configmaps", "kind": "ConfigMap",
+				"namespace": "app", "name": fmt.Sprintf("cm%d", idx)}},
+		}})
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "WorkStatusList"}, objs...)
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	c := &Controller{logger: klog.Background(), workqueue: queue}
+
+	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(client, 0)
+	workStatusInformer := informerFactory.ForResource(gvr).Informer()
+	mirror := cache.NewIndexer(cache.DeletionHandlingMetaNamespaceKeyFunc, cache.Indexers{})
+	registration, err := c.addWorkStatusEventHandler(workStatusInformer, mirror)
+	if err != nil {
+		t.Fatalf("failed to add event handler: %v", err)
+	}
+	informerFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), registration.HasSynced) {
+		t.Fatal("event handler did not sync")
+	}
+	if count := len(mirror.List()); count != len(objs) {
+		t.Errorf("expected the mirror to hold %d WorkStatuses once synced, got %d", len(objs), count)
+	}
+	if count := queue.Len(); count != len(objs) {
+		t.Errorf("expected %d WorkStatuses enqueued, got %d", len(objs), count)
+	}
+}
@@ -22,7 +22,6 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -67,7 +66,7 @@ func (c *Controller) syncWorkStatus(ctx context.Context, ref workStatusRef) erro
 		workStatus.status = status // might be nil
 	}
 
-	combinedStatusSet := c.combinedStatusResolver.NoteWorkStatus(workStatus) // nil .status is equivalent to deleted
+	combinedStatusSet := c.combinedStatusResolver.NoteWorkStatus(ctx, workStatus) // nil .status is equivalent to deleted
 	for combinedStatus := range combinedStatusSet {
 		c.workqueue.AddAfter(combinedStatusRef(combinedStatus.ObjectName.AsNamespacedName().String()), queueingDelay)
 	}
@@ -78,6 +77,11 @@ func (c *Controller) syncWorkStatus(ctx context.Context, ref workStatusRef) erro
 func (c *Controller) syncSingletonWorkStatus(ctx context.Context, ref singletonWorkStatusRef) error {
 	logger := klog.FromContext(ctx)
 
+	if !c.sharder.Owns(util.SingletonStatusKey(ref.sourceObjectIdentifier)) {
+		// the status of the source object is maintained by the replica that owns it
+		return c.syncWorkStatus(ctx, workStatusRef(ref))
+	}
+
 	workStatusObj, err := c.workStatusLister.ByNamespace(ref.wecName).Get(ref.name)
 	if err != nil {
 		if !errors.IsNotFound(err) {
@@ -88,7 +92,7 @@ func (c *Controller) syncSingletonWorkStatus(ctx context.Context, ref singletonW
 	if workStatusObj == nil { // make sure status of source obj is deleted
 		emptyStatus := make(map[string]interface{})
 		if err = updateObjectStatus(ctx, &ref.sourceObjectIdentifier, emptyStatus,
-			c.listers, c.wdsDynClient); err != nil {
+			c.listers, c.wdsDynClient, c.fullObjectGetter); err != nil {
 			return err
 		}
 
@@ -105,7 +109,7 @@ func (c *Controller) syncSingletonWorkStatus(ctx context.Context, ref singletonW
 	if statusLabelVal == util.BindingPolicyLabelSingletonStatusValueUnset {
 		emptyStatus := make(map[string]interface{})
 		return updateObjectStatus(ctx, &ref.sourceObjectIdentifier, emptyStatus,
-			c.listers, c.wdsDynClient)
+			c.listers, c.wdsDynClient, c.fullObjectGetter)
 	}
 
 	status, err := util.GetWorkStatusStatus(workStatusObj)
@@ -119,7 +123,8 @@ func (c *Controller) syncSingletonWorkStatus(ctx context.Context, ref singletonW
 	}
 
 	logger.Info("Updating singleton status", "objectIdentifier", ref.sourceObjectIdentifier)
-	if err = updateObjectStatus(ctx, &ref.sourceObjectIdentifier, status, c.listers, c.wdsDynClient); err != nil {
+	if err = updateObjectStatus(ctx, &ref.sourceObjectIdentifier, status, c.listers, c.wdsDynClient,
+		c.fullObjectGetter); err != nil {
 		return err
 	}
 
@@ -127,7 +132,8 @@ func (c *Controller) syncSingletonWorkStatus(ctx context.Context, ref singletonW
 }
 
 func updateObjectStatus(ctx context.Context, objectIdentifier *util.ObjectIdentifier, status map[string]interface{},
-	listers util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister], wdsDynClient dynamic.Interface) error {
+	listers util.ConcurrentMap[schema.GroupVersionResource, cache.GenericLister], wdsDynClient dynamic.Interface,
+	fullObjectGetter *util.FullObjectGetter) error {
 	logger := klog.FromContext(ctx)
 
 	gvr := objectIdentifier.GVR()
@@ -137,7 +143,7 @@ func updateObjectStatus(ctx context.Context, objectIdentifier *util.ObjectIdenti
 		return nil
 	}
 
-	obj, err := lister.ByNamespace(objectIdentifier.ObjectName.Namespace).Get(objectIdentifier.ObjectName.Name)
+	partialObj, err := getObject(lister, objectIdentifier.ObjectName.Namespace, objectIdentifier.ObjectName.Name)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			logger.V(5).Info("Could not find object", "objectIdentifier", objectIdentifier)
@@ -147,12 +153,19 @@ func updateObjectStatus(ctx context.Context, objectIdentifier *util.ObjectIdenti
 		return fmt.Errorf("failed to get object (%v): %w", objectIdentifier, err)
 	}
 
-	unstrObj, ok := obj.(*unstructured.Unstructured)
-	if !ok {
-		return fmt.Errorf("object cannot be cast to *unstructured.Unstructured: object: %s", util.RefToRuntimeObj(obj))
+	// the lister holds only the metadata, the whole object is needed for the update
+	sharedObj, err := fullObjectGetter.Get(ctx, *objectIdentifier, partialObj.(metav1.Object).GetResourceVersion())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.V(5).Info("Could not find object", "objectIdentifier", objectIdentifier)
+			return nil
+		}
+
+		return fmt.Errorf("failed to fetch object (%v): %w", objectIdentifier, err)
 	}
 
-	// set the status and update the object
+	// set the status and update the object, on a copy because the fetched object is shared
+	unstrObj := sharedObj.DeepCopy()
 	unstrObj.Object["status"] = status
 
 	if objectIdentifier.ObjectName.Namespace == "" {
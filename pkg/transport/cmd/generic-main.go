@@ -27,6 +27,7 @@ import (
 	clusterclient "open-cluster-management.io/api/client/cluster/clientset/versioned"
 	clusterinformers "open-cluster-management.io/api/client/cluster/informers/externalversions"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/server/mux"
 	"k8s.io/apiserver/pkg/server/routes"
 	"k8s.io/client-go/dynamic"
@@ -58,6 +59,12 @@ const (
 	defaultResyncPeriod = time.Duration(0)
 )
 
+// runnableController is what GenericMain uses of a transport controller.
+type runnableController interface {
+	RegisterMetrics(reg ksmetrics.RegisterFn)
+	Run(ctx context.Context, workersCount int) error
+}
+
 func GenericMain(transportImplementation transport.Transport) {
 	logger := klog.Background().WithName(transport.ControllerName)
 	ctx := klog.NewContext(context.Background(), logger)
@@ -99,13 +106,12 @@ func GenericMain(transportImplementation transport.Transport) {
 	}
 	wdsRestConfig.UserAgent = transport.ControllerName
 
-	// get the config for Transport space
-	transportRestConfig, err := options.TransportClientOptions.ToRESTConfig()
+	// get the configs for the ITSes among which the inventory is sharded, usually just one
+	itsNames, itsRestConfigs, err := options.ITSRESTConfigs()
 	if err != nil {
 		logger.Error(err, "unable to build transport kubeconfig")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
-	transportRestConfig.UserAgent = transport.ControllerName
 	spacesClientMetrics := ksmetrics.NewMultiSpaceClientMetrics()
 	ksmetrics.MustRegister(legacyregistry.Register, spacesClientMetrics)
 	// clients for WDS
@@ -120,38 +126,66 @@ func GenericMain(transportImplementation transport.Transport) {
 		logger.Error(err, "Failed to create dynamic k8s clientset for Workload Description Space (WDS)")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
-	// clients for transport space
+	// clients for transport spaces
 	itsClientMetrics := spacesClientMetrics.MetricsForSpace("its")
-	transportClientset, err := kubernetes.NewForConfig(transportRestConfig)
-	if err != nil {
-		logger.Error(err, "failed to create k8s clientset for transport space")
-		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
-	}
-	transportDynamicClient, err := dynamic.NewForConfig(transportRestConfig)
-	if err != nil {
-		logger.Error(err, "failed to create dynamic k8s clientset for transport space")
-		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
-	}
-	ocmClientset, err := clusterclient.NewForConfig(transportRestConfig)
-	if err != nil {
-		logger.Error(err, "failed to create OCM clientset for transport space")
-		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	itsShards := make([]transport.ITSShard, len(itsRestConfigs))
+	transportClientsets := make([]kubernetes.Interface, len(itsRestConfigs))
+	var informerFactories []interface{ Start(<-chan struct{}) }
+	for idx, transportRestConfig := range itsRestConfigs {
+		transportRestConfig.UserAgent = transport.ControllerName
+		itsLogger := logger.WithValues("its", itsNames[idx])
+		transportClientset, err := kubernetes.NewForConfig(transportRestConfig)
+		if err != nil {
+			itsLogger.Error(err, "failed to create k8s clientset for transport space")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		transportDynamicClient, err := dynamic.NewForConfig(transportRestConfig)
+		if err != nil {
+			itsLogger.Error(err, "failed to create dynamic k8s clientset for transport space")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		ocmClientset, err := clusterclient.NewForConfig(transportRestConfig)
+		if err != nil {
+			itsLogger.Error(err, "failed to create OCM clientset for transport space")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		ocmInformerFactory := clusterinformers.NewSharedInformerFactory(ocmClientset, defaultResyncPeriod)
+		itsK8sInformerFactory := k8sinformers.NewSharedInformerFactory(transportClientset, defaultResyncPeriod)
+		informerFactories = append(informerFactories, ocmInformerFactory, itsK8sInformerFactory)
+		transportClientsets[idx] = transportClientset
+		itsShards[idx] = transport.ITSShard{
+			Name:                  itsNames[idx],
+			InventoryPreInformer:  ocmInformerFactory.Cluster().V1().ManagedClusters(),
+			NSClient:              transportClientset.CoreV1().Namespaces(),
+			PropCfgMapPreInformer: itsK8sInformerFactory.Core().V1().ConfigMaps(),
+			DynamicClient:         transportDynamicClient,
+		}
 	}
 
-	ocmInformerFactory := clusterinformers.NewSharedInformerFactory(ocmClientset, defaultResyncPeriod)
-
-	inventoryPreInformer := ocmInformerFactory.Cluster().V1().ManagedClusters()
-
 	wdsKsInformerFactory := ksinformers.NewSharedInformerFactoryWithOptions(wdsClientset, defaultResyncPeriod)
 	wdsControlInformers := wdsKsInformerFactory.Control().V1alpha1()
-
-	itsK8sInformerFactory := k8sinformers.NewSharedInformerFactory(transportClientset, defaultResyncPeriod)
-
-	transportController, err := transport.NewTransportController(ctx, wdsClientMetrics, itsClientMetrics, inventoryPreInformer,
-		wdsClientset.ControlV1alpha1().Bindings(), wdsControlInformers.Bindings(),
-		wdsControlInformers.CustomTransforms(),
-		transportImplementation, wdsClientset, wdsDynamicClient, transportClientset.CoreV1().Namespaces(), itsK8sInformerFactory.Core().V1().ConfigMaps(),
-		transportClientset, transportDynamicClient, options.MaxSizeWrappedObject, options.WdsName)
+	informerFactories = append(informerFactories, wdsKsInformerFactory)
+
+	var transportController runnableController
+	if len(itsShards) == 1 {
+		itsShard := itsShards[0]
+		transportController, err = transport.NewTransportController(ctx, wdsClientMetrics, itsClientMetrics, itsShard.InventoryPreInformer,
+			wdsClientset.ControlV1alpha1().Bindings(), wdsControlInformers.Bindings(),
+			wdsControlInformers.CustomTransforms(), wdsControlInformers.CombinedStatuses(),
+			transportImplementation, wdsClientset, wdsDynamicClient, itsShard.NSClient, itsShard.PropCfgMapPreInformer,
+			transportClientsets[0], itsShard.DynamicClient, options.MaxSizeWrappedObject, options.WdsName)
+	} else {
+		// every ITS is expected to serve the same kind of wrapped object
+		var wrappedObjectGVR schema.GroupVersionResource
+		wrappedObjectGVR, err = transport.DiscoverWrappedObjectGVR(transportImplementation, transportClientsets[0])
+		if err == nil {
+			transportController = transport.NewTransportControllerForITSShards(ctx, wdsClientMetrics, itsClientMetrics,
+				wdsClientset.ControlV1alpha1().Bindings(), wdsControlInformers.Bindings(),
+				wdsControlInformers.CustomTransforms(), wdsControlInformers.CombinedStatuses(),
+				transportImplementation, wdsClientset, wdsDynamicClient, itsShards, options.MaxSizeWrappedObject, options.WdsName,
+				wrappedObjectGVR)
+		}
+	}
 	if err != nil {
 		logger.Error(err, "failed to construct transport controller")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
@@ -160,9 +194,9 @@ func GenericMain(transportImplementation transport.Transport) {
 
 	// notice that there is no need to run Start method in a separate goroutine.
 	// Start method is non-blocking and runs each of the factory's informers in its own dedicated goroutine.
-	ocmInformerFactory.Start(ctx.Done())
-	itsK8sInformerFactory.Start(ctx.Done())
-	wdsKsInformerFactory.Start(ctx.Done())
+	for _, informerFactory := range informerFactories {
+		informerFactory.Start(ctx.Done())
+	}
 
 	if err := transportController.Run(ctx, options.Concurrency); err != nil {
 		logger.Error(err, "failed to run transport controller")
@@ -17,8 +17,13 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/pflag"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+
 	clientopts "github.com/kubestellar/kubestellar/options"
 )
 
@@ -30,10 +35,15 @@ type TransportOptions struct {
 	Concurrency            int
 	WdsClientOptions       *clientopts.ClientOptions[*pflag.FlagSet]
 	TransportClientOptions *clientopts.ClientOptions[*pflag.FlagSet]
-	MaxSizeWrappedObject   int
-	WdsName                string
-	metricsBindAddr        string
-	pprofBindAddr          string
+	// ITSNames, ITSKubeconfigs and ITSContexts identify the ITSes among which the inventory
+	// is sharded, see ITSRESTConfigs
+	ITSNames             []string
+	ITSKubeconfigs       []string
+	ITSContexts          []string
+	MaxSizeWrappedObject int
+	WdsName              string
+	metricsBindAddr      string
+	pprofBindAddr        string
 }
 
 func NewTransportOptions() *TransportOptions {
@@ -51,8 +61,56 @@ func (options *TransportOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&options.Concurrency, "concurrency", options.Concurrency, "number of concurrent workers to run in parallel")
 	options.WdsClientOptions.AddFlags(fs)
 	options.TransportClientOptions.AddFlags(fs)
+	fs.StringSliceVar(&options.ITSNames, "its-name", options.ITSNames, "names of the ITSes among which the inventory is sharded, comma separated. "+
+		"With more than one, each ITS is accessed through the corresponding entry of its-kubeconfig or its-context, "+
+		"on top of the transport kubeconfig flags; with one or none, the ITS is the one given by the transport kubeconfig flags")
+	fs.StringSliceVar(&options.ITSKubeconfigs, "its-kubeconfig", options.ITSKubeconfigs, "paths of the kubeconfig files for the ITSes named by its-name, "+
+		"in the same order; an empty entry means the transport kubeconfig")
+	fs.StringSliceVar(&options.ITSContexts, "its-context", options.ITSContexts, "names of the kubeconfig contexts for the ITSes named by its-name, "+
+		"in the same order; an empty entry means the transport context")
 	fs.IntVar(&options.MaxSizeWrappedObject, "max-size-wrapped-object", options.MaxSizeWrappedObject, "Max size of the wrapped object")
 	fs.StringVar(&options.WdsName, "wds-name", options.WdsName, "name of the wds to connect to. name should be unique")
 	fs.StringVar(&options.metricsBindAddr, "metrics-bind-addr", options.metricsBindAddr, "the [host]:port from which to serve /metrics")
 	fs.StringVar(&options.pprofBindAddr, "pprof-bind-addr", options.pprofBindAddr, "the [host]:port from which to serve /debug/pprof")
 }
+
+// ITSRESTConfigs returns the names and rest configs of the ITSes among which the inventory is sharded.
+// Each ITS is accessed through the transport client options, overridden by the
+// corresponding entries of ITSKubeconfigs and ITSContexts when those are given.
+// When at most one ITS is named, the one ITS may go unnamed.
+func (options *TransportOptions) ITSRESTConfigs() ([]string, []*rest.Config, error) {
+	names := options.ITSNames
+	if len(names) == 0 {
+		names = []string{""}
+	}
+	if len(options.ITSKubeconfigs) != 0 && len(options.ITSKubeconfigs) != len(names) {
+		return nil, nil, fmt.Errorf("got %d its-kubeconfig entries for %d ITSes", len(options.ITSKubeconfigs), len(names))
+	}
+	if len(options.ITSContexts) != 0 && len(options.ITSContexts) != len(names) {
+		return nil, nil, fmt.Errorf("got %d its-context entries for %d ITSes", len(options.ITSContexts), len(names))
+	}
+	if len(names) > 1 {
+		if len(options.ITSKubeconfigs) == 0 && len(options.ITSContexts) == 0 {
+			return nil, nil, fmt.Errorf("several ITSes need its-kubeconfig or its-context to tell them apart")
+		}
+		if sets.New(names...).Len() != len(names) {
+			return nil, nil, fmt.Errorf("the its-name entries are not distinct: %v", names)
+		}
+	}
+	restConfigs := make([]*rest.Config, len(names))
+	for idx, name := range names {
+		var kubeconfig, context string
+		if len(options.ITSKubeconfigs) != 0 {
+			kubeconfig = options.ITSKubeconfigs[idx]
+		}
+		if len(options.ITSContexts) != 0 {
+			context = options.ITSContexts[idx]
+		}
+		restConfig, err := options.TransportClientOptions.ToRESTConfigFor(kubeconfig, context)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build the kubeconfig of ITS %q: %w", name, err)
+		}
+		restConfigs[idx] = restConfig
+	}
+	return names, restConfigs, nil
+}
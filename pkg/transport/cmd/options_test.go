@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// writeKubeconfig writes a kubeconfig with a context per given server, named
+// after the server's host, and returns its path.
+func writeKubeconfig(t *testing.T, currentContext string, hosts ...string) string {
+	config := clientcmdapi.NewConfig()
+	for _, host := range hosts {
+		config.Clusters[host] = &clientcmdapi.Cluster{Server: "https://" + host}
+		config.AuthInfos[host] = &clientcmdapi.AuthInfo{Token: "token"}
+		config.Contexts[host] = &clientcmdapi.Context{Cluster: host, AuthInfo: host}
+	}
+	config.CurrentContext = currentContext
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	return path
+}
+
+func TestITSRESTConfigs(t *testing.T) {
+	shared := writeKubeconfig(t, "its1", "its1", "its2")
+	other := writeKubeconfig(t, "its3", "its3")
+	testCases := []struct {
+		name          string
+		args          []string
+		expectedNames []string
+		expectedHosts []string
+		expectError   bool
+	}{
+		{
+			name:          "one unnamed ITS",
+			args:          []string{"--transport-kubeconfig=" + shared},
+			expectedNames: []string{""},
+			expectedHosts: []string{"https://its1"},
+		},
+		{
+			name:          "one named ITS in a given context",
+			args:          []string{"--transport-kubeconfig=" + shared, "--transport-context=its2", "--its-name=a"},
+			expectedNames: []string{"a"},
+			expectedHosts: []string{"https://its2"},
+		},
+		{
+			name:          "several ITSes by context",
+			args:          []string{"--transport-kubeconfig=" + shared, "--its-name=a,b", "--its-context=its2,its1"},
+			expectedNames: []string{"a", "b"},
+			expectedHosts: []string{"https://its2", "https://its1"},
+		},
+		{
+			name:          "several ITSes by kubeconfig, one defaulting to the transport kubeconfig",
+			args:          []string{"--transport-kubeconfig=" + shared, "--its-name=a,b", "--its-kubeconfig=," + other},
+			expectedNames: []string{"a", "b"},
+			expectedHosts: []string{"https://its1", "https://its3"},
+		},
+		{
+			name:        "several ITSes that are not told apart",
+			args:        []string{"--transport-kubeconfig=" + shared, "--its-name=a,b"},
+			expectError: true,
+		},
+		{
+			name:        "too few contexts",
+			args:        []string{"--transport-kubeconfig=" + shared, "--its-name=a,b", "--its-context=its1"},
+			expectError: true,
+		},
+		{
+			name:        "repeated name",
+			args:        []string{"--transport-kubeconfig=" + shared, "--its-name=a,a", "--its-context=its1,its2"},
+			expectError: true,
+		},
+		{
+			name:        "unknown context",
+			args:        []string{"--transport-kubeconfig=" + shared, "--its-name=a,b", "--its-context=its1,its9"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := NewTransportOptions()
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			options.AddFlags(fs)
+			if err := fs.Parse(tc.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+			names, restConfigs, err := options.ITSRESTConfigs()
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got names %v", names)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			hosts := make([]string, len(restConfigs))
+			for idx, restConfig := range restConfigs {
+				hosts[idx] = restConfig.Host
+			}
+			if !reflect.DeepEqual(names, tc.expectedNames) || !reflect.DeepEqual(hosts, tc.expectedHosts) {
+				t.Errorf("expected names %v and hosts %v, got names %v and hosts %v", tc.expectedNames, tc.expectedHosts, names, hosts)
+			}
+		})
+	}
+}
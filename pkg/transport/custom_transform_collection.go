@@ -170,20 +170,33 @@ func ctSpecGroupResource(spec v1alpha1.CustomTransformSpec) metav1.GroupResource
 	return metav1.GroupResource{Group: spec.APIGroup, Resource: spec.Resource}
 }
 
-func (ctc *customTransformCollectionImpl) parseRemovesAndUpdateStatus(ctx context.Context, ct *v1alpha1.CustomTransform, commonWarnings []string) (removes []jsonpath.Query) {
-	logger := klog.FromContext(ctx)
-	ctCopy := ct.DeepCopy()
-	ctCopy.Status = v1alpha1.CustomTransformStatus{ObservedGeneration: ct.Generation, Warnings: commonWarnings}
-	for idx, queryS := range ct.Spec.Remove {
+// ValidateCustomTransform returns descriptions of the errors in the given CustomTransformSpec, if any.
+func ValidateCustomTransform(spec v1alpha1.CustomTransformSpec) []string {
+	_, errs := parseRemoves(spec)
+	return errs
+}
+
+// parseRemoves parses the `remove` queries of the given CustomTransformSpec,
+// returning the good ones and descriptions of the errors in the others.
+func parseRemoves(spec v1alpha1.CustomTransformSpec) (removes []jsonpath.Query, errs []string) {
+	for idx, queryS := range spec.Remove {
 		query, err := jsonpath.ParseQuery(queryS)
 		if err != nil {
-			ctCopy.Status.Errors = append(ctCopy.Status.Errors, fmt.Sprintf("Error in spec.remove[%d]: %s", idx, err.Error()))
+			errs = append(errs, fmt.Sprintf("Error in spec.remove[%d]: %s", idx, err.Error()))
 		} else if len(query) == 0 {
-			ctCopy.Status.Errors = append(ctCopy.Status.Errors, fmt.Sprintf("Invalid spec.remove[%d]: it identifies the whole object", idx))
+			errs = append(errs, fmt.Sprintf("Invalid spec.remove[%d]: it identifies the whole object", idx))
 		} else {
 			removes = append(removes, query)
 		}
 	}
+	return
+}
+
+func (ctc *customTransformCollectionImpl) parseRemovesAndUpdateStatus(ctx context.Context, ct *v1alpha1.CustomTransform, commonWarnings []string) (removes []jsonpath.Query) {
+	logger := klog.FromContext(ctx)
+	ctCopy := ct.DeepCopy()
+	ctCopy.Status = v1alpha1.CustomTransformStatus{ObservedGeneration: ct.Generation, Warnings: commonWarnings}
+	removes, ctCopy.Status.Errors = parseRemoves(ct.Spec)
 	ctEcho, err := ctc.client.UpdateStatus(ctx, ctCopy, metav1.UpdateOptions{FieldManager: ControllerName})
 	if err != nil {
 		logger.Error(err, "Failed to write status of CustomTransform", "name", ct.Name, "resourceVersion", ct.ResourceVersion, "status", ctCopy.Status)
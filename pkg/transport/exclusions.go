@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/abstract"
+)
+
+// wrapeeExclusions holds, for the workload objects of a Binding that a higher-priority
+// BindingPolicy delivers to some of the Binding's destinations, the clusterIds of those destinations.
+type wrapeeExclusions map[wrapeeKey]sets.Set[string]
+
+func (exclusions wrapeeExclusions) note(obj *unstructured.Unstructured, excludedDestinations []string) {
+	if len(excludedDestinations) > 0 {
+		exclusions[wrapeeKeyOf(obj)] = sets.New(excludedDestinations...)
+	}
+}
+
+// apply returns the objects to deliver to each of the given destinations, taking
+// the exclusions into account. The given objects are the ones to propagate, and the
+// given map holds their customization for each destination; it is nil when no object
+// needed customization. When no object is excluded anywhere, the given map is returned.
+// Otherwise every destination has an entry in the returned map, possibly empty.
+func (exclusions wrapeeExclusions) apply(objectsToPropagate []*unstructured.Unstructured,
+	destToCustomizedObjects map[v1alpha1.Destination][]*unstructured.Unstructured,
+	destinations []v1alpha1.Destination) map[v1alpha1.Destination][]*unstructured.Unstructured {
+	if len(exclusions) == 0 {
+		return destToCustomizedObjects
+	}
+	destToObjects := make(map[v1alpha1.Destination][]*unstructured.Unstructured, len(destinations))
+	for _, dest := range destinations {
+		objects := objectsToPropagate
+		if destToCustomizedObjects != nil {
+			objects = destToCustomizedObjects[dest]
+		}
+		destToObjects[dest] = abstract.NewSliceByFilter(objects, func(obj *unstructured.Unstructured) bool {
+			return !exclusions[wrapeeKeyOf(obj)].Has(dest.ClusterId)
+		})
+	}
+	return destToObjects
+}
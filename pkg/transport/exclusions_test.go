@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestWrapeeExclusions(t *testing.T) {
+	newObj := func(name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetNamespace("ns1")
+		obj.SetName(name)
+		return obj
+	}
+	a, b := newObj("a"), newObj("b")
+	objects := []*unstructured.Unstructured{a, b}
+	destinations := []v1alpha1.Destination{{ClusterId: "c1"}, {ClusterId: "c2"}, {ClusterId: "c3"}}
+
+	exclusions := wrapeeExclusions{}
+	exclusions.note(a, nil)
+	exclusions.note(b, nil)
+	if actual := exclusions.apply(objects, nil, destinations); actual != nil {
+		t.Errorf("expected no per-destination objects without exclusions, got %v", actual)
+	}
+
+	exclusions.note(a, []string{"c2", "c3"})
+	exclusions.note(b, []string{"c3"})
+	expected := map[v1alpha1.Destination][]*unstructured.Unstructured{
+		{ClusterId: "c1"}: {a, b},
+		{ClusterId: "c2"}: {b},
+		{ClusterId: "c3"}: {},
+	}
+	if actual := exclusions.apply(objects, nil, destinations); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	customizedB := newObj("b")
+	customizedB.SetLabels(map[string]string{"customized": "true"})
+	customized := map[v1alpha1.Destination][]*unstructured.Unstructured{
+		{ClusterId: "c1"}: {a, customizedB},
+		{ClusterId: "c2"}: {a, customizedB},
+		{ClusterId: "c3"}: {a, customizedB},
+	}
+	expected = map[v1alpha1.Destination][]*unstructured.Unstructured{
+		{ClusterId: "c1"}: {a, customizedB},
+		{ClusterId: "c2"}: {customizedB},
+		{ClusterId: "c3"}: {},
+	}
+	if actual := exclusions.apply(objects, customized, destinations); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
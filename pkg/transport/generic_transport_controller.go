@@ -18,16 +18,18 @@ package transport
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"go/token"
+	"maps"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	clusterinformers "open-cluster-management.io/api/client/cluster/informers/externalversions/cluster/v1"
-	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -38,11 +40,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	cacheddiscovery "k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/dynamic/dynamicinformer"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
-	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -68,6 +68,12 @@ const (
 	originOwnerReferenceLabel       = "transport.kubestellar.io/originOwnerReferenceBindingKey"
 	originWdsLabel                  = "transport.kubestellar.io/originWdsName"
 	originOwnerGenerationAnnotation = "transport.kubestellar.io/originOwnerReferenceBindingGeneration"
+	// sequenceAnnotation holds the position of a wrapped object in the delivery order
+	// of the wrapped objects of its Binding, counting from zero.
+	sequenceAnnotation = "transport.kubestellar.io/sequence"
+	// contentHashAnnotation holds a hash of the content of a wrapped object,
+	// which leaves out the generation of the Binding that it was made from.
+	contentHashAnnotation = "transport.kubestellar.io/contentHash"
 
 	customTransformDomainIndexName = "custom-transform-domain"
 )
@@ -85,6 +91,7 @@ func NewTransportController(ctx context.Context,
 	bindingClient controlclient.BindingInterface,
 	bindingInformer controlv1alpha1informers.BindingInformer,
 	customTransformInformer controlv1alpha1informers.CustomTransformInformer,
+	combinedStatusInformer controlv1alpha1informers.CombinedStatusInformer,
 	transport Transport,
 	wdsClientset ksclientset.Interface,
 	wdsDynamicClient dynamic.Interface,
@@ -93,17 +100,11 @@ func NewTransportController(ctx context.Context,
 	transportClientset kubernetes.Interface,
 	transportDynamicClient dynamic.Interface,
 	maxSizeWrappedObject int, wdsName string) (*genericTransportController, error) {
-	var emptyWrappedObject runtime.Object
-	if t2, is := transport.(TransportWithCreateOnly); is {
-		emptyWrappedObject = t2.WrapObjectsHavingCreateOnly(make([]Wrapee, 0)) // empty wrapped object to get GVR from it.
-	} else {
-		emptyWrappedObject = transport.WrapObjects([]*unstructured.Unstructured{})
-	}
-	wrappedObjectGVR, err := getGvrFromWrappedObject(transportClientset, emptyWrappedObject)
+	wrappedObjectGVR, err := DiscoverWrappedObjectGVR(transport, transportClientset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get wrapped object GVR - %w", err)
+		return nil, err
 	}
-	return NewTransportControllerForWrappedObjectGVR(ctx, wdsClientMetrics, itsClientMetrics, inventoryPreInformer, bindingClient, bindingInformer, customTransformInformer, transport, wdsClientset, wdsDynamicClient, itsNSClient, propCfgMapPreInformer, transportDynamicClient, maxSizeWrappedObject, wdsName, wrappedObjectGVR), nil
+	return NewTransportControllerForWrappedObjectGVR(ctx, wdsClientMetrics, itsClientMetrics, inventoryPreInformer, bindingClient, bindingInformer, customTransformInformer, combinedStatusInformer, transport, wdsClientset, wdsDynamicClient, itsNSClient, propCfgMapPreInformer, transportDynamicClient, maxSizeWrappedObject, wdsName, wrappedObjectGVR), nil
 }
 
 // NewTransportControllerForWrappedObjectGVR returns a new transport controller.
@@ -114,6 +115,7 @@ func NewTransportControllerForWrappedObjectGVR(ctx context.Context,
 	bindingClient controlclient.BindingInterface,
 	bindingInformer controlv1alpha1informers.BindingInformer,
 	customTransformInformer controlv1alpha1informers.CustomTransformInformer,
+	combinedStatusInformer controlv1alpha1informers.CombinedStatusInformer,
 	transport Transport,
 	wdsClientset ksclientset.Interface,
 	wdsDynamicClient dynamic.Interface,
@@ -122,31 +124,52 @@ func NewTransportControllerForWrappedObjectGVR(ctx context.Context,
 	transportDynamicClient dynamic.Interface,
 	maxSizeWrappedObject int,
 	wdsName string, wrappedObjectGVR schema.GroupVersionResource) *genericTransportController {
+	shard := ITSShard{
+		InventoryPreInformer:  inventoryPreInformer,
+		NSClient:              itsNSClient,
+		PropCfgMapPreInformer: propCfgMapPreInformer,
+		DynamicClient:         transportDynamicClient,
+	}
+	return NewTransportControllerForITSShards(ctx, wdsClientMetrics, itsClientMetrics, bindingClient, bindingInformer, customTransformInformer, combinedStatusInformer, transport, wdsClientset, wdsDynamicClient, []ITSShard{shard}, maxSizeWrappedObject, wdsName, wrappedObjectGVR)
+}
+
+// NewTransportControllerForITSShards returns a new transport controller
+// for an inventory that is sharded among the given ITSes.
+// The wrapped objects for a destination go to the ITS that has the destination's inventory object.
+func NewTransportControllerForITSShards(ctx context.Context,
+	wdsClientMetrics, itsClientMetrics ksmetrics.ClientMetrics,
+	bindingClient controlclient.BindingInterface,
+	bindingInformer controlv1alpha1informers.BindingInformer,
+	customTransformInformer controlv1alpha1informers.CustomTransformInformer,
+	combinedStatusInformer controlv1alpha1informers.CombinedStatusInformer,
+	transport Transport,
+	wdsClientset ksclientset.Interface,
+	wdsDynamicClient dynamic.Interface,
+	itsShards []ITSShard,
+	maxSizeWrappedObject int,
+	wdsName string, wrappedObjectGVR schema.GroupVersionResource) *genericTransportController {
 	measuredBindingClient := ksmetrics.NewWrappedClusterScopedClient[*v1alpha1.Binding, *v1alpha1.BindingList](wdsClientMetrics, util.GetBindingGVR(), bindingClient)
 	measuredWDSDynamicClient := ksmetrics.NewWrappedDynamicClient(wdsClientMetrics, wdsDynamicClient)
-	measuredITSDynamicClient := ksmetrics.NewWrappedDynamicClient(itsClientMetrics, transportDynamicClient)
-	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(measuredITSDynamicClient, 0)
-	wrappedObjectGenericInformer := dynamicInformerFactory.ForResource(wrappedObjectGVR)
+	shards := make([]*itsShard, len(itsShards))
+	for idx, itsShard := range itsShards {
+		shards[idx] = newITSShard(itsClientMetrics, itsShard, wrappedObjectGVR)
+	}
 	customTransformInformer.Informer().AddIndexers(map[string]cache.IndexFunc{customTransformDomainIndexName: customTransformToDomain})
 	customTransformsClient := wdsClientset.ControlV1alpha1().CustomTransforms()
 	measuredCustomTransformClient := ksmetrics.NewWrappedClusterScopedClient[*v1alpha1.CustomTransform, *v1alpha1.CustomTransformList](wdsClientMetrics, v1alpha1.GroupVersion.WithResource("customtransforms"), customTransformsClient)
-	measuredITSNSClient := ksmetrics.NewWrappedClusterScopedClient[*corev1.Namespace, *corev1.NamespaceList](itsClientMetrics, corev1.SchemeGroupVersion.WithResource("namespaces"), itsNSClient)
 	workqueue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), ControllerName)
 
 	transportController := &genericTransportController{
 		logger:                        klog.FromContext(ctx),
-		inventoryInformerSynced:       inventoryPreInformer.Informer().HasSynced,
-		inventoryLister:               inventoryPreInformer.Lister(),
+		shards:                        shards,
 		bindingClient:                 measuredBindingClient,
 		bindingLister:                 bindingInformer.Lister(),
 		bindingInformerSynced:         bindingInformer.Informer().HasSynced,
-		itsNSClient:                   measuredITSNSClient,
-		propCfgMapLister:              propCfgMapPreInformer.Lister().ConfigMaps(v1alpha1.PropertyConfigMapNamespace),
-		propCfgMapInformerSynced:      propCfgMapPreInformer.Informer().HasSynced,
-		wrappedObjectInformerSynced:   wrappedObjectGenericInformer.Informer().HasSynced,
 		customTransformLister:         customTransformInformer.Lister(),
 		customTransformInformerSynced: customTransformInformer.Informer().HasSynced,
-		wecSampler: ksmetrics.NewListLenSampler(inventoryPreInformer.Informer().GetStore().List,
+		combinedStatusLister:          combinedStatusInformer.Lister(),
+		combinedStatusInformerSynced:  combinedStatusInformer.Informer().HasSynced,
+		wecSampler: ksmetrics.NewListLenSampler(shardsList(shards, func(shard *itsShard) cache.SharedIndexInformer { return shard.inventoryInformer }),
 			&k8smetrics.KubeOpts{Namespace: "kubestellar", Subsystem: "transport_controller",
 				Name: "wecs", Help: "number of inventory objects", StabilityLevel: k8smetrics.ALPHA}),
 		bindingSampler: ksmetrics.NewListLenSampler(bindingInformer.Informer().GetStore().List,
@@ -155,10 +178,10 @@ func NewTransportControllerForWrappedObjectGVR(ctx context.Context,
 		transformSampler: ksmetrics.NewListLenSampler(customTransformInformer.Informer().GetStore().List,
 			&k8smetrics.KubeOpts{Namespace: "kubestellar", Subsystem: "transport_controller",
 				Name: "transforms", Help: "number of CustomTransform objects", StabilityLevel: k8smetrics.ALPHA}),
-		propMapSampler: ksmetrics.NewListLenSampler(propCfgMapPreInformer.Informer().GetStore().List,
+		propMapSampler: ksmetrics.NewListLenSampler(shardsList(shards, func(shard *itsShard) cache.SharedIndexInformer { return shard.propCfgMapInformer }),
 			&k8smetrics.KubeOpts{Namespace: "kubestellar", Subsystem: "transport_controller",
 				Name: "prop_maps", Help: "number of property ConfigMaps", StabilityLevel: k8smetrics.ALPHA}),
-		wrappedSampler: ksmetrics.NewListLenSampler(wrappedObjectGenericInformer.Informer().GetStore().List,
+		wrappedSampler: ksmetrics.NewListLenSampler(shardsList(shards, func(shard *itsShard) cache.SharedIndexInformer { return shard.wrappedObjectInformer }),
 			&k8smetrics.KubeOpts{Namespace: "kubestellar", Subsystem: "transport_controller",
 				Name: "wrapped_objects", Help: "number of wrapped objects", StabilityLevel: k8smetrics.ALPHA}),
 		bindingWhatsHist: k8smetrics.NewHistogram(&k8smetrics.HistogramOpts{
@@ -178,7 +201,6 @@ func NewTransportControllerForWrappedObjectGVR(ctx context.Context,
 			StabilityLevel: k8smetrics.ALPHA}),
 		workqueue:                    workqueue,
 		transport:                    transport,
-		transportClient:              measuredITSDynamicClient,
 		wrappedObjectGVR:             wrappedObjectGVR,
 		wdsDynamicClient:             measuredWDSDynamicClient,
 		MaxSizeWrappedObject:         maxSizeWrappedObject,
@@ -222,59 +244,67 @@ func NewTransportControllerForWrappedObjectGVR(ctx context.Context,
 		},
 	})
 
+	// A change in a CombinedStatus may let the rollout of its Binding proceed
+	combinedStatusInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { transportController.handleCombinedStatus(obj, "add") },
+		UpdateFunc: func(_, obj any) { transportController.handleCombinedStatus(obj, "update") },
+	})
+
 	// Set up event handlers for when WrappedObject resources change. The handlers will lookup the origin Binding
 	// of the given WrappedObject and enqueue that Binding object for processing.
 	// This way, we don't need to implement custom logic for handling WrappedObject resources. More info on this pattern:
 	// https://github.com/kubernetes/community/blob/8cafef897a22026d42f5e5bb3f104febe7e29830/contributors/devel/controllers.md
-	wrappedObjectGenericInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj any) {
-			transportController.handleWrappedObject(obj, "add")
-			transportController.wrappedSampler.Prod()
-		},
-		UpdateFunc: func(_, new interface{}) {
-			transportController.handleWrappedObject(new, "update")
-		},
-		DeleteFunc: func(obj any) {
-			if dfsu, is := obj.(*cache.DeletedFinalStateUnknown); is {
-				obj = dfsu.Obj
-			}
-			transportController.handleWrappedObject(obj, "delete")
-			transportController.wrappedSampler.Prod()
-		},
-	})
-	inventoryPreInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj any) {
-			transportController.handlePropertiesEvent(obj, "add")
-			transportController.wecSampler.Prod()
-		},
-		UpdateFunc: func(old, new interface{}) {
-			transportController.handlePropertiesEvent(new, "update")
-		},
-		DeleteFunc: func(obj any) {
-			if dfsu, is := obj.(*cache.DeletedFinalStateUnknown); is {
-				obj = dfsu.Obj
-			}
-			transportController.handlePropertiesEvent(obj, "delete")
-			transportController.wecSampler.Prod()
-		},
-	})
-	propCfgMapPreInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj any) {
-			transportController.handlePropertiesEvent(obj, "add")
-			transportController.propMapSampler.Prod()
-		},
-		UpdateFunc: func(old, new interface{}) {
-			transportController.handlePropertiesEvent(new, "update")
-		},
-		DeleteFunc: func(obj any) {
-			if dfsu, is := obj.(*cache.DeletedFinalStateUnknown); is {
-				obj = dfsu.Obj
-			}
-			transportController.handlePropertiesEvent(obj, "delete")
-			transportController.propMapSampler.Prod()
-		},
-	})
-	dynamicInformerFactory.Start(ctx.Done())
+	for _, shard := range shards {
+		shard.wrappedObjectInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				transportController.handleWrappedObject(obj, "add")
+				transportController.wrappedSampler.Prod()
+			},
+			UpdateFunc: func(_, new interface{}) {
+				transportController.handleWrappedObject(new, "update")
+			},
+			DeleteFunc: func(obj any) {
+				if dfsu, is := obj.(*cache.DeletedFinalStateUnknown); is {
+					obj = dfsu.Obj
+				}
+				transportController.handleWrappedObject(obj, "delete")
+				transportController.wrappedSampler.Prod()
+			},
+		})
+		shard.inventoryInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				transportController.handlePropertiesEvent(obj, "add")
+				transportController.wecSampler.Prod()
+			},
+			UpdateFunc: func(old, new interface{}) {
+				transportController.handlePropertiesEvent(new, "update")
+			},
+			DeleteFunc: func(obj any) {
+				if dfsu, is := obj.(*cache.DeletedFinalStateUnknown); is {
+					obj = dfsu.Obj
+				}
+				transportController.handlePropertiesEvent(obj, "delete")
+				transportController.wecSampler.Prod()
+			},
+		})
+		shard.propCfgMapInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				transportController.handlePropertiesEvent(obj, "add")
+				transportController.propMapSampler.Prod()
+			},
+			UpdateFunc: func(old, new interface{}) {
+				transportController.handlePropertiesEvent(new, "update")
+			},
+			DeleteFunc: func(obj any) {
+				if dfsu, is := obj.(*cache.DeletedFinalStateUnknown); is {
+					obj = dfsu.Obj
+				}
+				transportController.handlePropertiesEvent(obj, "delete")
+				transportController.propMapSampler.Prod()
+			},
+		})
+		shard.dynamicInformerFactory.Start(ctx.Done())
+	}
 
 	return transportController
 }
@@ -296,6 +326,22 @@ func convertObjectToUnstructured(object runtime.Object) (*unstructured.Unstructu
 	return &unstructured.Unstructured{Object: unstructuredObject}, nil
 }
 
+// DiscoverWrappedObjectGVR uses the given transport and the given ITS clientset
+// to discover the GVR of wrapped objects.
+func DiscoverWrappedObjectGVR(transport Transport, transportClientset kubernetes.Interface) (schema.GroupVersionResource, error) {
+	var emptyWrappedObject runtime.Object
+	if t2, is := transport.(TransportWithCreateOnly); is {
+		emptyWrappedObject = t2.WrapObjectsHavingCreateOnly(make([]Wrapee, 0)) // empty wrapped object to get GVR from it.
+	} else {
+		emptyWrappedObject = transport.WrapObjects([]*unstructured.Unstructured{})
+	}
+	wrappedObjectGVR, err := getGvrFromWrappedObject(transportClientset, emptyWrappedObject)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("failed to get wrapped object GVR - %w", err)
+	}
+	return wrappedObjectGVR, nil
+}
+
 func getGvrFromWrappedObject(clientset kubernetes.Interface, wrappedObject runtime.Object) (schema.GroupVersionResource, error) {
 	unstructuredWrappedObject, err := convertObjectToUnstructured(wrappedObject)
 	if err != nil {
@@ -320,18 +366,16 @@ type clusterProperties = map[string]string
 type genericTransportController struct {
 	logger logr.Logger
 
-	inventoryInformerSynced     cache.InformerSynced
-	inventoryLister             clusterlisters.ManagedClusterLister
-	bindingClient               ksmetrics.ClientModNamespace[*v1alpha1.Binding, *v1alpha1.BindingList]
-	bindingLister               controlv1alpha1listers.BindingLister
-	bindingInformerSynced       cache.InformerSynced
-	itsNSClient                 ksmetrics.ClientModNamespace[*corev1.Namespace, *corev1.NamespaceList]
-	propCfgMapLister            corev1listers.ConfigMapNamespaceLister
-	propCfgMapInformerSynced    cache.InformerSynced
-	wrappedObjectInformerSynced cache.InformerSynced
+	// shards holds the ITSes among which the inventory is sharded; usually there is just one.
+	shards                []*itsShard
+	bindingClient         ksmetrics.ClientModNamespace[*v1alpha1.Binding, *v1alpha1.BindingList]
+	bindingLister         controlv1alpha1listers.BindingLister
+	bindingInformerSynced cache.InformerSynced
 
 	customTransformLister                                                        controlv1alpha1listers.CustomTransformLister
 	customTransformInformerSynced                                                cache.InformerSynced
+	combinedStatusLister                                                         controlv1alpha1listers.CombinedStatusLister
+	combinedStatusInformerSynced                                                 cache.InformerSynced
 	wecSampler, bindingSampler, transformSampler, propMapSampler, wrappedSampler ksmetrics.Sampler
 	bindingWhatsHist, bindingWheresHist, bindingAreaHist                         *k8smetrics.Histogram
 
@@ -343,8 +387,7 @@ type genericTransportController struct {
 	// recollectProperties holding the name of a inventory object.
 	workqueue workqueue.RateLimitingInterface
 
-	transport        Transport         //transport is a specific implementation for the transport interface.
-	transportClient  dynamic.Interface // dynamic client to transport wrapped object. since object kind is unknown during complilation, we use dynamic
+	transport        Transport //transport is a specific implementation for the transport interface.
 	wrappedObjectGVR schema.GroupVersionResource
 
 	wdsDynamicClient     dynamic.Interface
@@ -384,6 +427,27 @@ func (c *genericTransportController) handleCustomTransform(obj any, event string
 	c.workqueue.Add(ref)
 }
 
+// handleCombinedStatus enqueues a reference to the Binding of the given CombinedStatus
+// if that Binding has a rollout that is waiting for a health check.
+func (c *genericTransportController) handleCombinedStatus(obj any, event string) {
+	combinedStatus := obj.(*v1alpha1.CombinedStatus)
+	bindingName, found := combinedStatus.Labels[combinedStatusBindingPolicyLabel]
+	if !found {
+		return
+	}
+	binding, err := c.bindingLister.Get(bindingName)
+	if err != nil || binding.Spec.RolloutStrategy == nil || binding.Spec.RolloutStrategy.HealthCheck == nil {
+		return
+	}
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == v1alpha1.TypeRolledOut && condition.Reason == v1alpha1.ReasonRolloutProgressing {
+			c.logger.V(4).Info("Enqueuing reference to Binding due to informer event about CombinedStatus", "bindingName", bindingName, "combinedStatus", cache.MetaObjectToName(combinedStatus), "event", event)
+			c.workqueue.Add(bindingName)
+			return
+		}
+	}
+}
+
 // handleWrappedObject takes transport-specific wrapped object resource,
 // extracts the origin Binding of the given wrapped object and
 // enqueue that Binding object for processing. This way, we
@@ -413,12 +477,18 @@ func (c *genericTransportController) Run(ctx context.Context, workersCount int)
 	defer c.workqueue.ShutDown()
 
 	c.logger.Info("starting transport controller")
-	go c.ensurePropertyNamespace(ctx)
+	for _, shard := range c.shards {
+		go shard.ensurePropertyNamespace(ctx)
+	}
 
 	// Wait for the caches to be synced before starting workers
 	c.logger.Info("waiting for informer caches to sync")
 
-	if ok := cache.WaitForCacheSync(ctx.Done(), c.inventoryInformerSynced, c.bindingInformerSynced, c.wrappedObjectInformerSynced, c.propCfgMapInformerSynced, c.customTransformInformerSynced); !ok {
+	informersSynced := []cache.InformerSynced{c.bindingInformerSynced, c.customTransformInformerSynced, c.combinedStatusInformerSynced}
+	for _, shard := range c.shards {
+		informersSynced = append(informersSynced, shard.inventoryInformer.HasSynced, shard.wrappedObjectInformer.HasSynced, shard.propCfgMapInformer.HasSynced)
+	}
+	if ok := cache.WaitForCacheSync(ctx.Done(), informersSynced...); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 
@@ -436,10 +506,10 @@ func (c *genericTransportController) Run(ctx context.Context, workersCount int)
 	return nil
 }
 
-func (c *genericTransportController) ensurePropertyNamespace(ctx context.Context) {
-	logger := klog.FromContext(ctx)
+func (shard *itsShard) ensurePropertyNamespace(ctx context.Context) {
+	logger := klog.FromContext(ctx).WithValues("its", shard.name)
 	for {
-		_, err := c.itsNSClient.Get(ctx, v1alpha1.PropertyConfigMapNamespace, metav1.GetOptions{})
+		_, err := shard.nsClient.Get(ctx, v1alpha1.PropertyConfigMapNamespace, metav1.GetOptions{})
 		if err == nil {
 			logger.Info("Found property namespace already exists")
 			return
@@ -450,7 +520,7 @@ func (c *genericTransportController) ensurePropertyNamespace(ctx context.Context
 			ns := corev1.Namespace{
 				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PropertyConfigMapNamespace},
 			}
-			_, err = c.itsNSClient.Create(ctx, &ns, metav1.CreateOptions{FieldManager: ControllerName})
+			_, err = shard.nsClient.Create(ctx, &ns, metav1.CreateOptions{FieldManager: ControllerName})
 			if err == nil {
 				logger.Info("Created property namespace")
 				return
@@ -615,6 +685,10 @@ func (c *genericTransportController) syncBinding(ctx context.Context, objectName
 		c.setBindingSensitivities(binding.Name, nil)
 		return c.deleteWrappedObjectsAndFinalizer(ctx, binding)
 	}
+	if binding.Spec.Suspend { // leave the wrapped objects as they are until the Binding is resumed
+		klog.FromContext(ctx).V(4).Info("Not updating wrapped objects of suspended Binding", "bindingName", binding.Name)
+		return nil
+	}
 	// otherwise, object was not deleted and no error occurered while reading the object.
 	return c.updateWrappedObjectsAndFinalizer(ctx, binding)
 }
@@ -625,9 +699,7 @@ func isObjectBeingDeleted(object metav1.Object) bool {
 }
 
 func (c *genericTransportController) deleteWrappedObjectsAndFinalizer(ctx context.Context, binding *v1alpha1.Binding) error {
-	currentWrappedObjectList, err := c.transportClient.Resource(c.wrappedObjectGVR).List(ctx, metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", originOwnerReferenceLabel, binding.GetName(), originWdsLabel, c.wdsName),
-	})
+	currentWrappedObjectList, misplacedWrappedObjects, err := c.listWrappedObjects(ctx, binding)
 	if err != nil {
 		return fmt.Errorf("failed to get current wrapped objects that are owned by Binding '%s' - %w", binding.GetName(), err)
 	}
@@ -645,6 +717,9 @@ func (c *genericTransportController) deleteWrappedObjectsAndFinalizer(ctx contex
 			}
 		}
 	}
+	if err := c.deleteMisplacedWrappedObjects(ctx, misplacedWrappedObjects, nil); err != nil {
+		return fmt.Errorf("failed to delete wrapped objects from ITSes that their WECs have left - %w", err)
+	}
 
 	if err := c.removeFinalizerFromBinding(ctx, binding); err != nil {
 		return fmt.Errorf("failed to remove finalizer from Binding object '%s' - %w", binding.GetName(), err)
@@ -653,10 +728,23 @@ func (c *genericTransportController) deleteWrappedObjectsAndFinalizer(ctx contex
 	return nil
 }
 
+// deleteWrappedObject deletes the named wrapped object from the given mailbox namespace.
+// With a sharded inventory, the deletion is done in every ITS, because the WEC
+// may have moved from one shard to another since the wrapped object was made.
 func (c *genericTransportController) deleteWrappedObject(ctx context.Context, namespace string, objectName string) error {
-	err := c.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Delete(ctx, objectName, metav1.DeleteOptions{})
+	for _, shard := range c.shards {
+		if err := c.deleteWrappedObjectInShard(ctx, shard, namespace, objectName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteWrappedObjectInShard deletes the named wrapped object from the given mailbox namespace in the given ITS.
+func (c *genericTransportController) deleteWrappedObjectInShard(ctx context.Context, shard *itsShard, namespace string, objectName string) error {
+	err := shard.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Delete(ctx, objectName, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) { // if object is already not there, we do not report an error cause desired state was achieved.
-		return fmt.Errorf("failed to delete wrapped object '%s' from destination WEC mailbox namespace '%s' - %w", objectName, namespace, err)
+		return fmt.Errorf("failed to delete wrapped object '%s' from destination WEC mailbox namespace '%s' in ITS '%s' - %w", objectName, namespace, shard.name, err)
 	}
 	return nil
 }
@@ -677,10 +765,8 @@ func (c *genericTransportController) updateWrappedObjectsAndFinalizer(ctx contex
 	if err := c.addFinalizerToBinding(ctx, binding); err != nil {
 		return fmt.Errorf("failed to add finalizer to Binding object '%s' - %w", binding.GetName(), err)
 	}
-	// get current state
-	currentWrappedObjectList, err := c.transportClient.Resource(c.wrappedObjectGVR).List(ctx, metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", originOwnerReferenceLabel, binding.GetName(), originWdsLabel, c.wdsName),
-	})
+	// get current state; wrapped objects in an ITS that their WEC has left do not count
+	currentWrappedObjectList, misplacedWrappedObjects, err := c.listWrappedObjects(ctx, binding)
 	if err != nil {
 		return fmt.Errorf("failed to get current wrapped objects that are owned by Binding '%s' - %w", binding.GetName(), err)
 	}
@@ -689,11 +775,21 @@ func (c *genericTransportController) updateWrappedObjectsAndFinalizer(ctx contex
 	if err != nil {
 		return fmt.Errorf("failed to build wrapped object(s) from Binding '%s' - %w", binding.GetName(), err)
 	}
-	if binding.Status.ObservedGeneration != binding.Generation || !abstract.SliceEqual(binding.Status.Errors, bindingErrors) {
+	now := time.Now()
+	rollout := c.decideRollout(binding, destToDesiredWrappedObject, currentWrappedObjectList, now)
+	conditions := rolloutConditions(binding.Status.Conditions, rollout.condition)
+	closed, windowErrors := c.closedMaintenanceWindows(binding, currentWrappedObjectList, now)
+	bindingErrors = append(bindingErrors, windowErrors...)
+	pending := pendingDestinations(binding, destToDesiredWrappedObject, currentWrappedObjectList, closed)
+	if binding.Status.ObservedGeneration != binding.Generation || !abstract.SliceEqual(binding.Status.Errors, bindingErrors) ||
+		!v1alpha1.AreConditionSlicesSame(binding.Status.Conditions, conditions) ||
+		!apiequality.Semantic.DeepEqual(binding.Status.PendingDestinations, pending) {
 		bindingCopy := binding.DeepCopy()
 		bindingCopy.Status = v1alpha1.BindingStatus{
-			ObservedGeneration: binding.Generation,
-			Errors:             bindingErrors,
+			ObservedGeneration:  binding.Generation,
+			Errors:              bindingErrors,
+			Conditions:          conditions,
+			PendingDestinations: pending,
 		}
 		binding2, err := c.bindingClient.UpdateStatus(ctx, bindingCopy, metav1.UpdateOptions{FieldManager: ControllerName})
 		if err != nil {
@@ -704,32 +800,50 @@ func (c *genericTransportController) updateWrappedObjectsAndFinalizer(ctx contex
 	}
 	c.customTransformCollection.setBindingGroupResources(binding.Name, groupResources)
 	// converge actual state to the desired state
-	if err := c.propagateWrappedObjectToClusters(ctx, destToDesiredWrappedObject, currentWrappedObjectList, binding.Spec.Destinations, len(bindingErrors) != 0); err != nil {
+	if err := c.propagateWrappedObjectToClusters(ctx, destToDesiredWrappedObject, currentWrappedObjectList, binding.Spec.Destinations, len(bindingErrors) != 0, rollout, closed, now); err != nil {
 		return fmt.Errorf("failed to propagate wrapped object(s) for binding '%s' to all required WECs - %w", binding.GetName(), err)
 	}
+	if rollout.requeueAfter > 0 {
+		c.workqueue.AddAfter(binding.Name, rollout.requeueAfter)
+	}
+	if opening := earliestPendingUntil(pending); !opening.IsZero() {
+		c.workqueue.AddAfter(binding.Name, opening.Sub(now))
+	}
 
 	// all objects that appear in the desired state were handled. need to remove wrapped objects that are not part of the desired state
 	for _, wrappedObject := range currentWrappedObjectList.Items { // objects left in currentWrappedObjectList.Items have to be deleted
+		if _, isClosed := closed[wrappedObject.GetNamespace()]; isClosed { // wait for the maintenance window
+			continue
+		}
 		if err := c.deleteWrappedObject(ctx, wrappedObject.GetNamespace(), wrappedObject.GetName()); err != nil {
 			return fmt.Errorf("failed to delete wrapped object from destinations that were removed from desired state - %w", err)
 		}
 	}
+	if err := c.deleteMisplacedWrappedObjects(ctx, misplacedWrappedObjects, closed); err != nil {
+		return fmt.Errorf("failed to delete wrapped objects from ITSes that their WECs have left - %w", err)
+	}
 
 	return nil
 }
 
-func (c *genericTransportController) getObjectsFromWDS(ctx context.Context, binding *v1alpha1.Binding) ([]*unstructured.Unstructured, sets.Set[metav1.GroupResource], error) {
+// getObjectsFromWDS returns the workload objects of the given Binding, after transformation,
+// along with their modulations, the destinations they are excluded from, and their GroupResources.
+func (c *genericTransportController) getObjectsFromWDS(ctx context.Context, binding *v1alpha1.Binding) ([]*unstructured.Unstructured, wrapeeModulations, wrapeeExclusions, sets.Set[metav1.GroupResource], error) {
 	groupResources := sets.New[metav1.GroupResource]()
 	objectsToPropagate := make([]*unstructured.Unstructured, 0)
+	modulations := wrapeeModulations{}
+	exclusions := wrapeeExclusions{}
 	// add cluster-scoped objects to the 'objectsToPropagate' slice
 	for _, clusterScopedObject := range binding.Spec.Workload.ClusterScope {
 		gvr := schema.GroupVersionResource(clusterScopedObject.GroupVersionResource)
 		object, err := c.wdsDynamicClient.Resource(gvr).Get(ctx, clusterScopedObject.Name, metav1.GetOptions{})
 		if err != nil {
-			return nil, groupResources, fmt.Errorf("failed to get required cluster-scoped object '%s' with gvr %s from WDS - %w", clusterScopedObject.Name, gvr, err)
+			return nil, nil, nil, groupResources, fmt.Errorf("failed to get required cluster-scoped object '%s' with gvr %s from WDS - %w", clusterScopedObject.Name, gvr, err)
 		}
 		gr := metav1.GroupResource{Group: clusterScopedObject.GroupVersionResource.Group, Resource: clusterScopedObject.GroupVersionResource.Resource}
 		groupResources.Insert(gr)
+		modulations.note(object, clusterScopedObject.CreateOnly, clusterScopedObject.DeletionPolicy)
+		exclusions.note(object, clusterScopedObject.ExcludedDestinations)
 		objectsToPropagate = append(objectsToPropagate, TransformObject(ctx, c.customTransformCollection, gr, object, binding.Name))
 	}
 	// add namespace-scoped objects to the 'objectsToPropagate' slice
@@ -737,15 +851,17 @@ func (c *genericTransportController) getObjectsFromWDS(ctx context.Context, bind
 		gvr := schema.GroupVersionResource(namespaceScopedObject.GroupVersionResource)
 		object, err := c.wdsDynamicClient.Resource(gvr).Namespace(namespaceScopedObject.Namespace).Get(ctx, namespaceScopedObject.Name, metav1.GetOptions{})
 		if err != nil {
-			return nil, groupResources, fmt.Errorf("failed to get required namespace-scoped object '%s' in namespace '%s' with gvr '%s' from WDS - %w", namespaceScopedObject.Name,
+			return nil, nil, nil, groupResources, fmt.Errorf("failed to get required namespace-scoped object '%s' in namespace '%s' with gvr '%s' from WDS - %w", namespaceScopedObject.Name,
 				namespaceScopedObject.Namespace, gvr, err)
 		}
 		gr := metav1.GroupResource{Group: namespaceScopedObject.GroupVersionResource.Group, Resource: namespaceScopedObject.GroupVersionResource.Resource}
 		groupResources.Insert(gr)
+		modulations.note(object, namespaceScopedObject.CreateOnly, namespaceScopedObject.DeletionPolicy)
+		exclusions.note(object, namespaceScopedObject.ExcludedDestinations)
 		objectsToPropagate = append(objectsToPropagate, TransformObject(ctx, c.customTransformCollection, gr, object, binding.Name))
 	}
 
-	return objectsToPropagate, groupResources, nil
+	return objectsToPropagate, modulations, exclusions, groupResources, nil
 }
 
 // computeDestToWrappedObjects returns the following three things.
@@ -756,7 +872,7 @@ func (c *genericTransportController) getObjectsFromWDS(ctx context.Context, bind
 //   - an error if something transient went wrong.
 func (c *genericTransportController) computeDestToWrappedObjects(ctx context.Context, binding *v1alpha1.Binding) (
 	func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool), []string, sets.Set[metav1.GroupResource], error) {
-	objectsToPropagate, grs, err := c.getObjectsFromWDS(ctx, binding)
+	objectsToPropagate, modulations, exclusions, grs, err := c.getObjectsFromWDS(ctx, binding)
 	if err != nil {
 		return nil, nil, grs, fmt.Errorf("failed to get objects to propagate to WECs from Binding object '%s' - %w", binding.GetName(), err)
 	}
@@ -765,7 +881,14 @@ func (c *genericTransportController) computeDestToWrappedObjects(ctx context.Con
 		return nil, nil, grs, nil // if no objects were found in the workload section, return nil so that we don't distribute an empty wrapped object.
 	}
 
+	// put the objects in delivery order, which customization and wrapping preserve
+	objectsToPropagate, syncWaveErrors := orderBySyncWave(objectsToPropagate)
 	destToCustomizedObjects, bindingErrors := c.computeDestToCustomizedObjects(objectsToPropagate, binding)
+	destToCustomizedObjects = exclusions.apply(objectsToPropagate, destToCustomizedObjects, binding.Spec.Destinations)
+	bindingErrors = append(syncWaveErrors, bindingErrors...)
+	if modulations.anyOrphan() && !c.transportHonorsOrphan() {
+		bindingErrors = append(bindingErrors, "deletionPolicy Orphan is not supported by this transport")
+	}
 
 	// This will be constant if no object needed customization, otherwise a map's get func
 	var destToWrappedObject func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool)
@@ -773,7 +896,11 @@ func (c *genericTransportController) computeDestToWrappedObjects(ctx context.Con
 	if destToCustomizedObjects != nil {
 		asMap := map[v1alpha1.Destination][]*unstructured.Unstructured{}
 		for dest, objects := range destToCustomizedObjects {
-			wrappedObject, err := c.wrap(objects, binding)
+			if len(objects) == 0 { // every object is excluded from this destination
+				asMap[dest] = nil
+				continue
+			}
+			wrappedObject, err := c.wrap(objects, modulations, binding)
 			if err != nil {
 				return nil, nil, grs, fmt.Errorf("failure wrapping for destination %q: %w", binding.Name, err)
 			}
@@ -781,7 +908,7 @@ func (c *genericTransportController) computeDestToWrappedObjects(ctx context.Con
 		}
 		destToWrappedObject = abstract.PrimitiveMapGet(asMap)
 	} else {
-		wrappedObject, err := c.wrap(objectsToPropagate, binding)
+		wrappedObject, err := c.wrap(objectsToPropagate, modulations, binding)
 		if err != nil {
 			return nil, nil, grs, fmt.Errorf("failed to convert wrapped object to unstructured - %w", err)
 		}
@@ -854,13 +981,52 @@ func (c *genericTransportController) computeDestToCustomizedObjects(objectsToPro
 
 // TODO: replace all usage of this function with something that actually copes with the create-only bit.
 func FIXME_ADD_CONSTANT_CREATEONLY(obj *unstructured.Unstructured) Wrapee {
-	return Wrapee{obj, false}
+	return Wrapee{Object: obj}
+}
+
+// wrapeeKey identifies a workload object in a way that survives transformation and customization.
+type wrapeeKey struct {
+	schema.GroupKind
+	cache.ObjectName
+}
+
+func wrapeeKeyOf(obj *unstructured.Unstructured) wrapeeKey {
+	return wrapeeKey{GroupKind: obj.GroupVersionKind().GroupKind(), ObjectName: cache.MetaObjectToName(obj)}
 }
 
-func (c *genericTransportController) wrapBatch(batchToPropagate []*unstructured.Unstructured, binding *v1alpha1.Binding, numShard int, isSharded bool) (*unstructured.Unstructured, error) {
+// wrapeeModulations holds the create-only and orphan bits of the workload objects of a Binding.
+type wrapeeModulations map[wrapeeKey]Wrapee
+
+func (modulations wrapeeModulations) note(obj *unstructured.Unstructured, createOnly bool, deletionPolicy v1alpha1.DeletionPolicy) {
+	modulations[wrapeeKeyOf(obj)] = Wrapee{CreateOnly: createOnly, Orphan: deletionPolicy == v1alpha1.DeletionPolicyOrphan}
+}
+
+// wrapee returns the Wrapee for the given object, with its noted bits.
+func (modulations wrapeeModulations) wrapee(obj *unstructured.Unstructured) Wrapee {
+	wrapee := modulations[wrapeeKeyOf(obj)]
+	wrapee.Object = obj
+	return wrapee
+}
+
+func (modulations wrapeeModulations) anyOrphan() bool {
+	for _, wrapee := range modulations {
+		if wrapee.Orphan {
+			return true
+		}
+	}
+	return false
+}
+
+// transportHonorsOrphan tells whether the transport honors the orphan bit of Wrapee.
+func (c *genericTransportController) transportHonorsOrphan() bool {
+	t2, is := c.transport.(TransportWithOrphan)
+	return is && t2.HonorsOrphan()
+}
+
+func (c *genericTransportController) wrapBatch(batchToPropagate []*unstructured.Unstructured, modulations wrapeeModulations, binding *v1alpha1.Binding, name string) (*unstructured.Unstructured, error) {
 	var wrapped runtime.Object
 	if t2, is := c.transport.(TransportWithCreateOnly); is {
-		wrapees := abstract.SliceMap(batchToPropagate, FIXME_ADD_CONSTANT_CREATEONLY)
+		wrapees := abstract.SliceMap(batchToPropagate, modulations.wrapee)
 		wrapped = t2.WrapObjectsHavingCreateOnly(wrapees)
 	} else {
 		wrapped = c.transport.WrapObjects(batchToPropagate)
@@ -869,27 +1035,87 @@ func (c *genericTransportController) wrapBatch(batchToPropagate []*unstructured.
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert wrapped object to unstructured - %w", err)
 	}
-	// wrapped object name is (Binding.GetName()-WdsName) or (Binding.GetName()-WdsName-numShard).
-	// pay attention - we cannot use the Binding object name, cause we might have duplicate names coming from different WDS spaces.
-	// we add WdsName to the object name to assure name uniqueness,
-	// in order to easily get the origin Binding object name and wds, we add it as an annotations.
-	if isSharded {
-		wrappedObject.SetName(fmt.Sprintf("%s-%s-%d", binding.GetName(), c.wdsName, numShard))
-	} else {
-		wrappedObject.SetName(fmt.Sprintf("%s-%s", binding.GetName(), c.wdsName))
-	}
+	wrappedObject.SetName(name)
 	setLabel(wrappedObject, originOwnerReferenceLabel, binding.GetName())
 	setLabel(wrappedObject, originWdsLabel, c.wdsName)
 	setAnnotation(wrappedObject, originOwnerGenerationAnnotation, binding.GetGeneration())
 	return wrappedObject, err
 }
 
-func (c *genericTransportController) wrap(objectsToPropagate []*unstructured.Unstructured, binding *v1alpha1.Binding) ([]*unstructured.Unstructured, error) {
+// wrap returns the wrapped objects that carry the given objects, which are in delivery order.
+// Wrapped object name is (Binding.GetName()-WdsName), with "-numShard" appended when the
+// objects have to be split into shards to respect the maximum size of a wrapped object.
+// When the transport asks for staged application, each sync wave is wrapped separately
+// and "-w<wave>" is inserted before any "-numShard", where wave is the value of the sync wave,
+// so that the name of the wrapped object for a given wave does not change when other waves come or go.
+// Pay attention - we cannot use the Binding object name, cause we might have duplicate names coming from different WDS spaces.
+// We add WdsName to the object name to assure name uniqueness,
+// in order to easily get the origin Binding object name and wds, we add it as a label.
+// The returned wrapped objects are in delivery order, which is recorded in their sequenceAnnotation.
+func (c *genericTransportController) wrap(objectsToPropagate []*unstructured.Unstructured, modulations wrapeeModulations, binding *v1alpha1.Binding) ([]*unstructured.Unstructured, error) {
+	baseName := fmt.Sprintf("%s-%s", binding.GetName(), c.wdsName)
 	var wrappedObjects []*unstructured.Unstructured
+	if t2, is := c.transport.(TransportWithStagedApplication); is && t2.WantsStagedApplication() {
+		for _, wave := range splitSyncWaves(objectsToPropagate) {
+			stageWrappedObjects, err := c.wrapInShards(wave.objects, modulations, binding, fmt.Sprintf("%s-w%d", baseName, wave.wave))
+			if err != nil {
+				return nil, err
+			}
+			for _, wrappedObject := range stageWrappedObjects {
+				setAnnotation(wrappedObject, v1alpha1.SyncWaveAnnotationKey, wave.wave)
+			}
+			wrappedObjects = append(wrappedObjects, stageWrappedObjects...)
+		}
+	} else {
+		var err error
+		wrappedObjects, err = c.wrapInShards(objectsToPropagate, modulations, binding, baseName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for idx, wrappedObject := range wrappedObjects {
+		setAnnotation(wrappedObject, sequenceAnnotation, idx)
+		if err := setContentHash(wrappedObject); err != nil {
+			return nil, err
+		}
+	}
+	return wrappedObjects, nil
+}
+
+// setContentHash sets the contentHashAnnotation of the given wrapped object,
+// whose content must be complete.
+func setContentHash(wrappedObject *unstructured.Unstructured) error {
+	// hash a copy that shares everything but the metadata
+	content := maps.Clone(wrappedObject.Object)
+	metadata, _ := content["metadata"].(map[string]any)
+	content["metadata"] = maps.Clone(metadata)
+	hashed := &unstructured.Unstructured{Object: content}
+	annotations := maps.Clone(hashed.GetAnnotations())
+	delete(annotations, originOwnerGenerationAnnotation)
+	delete(annotations, contentHashAnnotation)
+	delete(annotations, rolloutBatchTimeAnnotation)
+	hashed.SetAnnotations(annotations)
+	data, err := hashed.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to hash wrapped object '%s' - %w", wrappedObject.GetName(), err)
+	}
+	setAnnotation(wrappedObject, contentHashAnnotation, fmt.Sprintf("%x", sha256.Sum256(data)))
+	return nil
+}
+
+// sameContent tells whether the given current wrapped object, which may be nil,
+// has the content of the given desired one.
+func sameContent(current, desired *unstructured.Unstructured) bool {
+	hash := desired.GetAnnotations()[contentHashAnnotation]
+	return current != nil && hash != "" && current.GetAnnotations()[contentHashAnnotation] == hash
+}
+
+// wrapInShards wraps the given objects into as few wrapped objects as the maximum size allows,
+// keeping the order of the objects.
+func (c *genericTransportController) wrapInShards(objectsToPropagate []*unstructured.Unstructured, modulations wrapeeModulations, binding *v1alpha1.Binding, baseName string) ([]*unstructured.Unstructured, error) {
+	var batches [][]*unstructured.Unstructured
 	var batchToPropagate []*unstructured.Unstructured = nil
 	maxBatchSize := c.MaxSizeWrappedObject
-	isSharded := false
-	numShard := 0
 	var batchSize int = 0
 	for _, obj := range objectsToPropagate {
 		bytes, err := obj.MarshalJSON()
@@ -900,14 +1126,8 @@ func (c *genericTransportController) wrap(objectsToPropagate []*unstructured.Uns
 		if objSize > maxBatchSize {
 			return nil, fmt.Errorf("failed to wrap object that is larger than max size")
 		}
-		if objSize+batchSize >= maxBatchSize {
-			isSharded = true
-			wrappedObject, err := c.wrapBatch(batchToPropagate, binding, numShard, isSharded)
-			if err != nil {
-				return nil, err
-			}
-			numShard += 1
-			wrappedObjects = append(wrappedObjects, wrappedObject)
+		if objSize+batchSize >= maxBatchSize && batchToPropagate != nil {
+			batches = append(batches, batchToPropagate)
 			batchToPropagate = nil
 			batchSize = 0
 		}
@@ -915,7 +1135,16 @@ func (c *genericTransportController) wrap(objectsToPropagate []*unstructured.Uns
 		batchSize += objSize
 	}
 	if batchToPropagate != nil {
-		wrappedObject, err := c.wrapBatch(batchToPropagate, binding, numShard, isSharded)
+		batches = append(batches, batchToPropagate)
+	}
+	isSharded := len(batches) > 1
+	wrappedObjects := make([]*unstructured.Unstructured, 0, len(batches))
+	for numShard, batch := range batches {
+		name := baseName
+		if isSharded {
+			name = fmt.Sprintf("%s-%d", baseName, numShard)
+		}
+		wrappedObject, err := c.wrapBatch(batch, modulations, binding, name)
 		if err != nil {
 			return nil, err
 		}
@@ -953,14 +1182,18 @@ func (c *genericTransportController) collectPropertiesForDestination(logger logr
 		props[key] = val
 		return true
 	}
-	invObj, err := c.inventoryLister.Get(invName)
+	shard := c.shardFor(invName)
+	if shard == nil {
+		return props
+	}
+	invObj, err := shard.inventoryLister.Get(invName)
 	if err == nil && invObj != nil {
 		enumeratePropertiesInMapStringToString(invObj.Labels)(collectProperty)
 		enumeratePropertiesInMapStringToString(invObj.Annotations)(collectProperty)
 	} else if err != nil && !errors.IsNotFound(err) { // listers do not fail
 		logger.Error(err, "Inconceivable failure to fetch inventory object", "dest", invName)
 	}
-	propCfgMap, err := c.propCfgMapLister.Get(invName)
+	propCfgMap, err := shard.propCfgMapLister.Get(invName)
 	if err == nil && propCfgMap != nil {
 		enumeratePropsInConfigMap(propCfgMap)(collectProperty)
 	} else if err != nil && !errors.IsNotFound(err) { // listers do not fail
@@ -1027,7 +1260,7 @@ func (c *genericTransportController) customizeForDestination(object *unstructure
 }
 
 func (c *genericTransportController) propagateWrappedObjectToClusters(ctx context.Context, destToDesiredWrappedObject func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool),
-	currentWrappedObjectList *unstructured.UnstructuredList, destinations []v1alpha1.Destination, broken bool) error {
+	currentWrappedObjectList *unstructured.UnstructuredList, destinations []v1alpha1.Destination, broken bool, rollout rolloutDecision, closed map[string]time.Time, now time.Time) error {
 	// if the desired wrapped object is nil, that means we should not propagate this object.
 	// this may happen when the workload section is empty.
 	// this is not an error state but a valid scenario.
@@ -1039,36 +1272,31 @@ func (c *genericTransportController) propagateWrappedObjectToClusters(ctx contex
 	c.logger.Info("in propagateWrappedObjectToCluster()")
 
 	for _, destination := range destinations {
-		// Loop until popWrappedObjectByNamespace returns nil, in case the wrapped object is sharded.
-		for {
-			currentWrappedObject := c.popWrappedObjectByNamespace(currentWrappedObjectList, destination.ClusterId)
-			if broken {
-				continue
-			}
-
-			foundMatch := false
-			desiredWrappedObjects, _ := destToDesiredWrappedObject(destination)
-			if currentWrappedObject != nil {
-				for _, desiredWrappedObject := range desiredWrappedObjects {
-					// Can't use apiequality.Semantic.DeepEqual to compare the two objects
-					if currentWrappedObject.GetAnnotations() != nil &&
-						currentWrappedObject.GetAnnotations()[originOwnerGenerationAnnotation] == desiredWrappedObject.GetAnnotations()[originOwnerGenerationAnnotation] {
-						foundMatch = true
-						break
-					}
-				}
-			}
-			if foundMatch {
+		_, isClosed := closed[destination.ClusterId]
+		if broken || isClosed || !rollout.allows(destination) { // leave the destination as it is
+			c.popWrappedObjectsByName(currentWrappedObjectList, destination.ClusterId, func(string) bool { return true })
+			continue
+		}
+		desiredWrappedObjects, _ := destToDesiredWrappedObject(destination)
+		desiredNames := sets.New[string]()
+		for _, desiredWrappedObject := range desiredWrappedObjects {
+			desiredNames.Insert(desiredWrappedObject.GetName())
+		}
+		// the current wrapped objects whose names are no longer desired (e.g., of a sync wave that is gone,
+		// or because every workload object is excluded from this destination) stay in the list, to be deleted.
+		currentWrappedObjects := c.popWrappedObjectsByName(currentWrappedObjectList, destination.ClusterId, desiredNames.Has)
+		for _, desiredWrappedObject := range desiredWrappedObjects {
+			currentWrappedObject := currentWrappedObjects[desiredWrappedObject.GetName()]
+			// Can't use apiequality.Semantic.DeepEqual to compare the two objects
+			if sameContent(currentWrappedObject, desiredWrappedObject) {
 				continue
 			}
-			// othereise, need to create or update the wrapped objects
-			for _, desiredWrappedObject := range desiredWrappedObjects {
-				if err := c.createOrUpdateWrappedObject(ctx, destination.ClusterId, desiredWrappedObject); err != nil {
-					return fmt.Errorf("failed to propagate wrapped object to cluster mailbox namespace '%s' - %w", destination.ClusterId, err)
-				}
+			// otherwise, need to create or update the wrapped object
+			if rollout.condition != nil {
+				setAnnotation(desiredWrappedObject, rolloutBatchTimeAnnotation, now.UTC().Format(time.RFC3339))
 			}
-			if currentWrappedObject == nil {
-				break
+			if err := c.createOrUpdateWrappedObject(ctx, destination.ClusterId, desiredWrappedObject); err != nil {
+				return fmt.Errorf("failed to propagate wrapped object to cluster mailbox namespace '%s' - %w", destination.ClusterId, err)
 			}
 		}
 	}
@@ -1096,15 +1324,37 @@ func (c *genericTransportController) popWrappedObjectByNamespace(list *unstructu
 	return nil
 }
 
+// popWrappedObjectsByName removes from the list the wrapped objects in the given namespace
+// whose names satisfy the given predicate, and returns them indexed by name.
+// The other wrapped objects remain in the list.
+func (c *genericTransportController) popWrappedObjectsByName(list *unstructured.UnstructuredList, namespace string, wanted func(string) bool) map[string]*unstructured.Unstructured {
+	ans := map[string]*unstructured.Unstructured{}
+	remaining := list.Items[:0]
+	for idx := range list.Items {
+		wrappedObject := list.Items[idx]
+		if wrappedObject.GetNamespace() == namespace && wanted(wrappedObject.GetName()) {
+			ans[wrappedObject.GetName()] = &wrappedObject
+			continue
+		}
+		remaining = append(remaining, wrappedObject)
+	}
+	list.Items = remaining
+	return ans
+}
+
 func (c *genericTransportController) createOrUpdateWrappedObject(ctx context.Context, namespace string, wrappedObject *unstructured.Unstructured) error {
-	existingWrappedObject, err := c.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Get(ctx, wrappedObject.GetName(), metav1.GetOptions{})
+	shard := c.shardFor(namespace)
+	if shard == nil {
+		return fmt.Errorf("no ITS has an inventory object for WEC '%s'", namespace)
+	}
+	existingWrappedObject, err := shard.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Get(ctx, wrappedObject.GetName(), metav1.GetOptions{})
 	if err != nil {
 		if !errors.IsNotFound(err) { // if object is not there, we need to create it. otherwise report an error.
 			return fmt.Errorf("failed to create wrapped object '%s' in destination WEC with namespace '%s' - %w", wrappedObject.GetName(), namespace, err)
 		}
 		// object not found when using get, create it
 		wrappedObject.SetResourceVersion("") // must be unset for this destination
-		wrappedObject2, err := c.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Create(ctx, wrappedObject, metav1.CreateOptions{
+		wrappedObject2, err := shard.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Create(ctx, wrappedObject, metav1.CreateOptions{
 			FieldManager: ControllerName,
 		})
 		logger := klog.FromContext(ctx)
@@ -1120,7 +1370,7 @@ func (c *genericTransportController) createOrUpdateWrappedObject(ctx context.Con
 	}
 	// // if we reached here object already exists, try update object
 	wrappedObject.SetResourceVersion(existingWrappedObject.GetResourceVersion())
-	_, err = c.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Update(ctx, wrappedObject, metav1.UpdateOptions{
+	_, err = shard.transportClient.Resource(c.wrappedObjectGVR).Namespace(namespace).Update(ctx, wrappedObject, metav1.UpdateOptions{
 		FieldManager: ControllerName,
 	})
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -390,7 +391,7 @@ func TestGenericController(t *testing.T) {
 	itsClientMetrics := spacesClientMetrics.MetricsForSpace("its")
 	ctlr := NewTransportControllerForWrappedObjectGVR(ctx, wdsClientMetrics, itsClientMetrics,
 		inventoryPreInformer, wdsKsClientFake.ControlV1alpha1().Bindings(),
-		wdsControlInformers.Bindings(), wdsControlInformers.CustomTransforms(),
+		wdsControlInformers.Bindings(), wdsControlInformers.CustomTransforms(), wdsControlInformers.CombinedStatuses(),
 		transport,
 		wdsKsClientFake,
 		wdsDynamicClient,
@@ -421,3 +422,63 @@ func TestGenericController(t *testing.T) {
 		logger.Info("Success", "objects", len(objs), "numExpected", len(transport.expect))
 	}
 }
+
+// orphanTestTransport records the Wrapees it is given and honors the orphan bit.
+type orphanTestTransport struct {
+	wrapees []Wrapee
+}
+
+func (ot *orphanTestTransport) WrapObjects(objs []*unstructured.Unstructured) runtime.Object {
+	return ot.WrapObjectsHavingCreateOnly(abstract.SliceMap(objs, FIXME_ADD_CONSTANT_CREATEONLY))
+}
+
+func (ot *orphanTestTransport) WrapObjectsHavingCreateOnly(wrapees []Wrapee) runtime.Object {
+	ot.wrapees = append(ot.wrapees, wrapees...)
+	return &workapi.ManifestWork{TypeMeta: typeMeta("ManifestWork", workapi.GroupVersion)}
+}
+
+func (ot *orphanTestTransport) HonorsOrphan() bool { return true }
+
+func TestWrapeeModulations(t *testing.T) {
+	newObj := func(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	deployment := newObj("apps/v1", "Deployment", "ns1", "app")
+	replicaSet := newObj("apps/v1", "ReplicaSet", "ns1", "app")
+	configMap := newObj("v1", "ConfigMap", "ns1", "config")
+	modulations := wrapeeModulations{}
+	modulations.note(deployment, true, "")
+	modulations.note(replicaSet, false, ksapi.DeletionPolicyOrphan)
+	modulations.note(configMap, false, ksapi.DeletionPolicyDelete)
+	if !modulations.anyOrphan() {
+		t.Error("expected some orphan")
+	}
+
+	transport := &orphanTestTransport{}
+	ctlr := &genericTransportController{transport: transport, wdsName: "wds1", MaxSizeWrappedObject: 1024 * 1024}
+	if !ctlr.transportHonorsOrphan() {
+		t.Error("expected the transport to honor the orphan bit")
+	}
+	binding := &ksapi.Binding{ObjectMeta: metav1.ObjectMeta{Name: "b1", Generation: 1}}
+	if _, err := ctlr.wrap([]*unstructured.Unstructured{deployment, replicaSet, configMap}, modulations, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []Wrapee{
+		{Object: deployment, CreateOnly: true},
+		{Object: replicaSet, Orphan: true},
+		{Object: configMap},
+	}
+	if !reflect.DeepEqual(transport.wrapees, expected) {
+		t.Errorf("expected wrapees %v, got %v", expected, transport.wrapees)
+	}
+
+	ctlr.transport = &testTransport{}
+	if ctlr.transportHonorsOrphan() {
+		t.Error("expected the transport to not honor the orphan bit")
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clusterinformers "open-cluster-management.io/api/client/cluster/informers/externalversions/cluster/v1"
+	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	ksmetrics "github.com/kubestellar/kubestellar/pkg/metrics"
+)
+
+// ITSShard is what the transport controller uses in one of the ITSes
+// among which the inventory is sharded.
+// Every WEC is expected to have its inventory object in just one shard,
+// and its mailbox namespace is in that same ITS.
+type ITSShard struct {
+	// Name identifies the ITS, for logging
+	Name string
+
+	InventoryPreInformer  clusterinformers.ManagedClusterInformer
+	NSClient              corev1client.NamespaceInterface
+	PropCfgMapPreInformer corev1informers.ConfigMapInformer
+
+	// DynamicClient is used to access the wrapped objects
+	DynamicClient dynamic.Interface
+}
+
+// itsShard is the transport controller's working state for one ITSShard.
+type itsShard struct {
+	name                   string
+	inventoryInformer      cache.SharedIndexInformer
+	inventoryLister        clusterlisters.ManagedClusterLister
+	nsClient               ksmetrics.ClientModNamespace[*corev1.Namespace, *corev1.NamespaceList]
+	propCfgMapInformer     cache.SharedIndexInformer
+	propCfgMapLister       corev1listers.ConfigMapNamespaceLister
+	transportClient        dynamic.Interface
+	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	wrappedObjectInformer  cache.SharedIndexInformer
+}
+
+func newITSShard(itsClientMetrics ksmetrics.ClientMetrics, shard ITSShard, wrappedObjectGVR schema.GroupVersionResource) *itsShard {
+	measuredITSDynamicClient := ksmetrics.NewWrappedDynamicClient(itsClientMetrics, shard.DynamicClient)
+	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(measuredITSDynamicClient, 0)
+	return &itsShard{
+		name:                   shard.Name,
+		inventoryInformer:      shard.InventoryPreInformer.Informer(),
+		inventoryLister:        shard.InventoryPreInformer.Lister(),
+		nsClient:               ksmetrics.NewWrappedClusterScopedClient[*corev1.Namespace, *corev1.NamespaceList](itsClientMetrics, corev1.SchemeGroupVersion.WithResource("namespaces"), shard.NSClient),
+		propCfgMapInformer:     shard.PropCfgMapPreInformer.Informer(),
+		propCfgMapLister:       shard.PropCfgMapPreInformer.Lister().ConfigMaps(v1alpha1.PropertyConfigMapNamespace),
+		transportClient:        measuredITSDynamicClient,
+		dynamicInformerFactory: dynamicInformerFactory,
+		wrappedObjectInformer:  dynamicInformerFactory.ForResource(wrappedObjectGVR).Informer(),
+	}
+}
+
+// shardFor returns the shard that has the inventory object of the given WEC,
+// or nil if there is none. When there is only one shard, that is the answer
+// regardless of whether it has the inventory object.
+func (c *genericTransportController) shardFor(wecName string) *itsShard {
+	if len(c.shards) == 1 {
+		return c.shards[0]
+	}
+	for _, shard := range c.shards {
+		if _, err := shard.inventoryLister.Get(wecName); err == nil {
+			return shard
+		}
+	}
+	return nil
+}
+
+// misplacedWrappedObject identifies a wrapped object found in an ITS other than the one
+// that has the inventory object of its WEC, as happens when the WEC moves to another ITS.
+type misplacedWrappedObject struct {
+	shard     *itsShard
+	namespace string
+	name      string
+}
+
+// listWrappedObjects lists the wrapped objects of the given Binding in all the shards.
+// The wrapped objects that are in the shard of their WEC are returned in the list;
+// the others are returned separately, to be deleted.
+func (c *genericTransportController) listWrappedObjects(ctx context.Context, binding *v1alpha1.Binding) (*unstructured.UnstructuredList, []misplacedWrappedObject, error) {
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", originOwnerReferenceLabel, binding.GetName(), originWdsLabel, c.wdsName),
+	}
+	var ans *unstructured.UnstructuredList
+	var misplaced []misplacedWrappedObject
+	for _, shard := range c.shards {
+		list, err := shard.transportClient.Resource(c.wrappedObjectGVR).List(ctx, listOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		items := list.Items
+		if ans == nil {
+			ans = list
+			ans.Items = nil
+		}
+		for _, item := range items {
+			if home := c.shardFor(item.GetNamespace()); home != nil && home != shard {
+				misplaced = append(misplaced, misplacedWrappedObject{shard: shard, namespace: item.GetNamespace(), name: item.GetName()})
+				continue
+			}
+			ans.Items = append(ans.Items, item)
+		}
+	}
+	return ans, misplaced, nil
+}
+
+// deleteMisplacedWrappedObjects deletes the given wrapped objects from the shards where they were found,
+// except those in the mailbox namespaces of the given closed destinations.
+func (c *genericTransportController) deleteMisplacedWrappedObjects(ctx context.Context, misplaced []misplacedWrappedObject, closed map[string]time.Time) error {
+	for _, wrappedObject := range misplaced {
+		if _, isClosed := closed[wrappedObject.namespace]; isClosed { // wait for the maintenance window
+			continue
+		}
+		if err := c.deleteWrappedObjectInShard(ctx, wrappedObject.shard, wrappedObject.namespace, wrappedObject.name); err != nil {
+			return err
+		}
+		klog.FromContext(ctx).V(3).Info("Deleted wrapped object from an ITS that the WEC has left", "its", wrappedObject.shard.name,
+			"namespace", wrappedObject.namespace, "objectName", wrappedObject.name)
+	}
+	return nil
+}
+
+// shardsList returns a func that lists the contents of a given informer of every shard.
+func shardsList(shards []*itsShard, getInformer func(*itsShard) cache.SharedIndexInformer) func() []any {
+	return func() []any {
+		var ans []any
+		for _, shard := range shards {
+			ans = append(ans, getInformer(shard).GetStore().List()...)
+		}
+		return ans
+	}
+}
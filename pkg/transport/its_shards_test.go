@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"testing"
+
+	clusterlisters "open-cluster-management.io/api/client/cluster/listers/cluster/v1"
+	clusterapi "open-cluster-management.io/api/cluster/v1"
+	workapi "open-cluster-management.io/api/work/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2/ktesting"
+
+	ksapi "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestShardRouting(t *testing.T) {
+	_, ctx := ktesting.NewTestContext(t)
+	scheme := runtime.NewScheme()
+	workapi.AddToScheme(scheme)
+	wrapperGVR := workapi.GroupVersion.WithResource("manifestworks")
+	makeShard := func(name string, wecNames ...string) *itsShard {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		for _, wecName := range wecNames {
+			indexer.Add(&clusterapi.ManagedCluster{ObjectMeta: metav1.ObjectMeta{Name: wecName}})
+		}
+		return &itsShard{
+			name:            name,
+			inventoryLister: clusterlisters.NewManagedClusterLister(indexer),
+			transportClient: dynamicfake.NewSimpleDynamicClient(scheme),
+		}
+	}
+	shard1 := makeShard("its1", "wec1")
+	shard2 := makeShard("its2", "wec2", "wec3")
+	ctlr := &genericTransportController{shards: []*itsShard{shard1, shard2}, wrappedObjectGVR: wrapperGVR, wdsName: "wds1"}
+	binding := &ksapi.Binding{ObjectMeta: metav1.ObjectMeta{Name: "b1"}}
+	for _, wecName := range []string{"wec1", "wec3"} {
+		wrapped := &unstructured.Unstructured{}
+		wrapped.SetAPIVersion(workapi.GroupVersion.String())
+		wrapped.SetKind("ManifestWork")
+		wrapped.SetName("b1-wds1")
+		wrapped.SetLabels(map[string]string{originOwnerReferenceLabel: binding.Name, originWdsLabel: "wds1"})
+		if err := ctlr.createOrUpdateWrappedObject(ctx, wecName, wrapped); err != nil {
+			t.Fatalf("failed to create wrapped object for %s: %v", wecName, err)
+		}
+	}
+	if err := ctlr.createOrUpdateWrappedObject(ctx, "wec4", &unstructured.Unstructured{}); err == nil {
+		t.Errorf("expected an error for a WEC in no shard")
+	}
+	for _, tc := range []struct {
+		shard   *itsShard
+		present string
+		absent  string
+	}{{shard1, "wec1", "wec3"}, {shard2, "wec3", "wec1"}} {
+		if _, err := tc.shard.transportClient.Resource(wrapperGVR).Namespace(tc.present).Get(ctx, "b1-wds1", metav1.GetOptions{}); err != nil {
+			t.Errorf("expected wrapped object for %s in %s: %v", tc.present, tc.shard.name, err)
+		}
+		if _, err := tc.shard.transportClient.Resource(wrapperGVR).Namespace(tc.absent).Get(ctx, "b1-wds1", metav1.GetOptions{}); err == nil {
+			t.Errorf("expected no wrapped object for %s in %s", tc.absent, tc.shard.name)
+		}
+	}
+	// a wrapped object left behind in shard1 by wec2, which is now in shard2
+	leftBehind := &unstructured.Unstructured{}
+	leftBehind.SetAPIVersion(workapi.GroupVersion.String())
+	leftBehind.SetKind("ManifestWork")
+	leftBehind.SetName("b1-wds1")
+	leftBehind.SetLabels(map[string]string{originOwnerReferenceLabel: binding.Name, originWdsLabel: "wds1"})
+	if _, err := shard1.transportClient.Resource(wrapperGVR).Namespace("wec2").Create(ctx, leftBehind, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create wrapped object left behind: %v", err)
+	}
+	list, misplaced, err := ctlr.listWrappedObjects(ctx, binding)
+	if err != nil {
+		t.Fatalf("failed to list wrapped objects: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("expected 2 wrapped objects across the shards, got %d", len(list.Items))
+	}
+	if len(misplaced) != 1 || misplaced[0].shard != shard1 || misplaced[0].namespace != "wec2" {
+		t.Errorf("expected the wrapped object for wec2 in its1 to be misplaced, got %+v", misplaced)
+	}
+	if err := ctlr.deleteMisplacedWrappedObjects(ctx, misplaced, nil); err != nil {
+		t.Fatalf("failed to delete misplaced wrapped objects: %v", err)
+	}
+	if _, err := shard1.transportClient.Resource(wrapperGVR).Namespace("wec2").Get(ctx, "b1-wds1", metav1.GetOptions{}); err == nil {
+		t.Errorf("expected the wrapped object left behind in its1 to be deleted")
+	}
+	if err := ctlr.deleteWrappedObject(ctx, "wec3", "b1-wds1"); err != nil {
+		t.Fatalf("failed to delete wrapped object: %v", err)
+	}
+	if _, err := shard2.transportClient.Resource(wrapperGVR).Namespace("wec3").Get(ctx, "b1-wds1", metav1.GetOptions{}); err == nil {
+		t.Errorf("expected the wrapped object for wec3 to be deleted")
+	}
+}
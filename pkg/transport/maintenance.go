@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+// Names of the properties of a WEC that give its own maintenance window
+const (
+	maintenanceScheduleProperty = "maintenanceSchedule"
+	maintenanceDurationProperty = "maintenanceDuration"
+	maintenanceTimeZoneProperty = "maintenanceTimeZone"
+)
+
+// closedMaintenanceWindows returns, for each WEC that is a destination of the given Binding
+// or holds one of its current wrapped objects and is outside of its maintenance window,
+// when its next maintenance window opens. The returned strings describe invalid windows.
+// `*binding` and `*currentWrappedObjectList` are immutable.
+func (c *genericTransportController) closedMaintenanceWindows(binding *v1alpha1.Binding, currentWrappedObjectList *unstructured.UnstructuredList,
+	now time.Time) (map[string]time.Time, []string) {
+	clusterIds := sets.New[string]()
+	for _, destination := range binding.Spec.Destinations {
+		clusterIds.Insert(destination.ClusterId)
+	}
+	for idx := range currentWrappedObjectList.Items {
+		clusterIds.Insert(currentWrappedObjectList.Items[idx].GetNamespace())
+	}
+	closed := map[string]time.Time{}
+	var errs []string
+	policyWindow := binding.Spec.MaintenanceWindow
+	if policyWindow != nil {
+		if _, err := nextMaintenanceWindowOpening(policyWindow, now); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid maintenanceWindow: %s", err))
+			policyWindow = nil
+		}
+	}
+	for _, clusterId := range sets.List(clusterIds) {
+		window := policyWindow
+		props := c.getPropertiesForDestination(binding.Name, v1alpha1.Destination{ClusterId: clusterId})
+		clusterWindow, err := maintenanceWindowFromProperties(props)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("invalid maintenance window of destination %q: %s", clusterId, err))
+			continue
+		}
+		if clusterWindow != nil {
+			window = clusterWindow
+		}
+		if window == nil {
+			continue
+		}
+		opening, err := nextMaintenanceWindowOpening(window, now)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("invalid maintenance window of destination %q: %s", clusterId, err))
+			continue
+		}
+		if !opening.IsZero() {
+			closed[clusterId] = opening
+		}
+	}
+	return closed, errs
+}
+
+// maintenanceWindowFromProperties returns the maintenance window given by the
+// properties of a WEC, or nil if they do not give one.
+func maintenanceWindowFromProperties(props clusterProperties) (*v1alpha1.MaintenanceWindow, error) {
+	schedule, have := props[maintenanceScheduleProperty]
+	if !have {
+		return nil, nil
+	}
+	durationStr, have := props[maintenanceDurationProperty]
+	if !have {
+		return nil, fmt.Errorf("property %s is given but %s is not", maintenanceScheduleProperty, maintenanceDurationProperty)
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse property %s: %w", maintenanceDurationProperty, err)
+	}
+	return &v1alpha1.MaintenanceWindow{
+		Schedule: schedule,
+		Duration: metav1.Duration{Duration: duration},
+		TimeZone: props[maintenanceTimeZoneProperty],
+	}, nil
+}
+
+// nextMaintenanceWindowOpening returns the zero time if the given window is open
+// at the given time, otherwise when the window next opens.
+func nextMaintenanceWindowOpening(window *v1alpha1.MaintenanceWindow, now time.Time) (time.Time, error) {
+	location := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(window.TimeZone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %q: %w", window.TimeZone, err)
+		}
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse schedule %q: %w", window.Schedule, err)
+	}
+	if _, is := schedule.(*cron.SpecSchedule); !is {
+		return time.Time{}, fmt.Errorf("schedule %q does not say when windows open", window.Schedule)
+	}
+	if window.Duration.Duration <= 0 {
+		return time.Time{}, fmt.Errorf("duration %s is not positive", window.Duration.Duration)
+	}
+	// The window is open if it opened after (now - duration) and not after now
+	opening := schedule.Next(now.In(location).Add(-window.Duration.Duration))
+	if opening.IsZero() {
+		return time.Time{}, fmt.Errorf("schedule %q never opens a window", window.Schedule)
+	}
+	if !opening.After(now) {
+		return time.Time{}, nil
+	}
+	return opening, nil
+}
+
+// pendingDestinations returns the WECs, among the given closed ones, that have changes waiting
+// to be propagated to them; these are the destinations that are not up to date and the
+// WECs that hold wrapped objects of the Binding but are no longer destinations.
+// The result is sorted by cluster ID.
+func pendingDestinations(binding *v1alpha1.Binding, destToDesiredWrappedObject func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool),
+	currentWrappedObjectList *unstructured.UnstructuredList, closed map[string]time.Time) []v1alpha1.PendingDestination {
+	if len(closed) == 0 {
+		return nil
+	}
+	updated, _ := updatedDestinations(binding, destToDesiredWrappedObject, currentWrappedObjectList)
+	destinations := sets.New(binding.Spec.Destinations...)
+	pendingIds := sets.New[string]()
+	for destination := range destinations.Difference(updated) {
+		pendingIds.Insert(destination.ClusterId)
+	}
+	for idx := range currentWrappedObjectList.Items {
+		clusterId := currentWrappedObjectList.Items[idx].GetNamespace()
+		if !destinations.Has(v1alpha1.Destination{ClusterId: clusterId}) {
+			pendingIds.Insert(clusterId)
+		}
+	}
+	var pending []v1alpha1.PendingDestination
+	for clusterId := range pendingIds {
+		if opening, isClosed := closed[clusterId]; isClosed {
+			pending = append(pending, v1alpha1.PendingDestination{ClusterId: clusterId, PendingUntil: metav1.NewTime(opening.UTC())})
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].ClusterId < pending[j].ClusterId })
+	return pending
+}
+
+// earliestPendingUntil returns the earliest time at which one of the given
+// destinations stops pending, or the zero time if there are none.
+func earliestPendingUntil(pending []v1alpha1.PendingDestination) time.Time {
+	var earliest time.Time
+	for _, destination := range pending {
+		if earliest.IsZero() || destination.PendingUntil.Time.Before(earliest) {
+			earliest = destination.PendingUntil.Time
+		}
+	}
+	return earliest
+}
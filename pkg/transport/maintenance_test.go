@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func TestNextMaintenanceWindowOpening(t *testing.T) {
+	nightly := &v1alpha1.MaintenanceWindow{Schedule: "0 1 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+	paris := &v1alpha1.MaintenanceWindow{Schedule: "0 1 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}, TimeZone: "Europe/Paris"}
+	testCases := []struct {
+		name        string
+		window      *v1alpha1.MaintenanceWindow
+		now         time.Time
+		expected    time.Time
+		expectError bool
+	}{
+		{name: "before", window: nightly, now: time.Date(2024, 6, 1, 0, 30, 0, 0, time.UTC),
+			expected: time.Date(2024, 6, 1, 1, 0, 0, 0, time.UTC)},
+		{name: "opening", window: nightly, now: time.Date(2024, 6, 1, 1, 0, 0, 0, time.UTC)},
+		{name: "during", window: nightly, now: time.Date(2024, 6, 1, 2, 59, 0, 0, time.UTC)},
+		{name: "closing", window: nightly, now: time.Date(2024, 6, 1, 3, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC)},
+		{name: "time zone", window: paris, now: time.Date(2024, 6, 1, 0, 30, 0, 0, time.UTC)},
+		{name: "time zone closed", window: paris, now: time.Date(2024, 6, 1, 1, 30, 0, 0, time.UTC),
+			expected: time.Date(2024, 6, 1, 23, 0, 0, 0, time.UTC)},
+		{name: "bad schedule", window: &v1alpha1.MaintenanceWindow{Schedule: "nightly", Duration: metav1.Duration{Duration: time.Hour}},
+			expectError: true},
+		{name: "constant delay", window: &v1alpha1.MaintenanceWindow{Schedule: "@every 1h", Duration: metav1.Duration{Duration: time.Hour}},
+			expectError: true},
+		{name: "no duration", window: &v1alpha1.MaintenanceWindow{Schedule: "@daily"}, expectError: true},
+		{name: "bad time zone", window: &v1alpha1.MaintenanceWindow{Schedule: "@daily", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Nowhere/Special"},
+			expectError: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, err := nextMaintenanceWindowOpening(testCase.window, testCase.now)
+			if testCase.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got opening %v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !actual.Equal(testCase.expected) {
+				t.Errorf("expected opening %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowFromProperties(t *testing.T) {
+	window, err := maintenanceWindowFromProperties(clusterProperties{"clusterName": "c1"})
+	if window != nil || err != nil {
+		t.Errorf("expected no window and no error, got %v and %v", window, err)
+	}
+	window, err = maintenanceWindowFromProperties(clusterProperties{maintenanceScheduleProperty: "@daily"})
+	if err == nil {
+		t.Errorf("expected an error for a missing duration, got window %v", window)
+	}
+	window, err = maintenanceWindowFromProperties(clusterProperties{maintenanceScheduleProperty: "0 22 * * 1-5",
+		maintenanceDurationProperty: "90m", maintenanceTimeZoneProperty: "America/New_York"})
+	expected := &v1alpha1.MaintenanceWindow{Schedule: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 90 * time.Minute}, TimeZone: "America/New_York"}
+	if err != nil || !reflect.DeepEqual(window, expected) {
+		t.Errorf("expected window %v, got %v and error %v", expected, window, err)
+	}
+}
+
+func TestPendingDestinations(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	opening := now.Add(time.Hour)
+	binding := &v1alpha1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "b1", Generation: 2},
+		Spec:       v1alpha1.BindingSpec{Destinations: []v1alpha1.Destination{{ClusterId: "c1"}, {ClusterId: "c2"}, {ClusterId: "c3"}}},
+	}
+	current := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		rolloutTestWrappedObject("c1", 2, now),
+		rolloutTestWrappedObject("c2", 1, now),
+		rolloutTestWrappedObject("c4", 1, now),
+		rolloutTestWrappedObject("c5", 1, now),
+	}}
+	closed := map[string]time.Time{"c1": opening, "c2": opening, "c3": opening, "c4": opening}
+	actual := pendingDestinations(binding, rolloutTestDesired(2), current, closed)
+	expected := []v1alpha1.PendingDestination{
+		{ClusterId: "c2", PendingUntil: metav1.NewTime(opening)},
+		{ClusterId: "c3", PendingUntil: metav1.NewTime(opening)},
+		{ClusterId: "c4", PendingUntil: metav1.NewTime(opening)},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+	if earliest := earliestPendingUntil(actual); !earliest.Equal(opening) {
+		t.Errorf("expected earliest opening %v, got %v", opening, earliest)
+	}
+}
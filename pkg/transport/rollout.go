@@ -0,0 +1,323 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"golang.org/x/exp/slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/abstract"
+)
+
+const (
+	// rolloutBatchTimeAnnotation holds the time at which a wrapped object was written
+	// by a rollout, in RFC 3339 format.
+	rolloutBatchTimeAnnotation = "transport.kubestellar.io/rolloutBatchTime"
+
+	// combinedStatusBindingPolicyLabel is the label of a CombinedStatus object that
+	// holds the name of its BindingPolicy, which is also the name of the Binding.
+	combinedStatusBindingPolicyLabel = "status.kubestellar.io/binding-policy"
+
+	defaultRolloutClusterColumn = "cluster"
+
+	// celRowKey is the name of the CEL variable that holds the row being checked.
+	celRowKey = "row"
+)
+
+// rolloutDecision is what to do about the rollout of a Binding, in one sync of it.
+type rolloutDecision struct {
+	// updatable holds the destinations whose wrapped objects may be written now.
+	// A nil set means all destinations.
+	updatable sets.Set[v1alpha1.Destination]
+
+	// condition is the RolledOut condition to report; nil means none.
+	condition *v1alpha1.BindingPolicyCondition
+
+	// requeueAfter, when positive, is when to reconsider the rollout.
+	requeueAfter time.Duration
+}
+
+func (decision rolloutDecision) allows(destination v1alpha1.Destination) bool {
+	return decision.updatable == nil || decision.updatable.Has(destination)
+}
+
+// decideRollout decides how far the rollout of the given Binding may proceed at the given time.
+// A destination is updated when its current wrapped objects are the desired ones, with the desired content.
+// `*binding` and `*currentWrappedObjectList` are immutable.
+func (c *genericTransportController) decideRollout(binding *v1alpha1.Binding, destToDesiredWrappedObject func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool),
+	currentWrappedObjectList *unstructured.UnstructuredList, now time.Time) rolloutDecision {
+	strategy := binding.Spec.RolloutStrategy
+	if strategy == nil {
+		return rolloutDecision{}
+	}
+	updated, lastBatch := updatedDestinations(binding, destToDesiredWrappedObject, currentWrappedObjectList)
+	total := len(binding.Spec.Destinations)
+	decide := func(updatable sets.Set[v1alpha1.Destination], condition v1alpha1.BindingPolicyCondition, requeueAfter time.Duration) rolloutDecision {
+		return rolloutDecision{updatable: updatable, condition: &condition, requeueAfter: requeueAfter}
+	}
+	if updated.Len() == total {
+		return decide(updated, v1alpha1.ConditionRolloutComplete(), 0)
+	}
+	if halted := haltedRolloutCondition(binding); halted != nil {
+		return decide(updated, *halted, 0)
+	}
+	pause := durationOrZero(strategy.Pause)
+	if updated.Len() > 0 {
+		if wait := lastBatch.Add(pause).Sub(now); wait > 0 {
+			return decide(updated, v1alpha1.ConditionRolloutProgressing(
+				fmt.Sprintf("%d of %d destinations updated, pausing before the next batch", updated.Len(), total)), wait)
+		}
+		if healthCheck := strategy.HealthCheck; healthCheck != nil {
+			if healthy, reason := c.rolloutHealthy(binding, healthCheck, updated); !healthy {
+				var requeueAfter time.Duration
+				if healthCheck.Timeout != nil {
+					deadline := lastBatch.Add(pause + healthCheck.Timeout.Duration)
+					if !now.Before(deadline) {
+						return decide(updated, v1alpha1.ConditionRolloutHalted(
+							fmt.Sprintf("%d of %d destinations updated, health check not passed within %s: %s", updated.Len(), total, healthCheck.Timeout.Duration, reason)), 0)
+					}
+					requeueAfter = deadline.Sub(now)
+				}
+				return decide(updated, v1alpha1.ConditionRolloutProgressing(
+					fmt.Sprintf("%d of %d destinations updated, waiting for health check: %s", updated.Len(), total, reason)), requeueAfter)
+			}
+		}
+	}
+	updatable := updated.Clone()
+	batchSize := rolloutBatchSize(strategy, total)
+	for _, destination := range binding.Spec.Destinations {
+		if updatable.Len() >= updated.Len()+batchSize {
+			break
+		}
+		updatable.Insert(destination)
+	}
+	return decide(updatable, v1alpha1.ConditionRolloutProgressing(
+		fmt.Sprintf("%d of %d destinations updated, updating %d more", updated.Len(), total, updatable.Len()-updated.Len())), pause)
+}
+
+// updatedDestinations returns the destinations of the given Binding whose current wrapped
+// objects are exactly the desired ones, by name and content, and the latest time at which
+// one of those wrapped objects was written by a rollout.
+// A destination that gets no wrapped object is updated once it has none.
+func updatedDestinations(binding *v1alpha1.Binding, destToDesiredWrappedObject func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool),
+	currentWrappedObjectList *unstructured.UnstructuredList) (sets.Set[v1alpha1.Destination], time.Time) {
+	current := map[string]map[string]*unstructured.Unstructured{} // namespace -> name -> wrapped object
+	for idx := range currentWrappedObjectList.Items {
+		wrappedObject := &currentWrappedObjectList.Items[idx]
+		namespace := wrappedObject.GetNamespace()
+		if current[namespace] == nil {
+			current[namespace] = map[string]*unstructured.Unstructured{}
+		}
+		current[namespace][wrappedObject.GetName()] = wrappedObject
+	}
+	var lastBatch time.Time
+	updated := sets.New[v1alpha1.Destination]()
+	for _, destination := range binding.Spec.Destinations {
+		var desiredWrappedObjects []*unstructured.Unstructured
+		if destToDesiredWrappedObject != nil {
+			desiredWrappedObjects, _ = destToDesiredWrappedObject(destination)
+		}
+		currentWrappedObjects := current[destination.ClusterId]
+		if len(currentWrappedObjects) != len(desiredWrappedObjects) {
+			continue
+		}
+		upToDate := true
+		for _, desiredWrappedObject := range desiredWrappedObjects {
+			upToDate = upToDate && sameContent(currentWrappedObjects[desiredWrappedObject.GetName()], desiredWrappedObject)
+		}
+		if !upToDate {
+			continue
+		}
+		updated.Insert(destination)
+		for _, wrappedObject := range currentWrappedObjects {
+			batchTime, err := time.Parse(time.RFC3339, wrappedObject.GetAnnotations()[rolloutBatchTimeAnnotation])
+			if err == nil && batchTime.After(lastBatch) {
+				lastBatch = batchTime
+			}
+		}
+	}
+	return updated, lastBatch
+}
+
+// haltedRolloutCondition returns the RolledOut condition of the given Binding
+// if it says that the rollout of the current generation has halted, otherwise nil.
+func haltedRolloutCondition(binding *v1alpha1.Binding) *v1alpha1.BindingPolicyCondition {
+	if binding.Status.ObservedGeneration != binding.Generation {
+		return nil
+	}
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == v1alpha1.TypeRolledOut && condition.Reason == v1alpha1.ReasonRolloutHalted {
+			return &condition
+		}
+	}
+	return nil
+}
+
+// rolloutBatchSize returns the number of destinations to update in each batch.
+func rolloutBatchSize(strategy *v1alpha1.RolloutStrategy, numDestinations int) int {
+	switch {
+	case strategy.BatchSize > 0:
+		return int(strategy.BatchSize)
+	case strategy.BatchPercentage > 0:
+		size := (numDestinations*int(strategy.BatchPercentage) + 99) / 100
+		return max(size, 1)
+	default:
+		return 1
+	}
+}
+
+func durationOrZero(duration *metav1.Duration) time.Duration {
+	if duration == nil {
+		return 0
+	}
+	return duration.Duration
+}
+
+// rolloutConditions returns a copy of the given conditions with the RolledOut
+// condition set to the given one, or removed if the given one is nil.
+func rolloutConditions(conditions []v1alpha1.BindingPolicyCondition, rolledOut *v1alpha1.BindingPolicyCondition) []v1alpha1.BindingPolicyCondition {
+	conditions = abstract.SliceCopy(conditions)
+	if rolledOut == nil {
+		return v1alpha1.RemoveCondition(conditions, v1alpha1.TypeRolledOut)
+	}
+	return v1alpha1.SetCondition(conditions, *rolledOut)
+}
+
+// rolloutHealthy tells whether the given updated destinations pass the given health check.
+// When they do not, the returned string says why.
+// `*binding` and `*healthCheck` are immutable.
+func (c *genericTransportController) rolloutHealthy(binding *v1alpha1.Binding, healthCheck *v1alpha1.RolloutHealthCheck,
+	updated sets.Set[v1alpha1.Destination]) (bool, string) {
+	program, err := compileRolloutCondition(healthCheck.Condition)
+	if err != nil {
+		return false, err.Error()
+	}
+	clusterColumn := healthCheck.ClusterColumn
+	if clusterColumn == "" {
+		clusterColumn = defaultRolloutClusterColumn
+	}
+	combinedStatuses, err := c.combinedStatusLister.List(labels.SelectorFromSet(labels.Set{combinedStatusBindingPolicyLabel: binding.Name}))
+	if err != nil {
+		return false, fmt.Sprintf("failed to list CombinedStatus objects: %s", err)
+	}
+	reported := sets.New[string]()
+	for _, combinedStatus := range combinedStatuses {
+		for _, result := range combinedStatus.Results {
+			if result.Name != healthCheck.StatusCollector {
+				continue
+			}
+			clusterIdx := slices.Index(result.ColumnNames, clusterColumn)
+			if clusterIdx < 0 {
+				return false, fmt.Sprintf("the results of StatusCollector %q have no column %q", healthCheck.StatusCollector, clusterColumn)
+			}
+			for _, row := range result.Rows {
+				if len(row.Columns) != len(result.ColumnNames) {
+					continue
+				}
+				cluster := row.Columns[clusterIdx].String
+				if cluster == nil || !updated.Has(v1alpha1.Destination{ClusterId: *cluster}) {
+					continue
+				}
+				rowMap := map[string]interface{}{}
+				for idx, name := range result.ColumnNames {
+					rowMap[name] = valueToInterface(row.Columns[idx])
+				}
+				out, _, err := program.Eval(map[string]interface{}{celRowKey: rowMap})
+				if err != nil {
+					return false, fmt.Sprintf("failed to evaluate condition for destination %q in CombinedStatus %s/%s: %s", *cluster, combinedStatus.Namespace, combinedStatus.Name, err)
+				}
+				if passed, is := out.Value().(bool); !is || !passed {
+					return false, fmt.Sprintf("condition not met for destination %q in CombinedStatus %s/%s", *cluster, combinedStatus.Namespace, combinedStatus.Name)
+				}
+				reported.Insert(*cluster)
+			}
+		}
+	}
+	for _, destination := range binding.Spec.Destinations {
+		if updated.Has(destination) && !reported.Has(destination.ClusterId) {
+			return false, fmt.Sprintf("no results of StatusCollector %q for destination %q yet", healthCheck.StatusCollector, destination.ClusterId)
+		}
+	}
+	return true, ""
+}
+
+// compileRolloutCondition compiles the `condition` of a RolloutHealthCheck,
+// which must evaluate to a boolean.
+func compileRolloutCondition(condition v1alpha1.Expression) (cel.Program, error) {
+	env, err := cel.NewEnv(cel.Declarations(decls.NewVar(celRowKey, decls.NewMapType(decls.String, decls.Dyn))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(string(condition))
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid health check condition: %w", issues.Err())
+	}
+	if outType := ast.OutputType(); outType != cel.BoolType && outType != cel.DynType {
+		return nil, fmt.Errorf("invalid health check condition: it evaluates to %s rather than bool", outType)
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid health check condition: %w", err)
+	}
+	return program, nil
+}
+
+// valueToInterface converts a column value of a CombinedStatus into the Go
+// representation of the corresponding JSON value.
+func valueToInterface(value v1alpha1.Value) interface{} {
+	switch value.Type {
+	case v1alpha1.TypeString:
+		if value.String != nil {
+			return *value.String
+		}
+	case v1alpha1.TypeBool:
+		if value.Bool != nil {
+			return *value.Bool
+		}
+	case v1alpha1.TypeNumber:
+		if value.Number != nil {
+			if asInt, err := strconv.ParseInt(*value.Number, 10, 64); err == nil {
+				return asInt
+			}
+			if asFloat, err := strconv.ParseFloat(*value.Number, 64); err == nil {
+				return asFloat
+			}
+		}
+	case v1alpha1.TypeObject, v1alpha1.TypeArray:
+		raw := value.Object
+		if value.Type == v1alpha1.TypeArray {
+			raw = value.Array
+		}
+		var decoded interface{}
+		if raw != nil && json.Unmarshal(raw.Raw, &decoded) == nil {
+			return decoded
+		}
+	}
+	return nil
+}
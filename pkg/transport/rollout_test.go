@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	controlv1alpha1listers "github.com/kubestellar/kubestellar/pkg/generated/listers/control/v1alpha1"
+)
+
+// rolloutTestWrappedObject makes a current wrapped object whose content hash is the given version.
+func rolloutTestWrappedObject(namespace string, version int, batchTime time.Time) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetNamespace(namespace)
+	obj.SetName("b1-wds1")
+	obj.SetAnnotations(map[string]string{
+		contentHashAnnotation:      strconv.Itoa(version),
+		rolloutBatchTimeAnnotation: batchTime.UTC().Format(time.RFC3339),
+	})
+	return obj
+}
+
+// rolloutTestDesired returns a func that gives every destination one wrapped object,
+// whose content hash is the given version, except the given destinations that get none.
+func rolloutTestDesired(version int, excluded ...string) func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool) {
+	return func(destination v1alpha1.Destination) ([]*unstructured.Unstructured, bool) {
+		if slices.Contains(excluded, destination.ClusterId) {
+			return nil, true
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetName("b1-wds1")
+		obj.SetAnnotations(map[string]string{contentHashAnnotation: strconv.Itoa(version)})
+		return []*unstructured.Unstructured{obj}, true
+	}
+}
+
+func rolloutTestCombinedStatus(clusterStates map[string]string) *v1alpha1.CombinedStatus {
+	result := v1alpha1.NamedStatusCombination{Name: "health", ColumnNames: []string{"cluster", "state"}}
+	for cluster, state := range clusterStates {
+		cluster, state := cluster, state
+		result.Rows = append(result.Rows, v1alpha1.StatusCombinationRow{Columns: []v1alpha1.Value{
+			{Type: v1alpha1.TypeString, String: &cluster},
+			{Type: v1alpha1.TypeString, String: &state},
+		}})
+	}
+	return &v1alpha1.CombinedStatus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cs1",
+			Labels: map[string]string{combinedStatusBindingPolicyLabel: "b1"}},
+		Results: []v1alpha1.NamedStatusCombination{result},
+	}
+}
+
+func TestDecideRollout(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	destinations := []v1alpha1.Destination{{ClusterId: "c1"}, {ClusterId: "c2"}, {ClusterId: "c3"}, {ClusterId: "c4"}}
+	healthCheck := &v1alpha1.RolloutHealthCheck{StatusCollector: "health", Condition: "row.state == 'ok'",
+		Timeout: &metav1.Duration{Duration: 10 * time.Minute}}
+	testCases := []struct {
+		name            string
+		strategy        *v1alpha1.RolloutStrategy
+		current         []unstructured.Unstructured
+		clusterStates   map[string]string
+		expectUpdatable []string
+		expectReason    v1alpha1.ConditionReason
+		expectRequeue   time.Duration
+	}{
+		{name: "no strategy", expectUpdatable: []string{"c1", "c2", "c3", "c4"}},
+		{name: "first batch", strategy: &v1alpha1.RolloutStrategy{BatchSize: 2},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 1, now), rolloutTestWrappedObject("c3", 1, now)},
+			expectUpdatable: []string{"c1", "c2"}, expectReason: v1alpha1.ReasonRolloutProgressing},
+		{name: "pausing", strategy: &v1alpha1.RolloutStrategy{BatchPercentage: 25, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now.Add(-time.Minute))},
+			expectUpdatable: []string{"c1"}, expectReason: v1alpha1.ReasonRolloutProgressing, expectRequeue: 4 * time.Minute},
+		{name: "pause elapsed", strategy: &v1alpha1.RolloutStrategy{BatchPercentage: 25, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now.Add(-6*time.Minute))},
+			expectUpdatable: []string{"c1", "c2"}, expectReason: v1alpha1.ReasonRolloutProgressing, expectRequeue: 5 * time.Minute},
+		{name: "unhealthy", strategy: &v1alpha1.RolloutStrategy{HealthCheck: healthCheck},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now.Add(-time.Minute))},
+			clusterStates:   map[string]string{"c1": "bad"},
+			expectUpdatable: []string{"c1"}, expectReason: v1alpha1.ReasonRolloutProgressing, expectRequeue: 9 * time.Minute},
+		{name: "not reported", strategy: &v1alpha1.RolloutStrategy{HealthCheck: healthCheck},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now.Add(-time.Minute))},
+			expectUpdatable: []string{"c1"}, expectReason: v1alpha1.ReasonRolloutProgressing, expectRequeue: 9 * time.Minute},
+		{name: "healthy", strategy: &v1alpha1.RolloutStrategy{HealthCheck: healthCheck},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now.Add(-time.Minute))},
+			clusterStates:   map[string]string{"c1": "ok", "c2": "bad"},
+			expectUpdatable: []string{"c1", "c2"}, expectReason: v1alpha1.ReasonRolloutProgressing},
+		{name: "timed out", strategy: &v1alpha1.RolloutStrategy{HealthCheck: healthCheck},
+			current:         []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now.Add(-time.Hour))},
+			clusterStates:   map[string]string{"c1": "bad"},
+			expectUpdatable: []string{"c1"}, expectReason: v1alpha1.ReasonRolloutHalted},
+		{name: "complete", strategy: &v1alpha1.RolloutStrategy{BatchSize: 1},
+			current: []unstructured.Unstructured{rolloutTestWrappedObject("c1", 2, now), rolloutTestWrappedObject("c2", 2, now),
+				rolloutTestWrappedObject("c3", 2, now), rolloutTestWrappedObject("c4", 2, now)},
+			expectUpdatable: []string{"c1", "c2", "c3", "c4"}, expectReason: v1alpha1.ReasonRolloutComplete},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if testCase.clusterStates != nil {
+				if err := indexer.Add(rolloutTestCombinedStatus(testCase.clusterStates)); err != nil {
+					t.Fatalf("failed to add CombinedStatus: %v", err)
+				}
+			}
+			ctlr := &genericTransportController{combinedStatusLister: controlv1alpha1listers.NewCombinedStatusLister(indexer)}
+			binding := &v1alpha1.Binding{
+				ObjectMeta: metav1.ObjectMeta{Name: "b1", Generation: 2},
+				Spec:       v1alpha1.BindingSpec{Destinations: destinations, RolloutStrategy: testCase.strategy},
+			}
+			decision := ctlr.decideRollout(binding, rolloutTestDesired(2), &unstructured.UnstructuredList{Items: testCase.current}, now)
+			for _, destination := range destinations {
+				expected := false
+				for _, clusterId := range testCase.expectUpdatable {
+					expected = expected || clusterId == destination.ClusterId
+				}
+				if actual := decision.allows(destination); actual != expected {
+					t.Errorf("destination %q: expected allowed=%v, got %v", destination.ClusterId, expected, actual)
+				}
+			}
+			var reason v1alpha1.ConditionReason
+			if decision.condition != nil {
+				reason = decision.condition.Reason
+			}
+			if reason != testCase.expectReason {
+				t.Errorf("expected reason %q, got %q (condition=%+v)", testCase.expectReason, reason, decision.condition)
+			}
+			if decision.requeueAfter != testCase.expectRequeue {
+				t.Errorf("expected requeueAfter %s, got %s", testCase.expectRequeue, decision.requeueAfter)
+			}
+		})
+	}
+}
+
+func TestRolloutBatchSize(t *testing.T) {
+	for _, testCase := range []struct {
+		strategy v1alpha1.RolloutStrategy
+		total    int
+		expected int
+	}{
+		{strategy: v1alpha1.RolloutStrategy{}, total: 10, expected: 1},
+		{strategy: v1alpha1.RolloutStrategy{BatchSize: 3}, total: 10, expected: 3},
+		{strategy: v1alpha1.RolloutStrategy{BatchPercentage: 25}, total: 10, expected: 3},
+		{strategy: v1alpha1.RolloutStrategy{BatchPercentage: 10}, total: 3, expected: 1},
+	} {
+		if actual := rolloutBatchSize(&testCase.strategy, testCase.total); actual != testCase.expected {
+			t.Errorf("strategy %+v with %d destinations: expected %d, got %d", testCase.strategy, testCase.total, testCase.expected, actual)
+		}
+	}
+}
+
+func TestUpdatedDestinations(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	binding := &v1alpha1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "b1", Generation: 2},
+		Spec: v1alpha1.BindingSpec{Destinations: []v1alpha1.Destination{
+			{ClusterId: "c1"}, {ClusterId: "c2"}, {ClusterId: "c3"}, {ClusterId: "c4"}, {ClusterId: "c5"}}},
+	}
+	stale := rolloutTestWrappedObject("c5", 2, now)
+	stale.SetName("b1-wds1-old")
+	current := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		rolloutTestWrappedObject("c1", 2, now.Add(-time.Minute)), // up to date
+		rolloutTestWrappedObject("c2", 1, now),                   // content differs
+		rolloutTestWrappedObject("c4", 2, now),                   // excluded, still present
+		rolloutTestWrappedObject("c5", 2, now), stale,            // has a wrapped object that is no longer desired
+	}}
+	updated, lastBatch := updatedDestinations(binding, rolloutTestDesired(2, "c3", "c4"), current)
+	if expected := sets.New(v1alpha1.Destination{ClusterId: "c1"}, v1alpha1.Destination{ClusterId: "c3"}); !updated.Equal(expected) {
+		t.Errorf("expected updated %v, got %v", expected.UnsortedList(), updated.UnsortedList())
+	}
+	if !lastBatch.Equal(now.Add(-time.Minute)) {
+		t.Errorf("expected last batch at %v, got %v", now.Add(-time.Minute), lastBatch)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// Ranks of kinds within a sync wave, in order of delivery
+const (
+	kindRankDefinitions = iota // Namespaces and CRDs
+	kindRankConfig             // things that workloads refer to
+	kindRankWorkloads
+	kindRankOther // including custom resources
+)
+
+var builtinKindRanks = map[schema.GroupKind]int{
+	{Group: "", Kind: "Namespace"}:                                   kindRankDefinitions,
+	{Group: "apiextensions.k8s.io", Kind: util.CRDKind}:              kindRankDefinitions,
+	{Group: "", Kind: "ServiceAccount"}:                              kindRankConfig,
+	{Group: "", Kind: "ConfigMap"}:                                   kindRankConfig,
+	{Group: "", Kind: "Secret"}:                                      kindRankConfig,
+	{Group: "", Kind: "PersistentVolumeClaim"}:                       kindRankConfig,
+	{Group: "", Kind: "LimitRange"}:                                  kindRankConfig,
+	{Group: "", Kind: "ResourceQuota"}:                               kindRankConfig,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:        kindRankConfig,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}: kindRankConfig,
+	{Group: "rbac.authorization.k8s.io", Kind: "Role"}:               kindRankConfig,
+	{Group: "rbac.authorization.k8s.io", Kind: "RoleBinding"}:        kindRankConfig,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                  kindRankConfig,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:              kindRankConfig,
+	{Group: "", Kind: "Service"}:                                     kindRankWorkloads,
+	{Group: "", Kind: "Pod"}:                                         kindRankWorkloads,
+	{Group: "", Kind: "ReplicationController"}:                       kindRankWorkloads,
+	{Group: "apps", Kind: "Deployment"}:                              kindRankWorkloads,
+	{Group: "apps", Kind: "ReplicaSet"}:                              kindRankWorkloads,
+	{Group: "apps", Kind: "StatefulSet"}:                             kindRankWorkloads,
+	{Group: "apps", Kind: "DaemonSet"}:                               kindRankWorkloads,
+	{Group: "batch", Kind: "Job"}:                                    kindRankWorkloads,
+	{Group: "batch", Kind: "CronJob"}:                                kindRankWorkloads,
+	{Group: "networking.k8s.io", Kind: "Ingress"}:                    kindRankWorkloads,
+	{Group: "networking.k8s.io", Kind: "NetworkPolicy"}:              kindRankWorkloads,
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}:          kindRankWorkloads,
+	{Group: "policy", Kind: "PodDisruptionBudget"}:                   kindRankWorkloads,
+}
+
+func kindRank(obj *unstructured.Unstructured) int {
+	if rank, have := builtinKindRanks[obj.GroupVersionKind().GroupKind()]; have {
+		return rank
+	}
+	return kindRankOther
+}
+
+// syncWave is the objects of one sync wave, in delivery order.
+type syncWave struct {
+	wave    int
+	objects []*unstructured.Unstructured
+}
+
+// orderBySyncWave returns the given objects sorted into delivery order:
+// by sync wave, then by kind rank; otherwise the given order is preserved.
+// The returned strings describe the invalid sync wave annotations;
+// an object with an invalid annotation is put in wave 0.
+// The given slice is not mutated.
+func orderBySyncWave(objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, []string) {
+	type objectWithRank struct {
+		obj  *unstructured.Unstructured
+		wave int
+		rank int
+	}
+	errs := []string{}
+	ranked := make([]objectWithRank, len(objects))
+	for idx, obj := range objects {
+		wave, err := syncWaveOf(obj)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("object %s: %s", util.RefToRuntimeObj(obj), err.Error()))
+		}
+		ranked[idx] = objectWithRank{obj: obj, wave: wave, rank: kindRank(obj)}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].wave != ranked[j].wave {
+			return ranked[i].wave < ranked[j].wave
+		}
+		return ranked[i].rank < ranked[j].rank
+	})
+	ordered := make([]*unstructured.Unstructured, len(ranked))
+	for idx, item := range ranked {
+		ordered[idx] = item.obj
+	}
+	return ordered, errs
+}
+
+// splitSyncWaves splits the given objects, which are in the order returned by
+// orderBySyncWave, into their sync waves.
+func splitSyncWaves(objects []*unstructured.Unstructured) []syncWave {
+	waves := []syncWave{}
+	for _, obj := range objects {
+		wave, _ := syncWaveOf(obj)
+		if len(waves) == 0 || waves[len(waves)-1].wave != wave {
+			waves = append(waves, syncWave{wave: wave})
+		}
+		last := &waves[len(waves)-1]
+		last.objects = append(last.objects, obj)
+	}
+	return waves
+}
+
+// syncWaveOf returns the sync wave of the given object, which is 0 if
+// the annotation is absent or invalid.
+func syncWaveOf(obj *unstructured.Unstructured) (int, error) {
+	value, have := obj.GetAnnotations()[v1alpha1.SyncWaveAnnotationKey]
+	if !have {
+		return 0, nil
+	}
+	wave, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q of annotation %s, it must be an integer", value, v1alpha1.SyncWaveAnnotationKey)
+	}
+	return wave, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	workapi "open-cluster-management.io/api/work/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/klog/v2/ktesting"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+)
+
+func syncWaveTestObject(apiVersion, kind, name, wave string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	if wave != "" {
+		obj.SetAnnotations(map[string]string{v1alpha1.SyncWaveAnnotationKey: wave})
+	}
+	return obj
+}
+
+func objectNames(objects []*unstructured.Unstructured) []string {
+	names := make([]string, len(objects))
+	for idx, obj := range objects {
+		names[idx] = obj.GetName()
+	}
+	return names
+}
+
+func TestOrderBySyncWave(t *testing.T) {
+	objects := []*unstructured.Unstructured{
+		syncWaveTestObject("example.com/v1", "Widget", "widget", ""),
+		syncWaveTestObject("apps/v1", "Deployment", "deploy", ""),
+		syncWaveTestObject("v1", "ConfigMap", "late-config", "1"),
+		syncWaveTestObject("v1", "ConfigMap", "config", ""),
+		syncWaveTestObject("apiextensions.k8s.io/v1", "CustomResourceDefinition", "crd", ""),
+		syncWaveTestObject("v1", "Secret", "early-secret", "-1"),
+		syncWaveTestObject("v1", "Namespace", "ns", ""),
+		syncWaveTestObject("v1", "ConfigMap", "bad", "soon"),
+	}
+	ordered, errs := orderBySyncWave(objects)
+	expected := []string{"early-secret", "crd", "ns", "config", "bad", "deploy", "widget", "late-config"}
+	if actual := objectNames(ordered); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected order %v, got %v", expected, actual)
+	}
+	if len(errs) != 1 {
+		t.Errorf("expected one error, got %v", errs)
+	}
+	if objects[0].GetName() != "widget" {
+		t.Error("the given slice was mutated")
+	}
+
+	waves := splitSyncWaves(ordered)
+	expectedWaves := []int{-1, 0, 1}
+	if len(waves) != len(expectedWaves) {
+		t.Fatalf("expected %d waves, got %d", len(expectedWaves), len(waves))
+	}
+	for idx, wave := range waves {
+		if wave.wave != expectedWaves[idx] {
+			t.Errorf("wave %d: expected number %d, got %d", idx, expectedWaves[idx], wave.wave)
+		}
+	}
+	if actual := objectNames(waves[1].objects); !reflect.DeepEqual(actual, expected[1:7]) {
+		t.Errorf("expected wave 0 to be %v, got %v", expected[1:7], actual)
+	}
+}
+
+// stagedTestTransport wraps objects in a List and asks for staged application.
+type stagedTestTransport struct{}
+
+func (stagedTestTransport) WrapObjects(objects []*unstructured.Unstructured) runtime.Object {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind("List")
+	for _, obj := range objects {
+		list.Items = append(list.Items, *obj)
+	}
+	return &unstructured.Unstructured{Object: list.UnstructuredContent()}
+}
+
+func (stagedTestTransport) WantsStagedApplication() bool { return true }
+
+func TestWrapStaged(t *testing.T) {
+	ctlr := &genericTransportController{transport: stagedTestTransport{}, wdsName: "wds1", MaxSizeWrappedObject: 1024 * 1024}
+	binding := &v1alpha1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "b1", Generation: 3}}
+	ordered, _ := orderBySyncWave([]*unstructured.Unstructured{
+		syncWaveTestObject("apps/v1", "Deployment", "deploy", "1"),
+		syncWaveTestObject("v1", "Namespace", "ns", ""),
+		syncWaveTestObject("v1", "ConfigMap", "config", ""),
+	})
+	wrapped, err := ctlr.wrap(ordered, nil, binding)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []struct {
+		name    string
+		wave    string
+		objects []string
+	}{
+		{name: "b1-wds1-w0", wave: "0", objects: []string{"ns", "config"}},
+		{name: "b1-wds1-w1", wave: "1", objects: []string{"deploy"}},
+	}
+	if len(wrapped) != len(expected) {
+		t.Fatalf("expected %d wrapped objects, got %d", len(expected), len(wrapped))
+	}
+	for idx, exp := range expected {
+		actual := wrapped[idx]
+		if actual.GetName() != exp.name {
+			t.Errorf("wrapped object %d: expected name %q, got %q", idx, exp.name, actual.GetName())
+		}
+		annotations := actual.GetAnnotations()
+		if annotations[v1alpha1.SyncWaveAnnotationKey] != exp.wave {
+			t.Errorf("wrapped object %d: expected wave %q, got %q", idx, exp.wave, annotations[v1alpha1.SyncWaveAnnotationKey])
+		}
+		if seq := annotations[sequenceAnnotation]; seq != strconv.Itoa(idx) {
+			t.Errorf("wrapped object %d: expected sequence %d, got %q", idx, idx, seq)
+		}
+		items, _, _ := unstructured.NestedSlice(actual.Object, "items")
+		names := []string{}
+		for _, item := range items {
+			names = append(names, item.(map[string]interface{})["metadata"].(map[string]interface{})["name"].(string))
+		}
+		if !reflect.DeepEqual(names, exp.objects) {
+			t.Errorf("wrapped object %d: expected objects %v, got %v", idx, exp.objects, names)
+		}
+	}
+}
+
+func TestPropagateDeletesStaleWaves(t *testing.T) {
+	logger, ctx := ktesting.NewTestContext(t)
+	scheme := runtime.NewScheme()
+	workapi.AddToScheme(scheme)
+	shard := &itsShard{name: "its1", transportClient: dynamicfake.NewSimpleDynamicClient(scheme)}
+	ctlr := &genericTransportController{logger: logger, shards: []*itsShard{shard}, wrappedObjectGVR: workapi.GroupVersion.WithResource("manifestworks"), wdsName: "wds1"}
+	wrappedObject := func(name string) *unstructured.Unstructured {
+		obj := syncWaveTestObject(workapi.GroupVersion.String(), "ManifestWork", name, "")
+		obj.SetNamespace("wec1")
+		obj.SetAnnotations(map[string]string{contentHashAnnotation: "h1"})
+		return obj
+	}
+	current := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*wrappedObject("b1-wds1-w0"), *wrappedObject("b1-wds1-w1")}}
+	desired := []*unstructured.Unstructured{wrappedObject("b1-wds1-w0"), wrappedObject("b1-wds1-w2")}
+	destinations := []v1alpha1.Destination{{ClusterId: "wec1"}}
+	err := ctlr.propagateWrappedObjectToClusters(ctx, func(v1alpha1.Destination) ([]*unstructured.Unstructured, bool) { return desired, true },
+		current, destinations, false, rolloutDecision{}, nil, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(current.Items) != 1 || current.Items[0].GetName() != "b1-wds1-w1" {
+		t.Errorf("expected only b1-wds1-w1 to be left for deletion, got %v", current.Items)
+	}
+	client := shard.transportClient.Resource(ctlr.wrappedObjectGVR).Namespace("wec1")
+	if _, err := client.Get(ctx, "b1-wds1-w2", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected b1-wds1-w2 to be created: %v", err)
+	}
+	if _, err := client.Get(ctx, "b1-wds1-w0", metav1.GetOptions{}); err == nil {
+		t.Errorf("expected the up-to-date b1-wds1-w0 to be left alone")
+	}
+}
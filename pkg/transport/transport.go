@@ -49,16 +49,50 @@ type TransportWithCreateOnly interface {
 	WrapObjectsHavingCreateOnly(objects []Wrapee) runtime.Object
 }
 
-// Wrapee is a workload object to wrap and its associated create-only bit
+// TransportWithStagedApplication is a subtype of Transport for implementations
+// that apply the objects of different sync waves in stages, rather than
+// relying on the order of objects within a wrapped object.
+// When WantsStagedApplication returns true, the objects of each sync wave
+// are given to the Transport separately and so are put in separate wrapped objects,
+// which carry the SyncWaveAnnotationKey annotation with the wave's number.
+// See v1alpha1.SyncWaveAnnotationKey for the definition of sync waves.
+type TransportWithStagedApplication interface {
+	Transport
+
+	// WantsStagedApplication tells whether the sync waves are to be wrapped separately.
+	WantsStagedApplication() bool
+}
+
+// TransportWithOrphan is a subtype of TransportWithCreateOnly for implementations
+// that honor the orphan bit of a Wrapee.
+// For a Wrapee whose orphan bit is set, the wrapped object must tell the agent in the WEC
+// to leave the object in place, no longer managed, when the object is later
+// removed from the wrapped object or the wrapped object is deleted.
+// The generic code refuses to propagate a Binding that calls for orphaning
+// with a Transport that does not honor the orphan bit.
+type TransportWithOrphan interface {
+	TransportWithCreateOnly
+
+	// HonorsOrphan tells whether this Transport honors the orphan bit of Wrapee.
+	HonorsOrphan() bool
+}
+
+// Wrapee is a workload object to wrap and its associated create-only and orphan bits
 type Wrapee struct {
 	Object     *unstructured.Unstructured
 	CreateOnly bool
+
+	// Orphan indicates that the object is to be left in the WEC, rather than deleted,
+	// when it stops being downsynced there.
+	Orphan bool
 }
 
 func (wr Wrapee) GetObject() *unstructured.Unstructured { return wr.Object }
 
 func (wr Wrapee) GetCreateOnly() bool { return wr.CreateOnly }
 
+func (wr Wrapee) GetOrphan() bool { return wr.Orphan }
+
 func NewWrapee(object *unstructured.Unstructured, createOnly bool) Wrapee {
-	return Wrapee{object, createOnly}
+	return Wrapee{Object: object, CreateOnly: createOnly}
 }
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CredentialsPollInterval is how often a kubeconfig file is re-read by PollCredentials.
+const CredentialsPollInterval = 30 * time.Second
+
+// CredentialsReloader is an http.RoundTripper that sends requests using the latest
+// credentials it has been given. The clients and informers made from its RestConfig
+// keep working, with their caches and everything built on them, across a rotation
+// of the credentials.
+// Only the credentials and the TLS configuration are reloaded; a change to the
+// server's address is logged and otherwise ignored.
+type CredentialsReloader struct {
+	logger logr.Logger
+	// base is the config that RestConfig returns, with this as its Transport
+	base *rest.Config
+
+	mutex        sync.RWMutex
+	current      *rest.Config
+	roundTripper http.RoundTripper
+}
+
+var _ http.RoundTripper = &CredentialsReloader{}
+
+// NewCredentialsReloader returns a CredentialsReloader that starts with the given config.
+func NewCredentialsReloader(logger logr.Logger, initial *rest.Config) (*CredentialsReloader, error) {
+	roundTripper, err := rest.TransportFor(initial)
+	if err != nil {
+		return nil, fmt.Errorf("error making transport: %w", err)
+	}
+	cr := &CredentialsReloader{logger: logger, current: initial, roundTripper: roundTripper}
+	base := rest.CopyConfig(initial)
+	base.TLSClientConfig = rest.TLSClientConfig{}
+	base.BearerToken = ""
+	base.BearerTokenFile = ""
+	base.Username = ""
+	base.Password = ""
+	base.Impersonate = rest.ImpersonationConfig{}
+	base.AuthProvider = nil
+	base.AuthConfigPersister = nil
+	base.ExecProvider = nil
+	base.WrapTransport = nil
+	base.Proxy = nil
+	base.Dial = nil
+	base.Transport = cr
+	cr.base = base
+	return cr, nil
+}
+
+// RestConfig returns a config whose requests use the latest credentials.
+func (cr *CredentialsReloader) RestConfig() *rest.Config {
+	return rest.CopyConfig(cr.base)
+}
+
+func (cr *CredentialsReloader) RoundTrip(req *http.Request) (*http.Response, error) {
+	cr.mutex.RLock()
+	roundTripper := cr.roundTripper
+	cr.mutex.RUnlock()
+	return roundTripper.RoundTrip(req)
+}
+
+// Reload switches to the credentials in the given config, if they differ from the current ones.
+func (cr *CredentialsReloader) Reload(fresh *rest.Config) error {
+	cr.mutex.RLock()
+	current := cr.current
+	cr.mutex.RUnlock()
+	if fresh.Host != current.Host {
+		cr.logger.Info("Ignoring change of server address; a restart is needed to use the new one", "old", current.Host, "new", fresh.Host)
+	}
+	if sameCredentials(current, fresh) {
+		return nil
+	}
+	roundTripper, err := rest.TransportFor(fresh)
+	if err != nil {
+		return fmt.Errorf("error making transport: %w", err)
+	}
+	cr.mutex.Lock()
+	old := cr.roundTripper
+	cr.current, cr.roundTripper = fresh, roundTripper
+	cr.mutex.Unlock()
+	utilnet.CloseIdleConnectionsFor(old)
+	cr.logger.Info("Reloaded credentials", "host", current.Host)
+	return nil
+}
+
+func sameCredentials(config1, config2 *rest.Config) bool {
+	return apiequality.Semantic.DeepEqual(config1.TLSClientConfig, config2.TLSClientConfig) &&
+		config1.BearerToken == config2.BearerToken && config1.BearerTokenFile == config2.BearerTokenFile &&
+		config1.Username == config2.Username && config1.Password == config2.Password &&
+		apiequality.Semantic.DeepEqual(config1.Impersonate, config2.Impersonate) &&
+		apiequality.Semantic.DeepEqual(config1.AuthProvider, config2.AuthProvider) &&
+		apiequality.Semantic.DeepEqual(config1.ExecProvider, config2.ExecProvider)
+}
+
+// WatchSecretCredentials watches the given key of the given Secret, which holds a kubeconfig,
+// and has the given CredentialsReloader reload from it when it changes.
+// The watch runs until the given context is done.
+func WatchSecretCredentials(ctx context.Context, clientset kubernetes.Interface, namespace, name, key string, reloader *CredentialsReloader) {
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(clientset, 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}))
+	reload := func(obj any) {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return
+		}
+		fresh, err := restConfigFromBytes(secret.Data[key])
+		if err == nil {
+			err = reloader.Reload(fresh)
+		}
+		if err != nil {
+			reloader.logger.Error(err, "Failed to reload credentials from Secret", "namespace", namespace, "name", name, "key", key)
+		}
+	}
+	informerFactory.Core().V1().Secrets().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    reload,
+		UpdateFunc: func(_, obj any) { reload(obj) },
+	})
+	informerFactory.Start(ctx.Done())
+}
+
+// PollCredentials has the given CredentialsReloader reload from the given loader
+// every CredentialsPollInterval, until the given context is done.
+// This suits credentials that come from files, which are re-read by the loader.
+func PollCredentials(ctx context.Context, load func() (*rest.Config, error), reloader *CredentialsReloader) {
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		fresh, err := load()
+		if err == nil {
+			err = reloader.Reload(fresh)
+		}
+		if err != nil {
+			reloader.logger.Error(err, "Failed to reload credentials")
+		}
+	}, CredentialsPollInterval)
+}
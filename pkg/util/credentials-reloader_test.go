@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2/ktesting"
+)
+
+func TestCredentialsReloader(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	var mutex sync.Mutex
+	var lastAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		lastAuthorization = req.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	reloader, err := NewCredentialsReloader(logger, &rest.Config{Host: server.URL, BearerToken: "token1"})
+	if err != nil {
+		t.Fatalf("failed to make CredentialsReloader: %v", err)
+	}
+	client, err := rest.HTTPClientFor(reloader.RestConfig())
+	if err != nil {
+		t.Fatalf("failed to make HTTP client: %v", err)
+	}
+	expectAuthorization := func(expected string) {
+		t.Helper()
+		resp, err := client.Get(server.URL + "/version")
+		if err != nil {
+			t.Fatalf("failed to GET: %v", err)
+		}
+		resp.Body.Close()
+		mutex.Lock()
+		defer mutex.Unlock()
+		if lastAuthorization != expected {
+			t.Errorf("expected Authorization %q, got %q", expected, lastAuthorization)
+		}
+	}
+	expectAuthorization("Bearer token1")
+	if err := reloader.Reload(&rest.Config{Host: server.URL, BearerToken: "token2"}); err != nil {
+		t.Fatalf("failed to reload: %v", err)
+	}
+	expectAuthorization("Bearer token2")
+}
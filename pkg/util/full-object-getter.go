@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/lru"
+)
+
+// DefaultFullObjectCacheSize is the number of objects that a FullObjectGetter
+// caches when nothing else is called for.
+const DefaultFullObjectCacheSize = 1024
+
+// FullObjectGetter fetches whole objects from a cluster whose informers hold
+// only the metadata of those objects. The most recently fetched objects are
+// cached by resourceVersion, so that an object that has not changed is not
+// fetched again. The cache holds up to its size in whole objects, so its memory
+// grows with both the size and the objects; the least recently used ones are dropped.
+type FullObjectGetter struct {
+	client dynamic.Interface
+	cache  *lru.Cache // ObjectIdentifier -> *unstructured.Unstructured
+}
+
+// NewFullObjectGetter makes a FullObjectGetter that caches up to the given number of objects.
+func NewFullObjectGetter(client dynamic.Interface, cacheSize int) *FullObjectGetter {
+	return &FullObjectGetter{client: client, cache: lru.New(cacheSize)}
+}
+
+// Get returns the identified object, which the caller's informer says is at the
+// given resourceVersion; an empty resourceVersion means the object is fetched.
+// The returned object may be shared, the caller must not mutate it.
+func (getter *FullObjectGetter) Get(ctx context.Context, objIdentifier ObjectIdentifier, resourceVersion string) (*unstructured.Unstructured, error) {
+	if resourceVersion != "" {
+		if cached, found := getter.cache.Get(objIdentifier); found {
+			if obj := cached.(*unstructured.Unstructured); obj.GetResourceVersion() == resourceVersion {
+				return obj, nil
+			}
+		}
+	}
+	var client dynamic.ResourceInterface = getter.client.Resource(objIdentifier.GVR())
+	if namespace := objIdentifier.ObjectName.Namespace; namespace != "" {
+		client = getter.client.Resource(objIdentifier.GVR()).Namespace(namespace)
+	}
+	obj, err := client.Get(ctx, objIdentifier.ObjectName.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			getter.cache.Remove(objIdentifier)
+		}
+		return nil, err
+	}
+	getter.cache.Add(objIdentifier, obj)
+	return obj, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TestFullObjectGetterCachesByResourceVersion tests that an object is fetched
+// again only when its resourceVersion changes.
+func TestFullObjectGetterCachesByResourceVersion(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "ns", "name": "d", "resourceVersion": "1"},
+		"spec":       map[string]interface{}{"replicas": int64(1)},
+	}}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "DeploymentList"}, obj)
+	gets := 0
+	client.PrependReactor("get", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		gets++
+		return false, nil, nil
+	})
+	objIdentifier := ObjectIdentifier{
+		GVK:        schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Resource:   "deployments",
+		ObjectName: cache.NewObjectName("ns", "d"),
+	}
+	getter := NewFullObjectGetter(client, DefaultFullObjectCacheSize)
+	ctx := context.Background()
+
+	for i, resourceVersion := range []string{"1", "1", "", "1"} {
+		got, err := getter.Get(ctx, objIdentifier, resourceVersion)
+		if err != nil {
+			t.Fatalf("get %d failed: %v", i, err)
+		}
+		if got.GetResourceVersion() != "1" {
+			t.Errorf("get %d returned resourceVersion %q, expected 1", i, got.GetResourceVersion())
+		}
+	}
+	if gets != 2 {
+		t.Errorf("expected 2 fetches (the first and the one with no resourceVersion), got %d", gets)
+	}
+
+	obj.SetResourceVersion("2")
+	if err := client.Tracker().Update(gvr, obj, "ns"); err != nil {
+		t.Fatalf("failed to update object: %v", err)
+	}
+	got, err := getter.Get(ctx, objIdentifier, "2")
+	if err != nil {
+		t.Fatalf("get after update failed: %v", err)
+	}
+	if got.GetResourceVersion() != "2" || gets != 3 {
+		t.Errorf("expected a fetch of resourceVersion 2, got resourceVersion %q after %d fetches",
+			got.GetResourceVersion(), gets)
+	}
+}
@@ -55,6 +55,25 @@ func GetITSKubeconfig(logger logr.Logger, itsName string) (*rest.Config, string,
 	return getRestConfig(logger, itsName, ControlPlaneTypeITS)
 }
 
+// GetITSKubeconfigs gets the rest configs, and names, of the ITSes among which the inventory is sharded.
+// An empty list of names means to use the only ITS.
+func GetITSKubeconfigs(logger logr.Logger, itsNames []string) ([]*rest.Config, []string, error) {
+	if len(itsNames) == 0 {
+		itsNames = []string{""}
+	}
+	restConfigs := make([]*rest.Config, len(itsNames))
+	names := make([]string, len(itsNames))
+	for idx, itsName := range itsNames {
+		restConfig, name, err := GetITSKubeconfig(logger, itsName)
+		if err != nil {
+			return nil, nil, err
+		}
+		restConfigs[idx] = restConfig
+		names[idx] = name
+	}
+	return restConfigs, names, nil
+}
+
 // get the rest config for a control plane based on labels and name
 func getRestConfig(logger logr.Logger, cpName, labelValue string) (*rest.Config, string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
@@ -92,8 +111,9 @@ func getRestConfig(logger logr.Logger, cpName, labelValue string) (*rest.Config,
 			targetCP = &list.Items[0]
 			return true, nil
 		}
-		// TODO - we do not allow this case for a WDS as there is a 1:1 relashionship controller:cp for WDS.
-		// Need to revisit for ITS where we can have multiple shards.
+		// We do not allow this case here; a controller-manager that serves
+		// several WDSes watches them instead (see its --multi-wds flag),
+		// and the several ITSes of a sharded inventory are named explicitly (see GetITSKubeconfigs).
 		// Assume we are not waiting for control planes to go away.
 		return true, fmt.Errorf(ErrMultipleControlPlanes, labelValue)
 	})
@@ -112,30 +132,59 @@ func getRestConfig(logger logr.Logger, cpName, labelValue string) (*rest.Config,
 		return nil, "", fmt.Errorf("error creating new clientset: %w", err)
 	}
 
-	if targetCP.Status.SecretRef == nil {
-		return nil, "", fmt.Errorf("access secret reference doesn't exist for %s", targetCP.Name)
+	// The credentials are followed for the life of the process, not just this function call.
+	restConf, err := GetReloadingControlPlaneRestConfig(context.Background(), logger, clientset, targetCP)
+	if err != nil {
+		return nil, "", err
 	}
-	namespace := targetCP.Status.SecretRef.Namespace
-	name := targetCP.Status.SecretRef.Name
-	key := targetCP.Status.SecretRef.InClusterKey
+	return restConf, targetCP.Name, nil
+}
 
-	// determine if the configuration is in-cluster or off-cluster and use related key
-	_, err = rest.InClusterConfig()
-	if err != nil { // off-cluster
-		key = targetCP.Status.SecretRef.Key
+// GetControlPlaneRestConfig returns the rest config for accessing the given control plane,
+// read from its access secret in the hosting cluster.
+func GetControlPlaneRestConfig(ctx context.Context, clientset kubernetes.Interface, cp *kfv1aplha1.ControlPlane) (*rest.Config, error) {
+	if cp.Status.SecretRef == nil {
+		return nil, fmt.Errorf("access secret reference doesn't exist for %s", cp.Name)
 	}
+	namespace := cp.Status.SecretRef.Namespace
+	name := cp.Status.SecretRef.Name
+	key := controlPlaneSecretKey(cp)
 
 	secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return nil, "", fmt.Errorf("error getting secrets: %w", err)
+		return nil, fmt.Errorf("error getting secrets: %w", err)
 	}
 
 	restConf, err := restConfigFromBytes(secret.Data[key])
 	if err != nil {
-		return nil, "", fmt.Errorf("error getting rest config from bytes: %w", err)
+		return nil, fmt.Errorf("error getting rest config from bytes: %w", err)
 	}
+	return restConf, nil
+}
 
-	return restConf, targetCP.Name, nil
+// GetReloadingControlPlaneRestConfig is like GetControlPlaneRestConfig, but the returned config
+// follows rotations of the credentials in the access secret until the given context is done.
+func GetReloadingControlPlaneRestConfig(ctx context.Context, logger logr.Logger, clientset kubernetes.Interface, cp *kfv1aplha1.ControlPlane) (*rest.Config, error) {
+	restConf, err := GetControlPlaneRestConfig(ctx, clientset, cp)
+	if err != nil {
+		return nil, err
+	}
+	reloader, err := NewCredentialsReloader(logger.WithValues("controlPlane", cp.Name), restConf)
+	if err != nil {
+		return nil, err
+	}
+	WatchSecretCredentials(ctx, clientset, cp.Status.SecretRef.Namespace, cp.Status.SecretRef.Name, controlPlaneSecretKey(cp), reloader)
+	return reloader.RestConfig(), nil
+}
+
+// controlPlaneSecretKey returns the key, in the access secret of the given control plane,
+// of the kubeconfig to use from where this process runs.
+func controlPlaneSecretKey(cp *kfv1aplha1.ControlPlane) string {
+	// determine if the configuration is in-cluster or off-cluster and use related key
+	if _, err := rest.InClusterConfig(); err != nil { // off-cluster
+		return cp.Status.SecretRef.Key
+	}
+	return cp.Status.SecretRef.InClusterKey
 }
 
 func restConfigFromBytes(kubeconfig []byte) (*rest.Config, error) {
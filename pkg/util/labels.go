@@ -38,6 +38,12 @@ const (
 	BindingPolicyLabelSingletonStatusValueUnset = "false"
 )
 
+// SingletonStatusKey returns the key by which the singleton status of the identified object,
+// and its singleton label, are sharded among the replicas of the controller manager.
+func SingletonStatusKey(objectIdentifier ObjectIdentifier) string {
+	return objectIdentifier.GVR().String() + "/" + objectIdentifier.ObjectName.String()
+}
+
 func GetBindingPolicyGVR() schema.GroupVersionResource {
 	return v1alpha1.GroupVersion.WithResource(BindingPolicyResource)
 }
@@ -17,6 +17,8 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
+	"path"
 	"strings"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -57,3 +59,111 @@ func addRequiredResourceGroups(allowedResourceGroups sets.Set[string]) {
 	allowedResourceGroups.Insert(v1alpha1.GroupVersion.Group)
 	allowedResourceGroups.Insert(apiextensions.GroupName)
 }
+
+// DefaultExcludedResources lists, in the syntax of NewResourceFilter, the resources
+// that are excluded by default because they should not be delivered to other clusters.
+var DefaultExcludedResources = []string{
+	"core/events",
+	"events.k8s.io/events",
+	"core/nodes",
+	"core/endpoints",
+	"storage.k8s.io/csistoragecapacities",
+	"storage.k8s.io/csinodes",
+	"control.kubestellar.io/workstatuses",
+}
+
+// RequiredResources lists, in the syntax of NewResourceFilter, the resources that
+// pass every ResourceFilter made by NewResourceFilter, because the controllers
+// rely on watching them; e.g., the binding controller learns of new resources
+// from the CustomResourceDefinitions.
+var RequiredResources = []string{
+	"apiextensions.k8s.io/customresourcedefinitions",
+}
+
+// ResourceFilter selects resources by their group and resource names.
+// A resource passes if it matches some Required pattern; or if it matches
+// some Include pattern, or Include is empty, and matches no Exclude pattern.
+type ResourceFilter struct {
+	Required []GroupResourcePattern
+	Include  []GroupResourcePattern
+	Exclude  []GroupResourcePattern
+}
+
+// GroupResourcePattern is a pair of patterns, in the syntax of path.Match,
+// for the group and the resource names.
+type GroupResourcePattern struct {
+	Group    string
+	Resource string
+}
+
+// NewResourceFilter makes a ResourceFilter from the given include and exclude patterns,
+// which lets the RequiredResources pass regardless.
+// Each pattern has the form <group>/<resource>, where both parts use the syntax of path.Match
+// and the core group is written as "core" (or nothing), e.g. "apps/deployments",
+// "core/configmaps", "*.example.com/*".
+func NewResourceFilter(include, exclude []string) (*ResourceFilter, error) {
+	includePatterns, err := parseGroupResourcePatterns(include)
+	if err != nil {
+		return nil, err
+	}
+	excludePatterns, err := parseGroupResourcePatterns(exclude)
+	if err != nil {
+		return nil, err
+	}
+	requiredPatterns, err := parseGroupResourcePatterns(RequiredResources)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceFilter{Required: requiredPatterns, Include: includePatterns, Exclude: excludePatterns}, nil
+}
+
+// DefaultResourceFilter returns the ResourceFilter that excludes just the DefaultExcludedResources.
+func DefaultResourceFilter() *ResourceFilter {
+	filter, err := NewResourceFilter(nil, DefaultExcludedResources)
+	if err != nil {
+		panic(err) // the defaults are well formed
+	}
+	return filter
+}
+
+func parseGroupResourcePatterns(patterns []string) ([]GroupResourcePattern, error) {
+	ans := make([]GroupResourcePattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		group, resource, found := strings.Cut(pattern, "/")
+		if !found || resource == "" {
+			return nil, fmt.Errorf("resource pattern %q is not of the form <group>/<resource>", pattern)
+		}
+		if group == "core" {
+			group = ""
+		}
+		for _, part := range []string{group, resource} {
+			if _, err := path.Match(part, ""); err != nil {
+				return nil, fmt.Errorf("resource pattern %q is malformed: %w", pattern, err)
+			}
+		}
+		ans = append(ans, GroupResourcePattern{Group: group, Resource: resource})
+	}
+	return ans, nil
+}
+
+// Allows tells whether the given resource passes the filter.
+func (filter *ResourceFilter) Allows(group, resource string) bool {
+	if matchesAnyGroupResource(filter.Required, group, resource) {
+		return true
+	}
+	if len(filter.Include) > 0 && !matchesAnyGroupResource(filter.Include, group, resource) {
+		return false
+	}
+	return !matchesAnyGroupResource(filter.Exclude, group, resource)
+}
+
+func matchesAnyGroupResource(patterns []GroupResourcePattern, group, resource string) bool {
+	for _, pattern := range patterns {
+		groupMatches, _ := path.Match(pattern.Group, group)
+		resourceMatches, _ := path.Match(pattern.Resource, resource)
+		if groupMatches && resourceMatches {
+			return true
+		}
+	}
+	return false
+}
@@ -95,3 +95,51 @@ func TestIsAPIGroupAllowed(t *testing.T) {
 		})
 	}
 }
+
+// TestResourceFilter tests the ResourceFilter type
+func TestResourceFilter(t *testing.T) {
+	if _, err := NewResourceFilter([]string{"deployments"}, nil); err == nil {
+		t.Errorf("expected an error for a pattern without a group")
+	}
+	if _, err := NewResourceFilter(nil, []string{"apps/[deployments"}); err == nil {
+		t.Errorf("expected an error for a malformed pattern")
+	}
+	filter, err := NewResourceFilter([]string{"apps/*", "core/configmaps", "*.example.com/*"}, []string{"apps/replicasets", "widgets.example.com/*"})
+	if err != nil {
+		t.Fatalf("failed to make filter: %v", err)
+	}
+	testCases := []struct {
+		group    string
+		resource string
+		expected bool
+	}{
+		{"apps", "deployments", true},
+		{"apps", "replicasets", false},
+		{"", "configmaps", true},
+		{"", "secrets", false},
+		{"gadgets.example.com", "gadgets", true},
+		{"widgets.example.com", "widgets", false},
+	}
+	for _, tc := range testCases {
+		if actual := filter.Allows(tc.group, tc.resource); actual != tc.expected {
+			t.Errorf("for %s/%s expected %v, got %v", tc.group, tc.resource, tc.expected, actual)
+		}
+	}
+	narrowFilter, err := NewResourceFilter([]string{"apps/deployments"}, []string{"apiextensions.k8s.io/*"})
+	if err != nil {
+		t.Fatalf("failed to make filter: %v", err)
+	}
+	if !narrowFilter.Allows("apiextensions.k8s.io", "customresourcedefinitions") {
+		t.Errorf("expected a narrow filter to still allow the required CustomResourceDefinitions")
+	}
+	if narrowFilter.Allows("", "configmaps") {
+		t.Errorf("expected a narrow filter to not allow what it does not include")
+	}
+	defaultFilter := DefaultResourceFilter()
+	if defaultFilter.Allows("", "events") || defaultFilter.Allows("storage.k8s.io", "csinodes") {
+		t.Errorf("expected the default filter to exclude core events and csinodes")
+	}
+	if !defaultFilter.Allows("example.com", "events") || !defaultFilter.Allows("apps", "deployments") {
+		t.Errorf("expected the default filter to allow a CRD named events and deployments")
+	}
+}
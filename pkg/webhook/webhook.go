@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook provides the admission webhooks for the KubeStellar control objects.
+// They reject, at admission time, the mistakes that the controllers would otherwise
+// only report in the status of the objects.
+// The conversion webhook, at the path "/convert", is served along with them;
+// it converts among the versions of the KubeStellar kinds, through v1alpha1.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/abstract"
+	"github.com/kubestellar/kubestellar/pkg/binding"
+	"github.com/kubestellar/kubestellar/pkg/status"
+	"github.com/kubestellar/kubestellar/pkg/transport"
+)
+
+// The paths below are the ones that controller-runtime derives from the group, version and kind.
+
+//+kubebuilder:webhook:path=/validate-control-kubestellar-io-v1alpha1-bindingpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=control.kubestellar.io,resources=bindingpolicies,verbs=create;update,versions=v1alpha1,name=vbindingpolicy.control.kubestellar.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-control-kubestellar-io-v1alpha1-statuscollector,mutating=false,failurePolicy=fail,sideEffects=None,groups=control.kubestellar.io,resources=statuscollectors,verbs=create;update,versions=v1alpha1,name=vstatuscollector.control.kubestellar.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-control-kubestellar-io-v1alpha1-customtransform,mutating=false,failurePolicy=fail,sideEffects=None,groups=control.kubestellar.io,resources=customtransforms,verbs=create;update,versions=v1alpha1,name=vcustomtransform.control.kubestellar.io,admissionReviewVersions=v1
+
+// SetupWithManager registers the validating webhooks for BindingPolicy,
+// StatusCollector and CustomTransform with the webhook server of the given manager.
+// The conversion webhook is registered too, when the scheme of the manager
+// has more than one version of the KubeStellar kinds.
+func SetupWithManager(mgr ctrl.Manager) error {
+	bindingPolicyValidator, err := binding.NewBindingPolicyValidator()
+	if err != nil {
+		return fmt.Errorf("failed to create BindingPolicy validator: %w", err)
+	}
+	statusCollectorValidator, err := status.NewStatusCollectorValidator()
+	if err != nil {
+		return fmt.Errorf("failed to create StatusCollector validator: %w", err)
+	}
+	for _, hook := range []struct {
+		obj       runtime.Object
+		validator admission.CustomValidator
+	}{
+		{&v1alpha1.BindingPolicy{}, NewBindingPolicyValidator(bindingPolicyValidator)},
+		{&v1alpha1.StatusCollector{}, NewStatusCollectorValidator(statusCollectorValidator)},
+		{&v1alpha1.CustomTransform{}, NewCustomTransformValidator()},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(hook.obj).WithValidator(hook.validator).Complete(); err != nil {
+			return fmt.Errorf("failed to register validating webhook for %T: %w", hook.obj, err)
+		}
+	}
+	return nil
+}
+
+// NewBindingPolicyValidator returns the admission validator for BindingPolicy objects.
+func NewBindingPolicyValidator(validator *binding.BindingPolicyValidator) admission.CustomValidator {
+	return specValidator[*v1alpha1.BindingPolicy]{
+		kind:     "BindingPolicy",
+		spec:     func(obj *v1alpha1.BindingPolicy) any { return obj.Spec },
+		validate: validator.Validate,
+	}
+}
+
+// NewStatusCollectorValidator returns the admission validator for StatusCollector objects.
+func NewStatusCollectorValidator(validator *status.StatusCollectorValidator) admission.CustomValidator {
+	return specValidator[*v1alpha1.StatusCollector]{
+		kind: "StatusCollector",
+		spec: func(obj *v1alpha1.StatusCollector) any { return obj.Spec },
+		validate: func(obj *v1alpha1.StatusCollector) []string {
+			return abstract.SliceMap(validator.Validate(obj), error.Error)
+		},
+	}
+}
+
+// NewCustomTransformValidator returns the admission validator for CustomTransform objects.
+func NewCustomTransformValidator() admission.CustomValidator {
+	return specValidator[*v1alpha1.CustomTransform]{
+		kind:     "CustomTransform",
+		spec:     func(obj *v1alpha1.CustomTransform) any { return obj.Spec },
+		validate: func(obj *v1alpha1.CustomTransform) []string { return transport.ValidateCustomTransform(obj.Spec) },
+	}
+}
+
+// specValidator is an admission.CustomValidator that checks the spec of objects of one kind.
+// An update that leaves the spec unchanged is always admitted, so that objects that
+// were created before the webhook was in place can still have their metadata updated
+// and be deleted.
+type specValidator[Obj runtime.Object] struct {
+	kind     string
+	spec     func(Obj) any
+	validate func(Obj) []string
+}
+
+var _ admission.CustomValidator = specValidator[*v1alpha1.BindingPolicy]{}
+
+func (sv specValidator[Obj]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, sv.check(obj)
+}
+
+func (sv specValidator[Obj]) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldTyped, oldOK := oldObj.(Obj)
+	newTyped, newOK := newObj.(Obj)
+	if oldOK && newOK && apiequality.Semantic.DeepEqual(sv.spec(oldTyped), sv.spec(newTyped)) {
+		return nil, nil
+	}
+	return nil, sv.check(newObj)
+}
+
+func (sv specValidator[Obj]) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (sv specValidator[Obj]) check(obj runtime.Object) error {
+	typed, ok := obj.(Obj)
+	if !ok {
+		return fmt.Errorf("expected a %s but got a %T", sv.kind, obj)
+	}
+	if errs := sv.validate(typed); len(errs) > 0 {
+		return fmt.Errorf("invalid %s: %s", sv.kind, strings.Join(errs, "; "))
+	}
+	return nil
+}
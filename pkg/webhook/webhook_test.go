@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/status"
+)
+
+func TestStatusCollectorValidator(t *testing.T) {
+	ctx := context.Background()
+	scValidator, err := status.NewStatusCollectorValidator()
+	if err != nil {
+		t.Fatalf("failed to create StatusCollector validator: %v", err)
+	}
+	validator := NewStatusCollectorValidator(scValidator)
+	badFilter := v1alpha1.Expression("returned.status.")
+	good := &v1alpha1.StatusCollector{ObjectMeta: metav1.ObjectMeta{Name: "sc1"},
+		Spec: v1alpha1.StatusCollectorSpec{Select: []v1alpha1.NamedExpression{{Name: "status", Def: "returned.status"}}}}
+	bad := good.DeepCopy()
+	bad.Spec.Filter = &badFilter
+
+	if _, err := validator.ValidateCreate(ctx, good); err != nil {
+		t.Errorf("expected a good StatusCollector to be admitted, got %v", err)
+	}
+	if _, err := validator.ValidateCreate(ctx, bad); err == nil {
+		t.Error("expected a bad StatusCollector to be rejected")
+	}
+	if _, err := validator.ValidateUpdate(ctx, good, bad); err == nil {
+		t.Error("expected an update to a bad spec to be rejected")
+	}
+	relabeled := bad.DeepCopy()
+	relabeled.Labels = map[string]string{"a": "b"}
+	if _, err := validator.ValidateUpdate(ctx, bad, relabeled); err != nil {
+		t.Errorf("expected an update that leaves the spec unchanged to be admitted, got %v", err)
+	}
+	if _, err := validator.ValidateDelete(ctx, bad); err != nil {
+		t.Errorf("expected deletion to be admitted, got %v", err)
+	}
+}
+
+func TestCustomTransformValidator(t *testing.T) {
+	ctx := context.Background()
+	validator := NewCustomTransformValidator()
+	for _, testCase := range []struct {
+		remove    []string
+		expectErr bool
+	}{
+		{remove: []string{"$.spec.replicas", "$.metadata.annotations[\"a\"]"}},
+		{remove: []string{"$.spec["}, expectErr: true},
+		{remove: []string{"$"}, expectErr: true},
+	} {
+		ct := &v1alpha1.CustomTransform{ObjectMeta: metav1.ObjectMeta{Name: "ct1"},
+			Spec: v1alpha1.CustomTransformSpec{APIGroup: "apps", Resource: "deployments", Remove: testCase.remove}}
+		if _, err := validator.ValidateCreate(ctx, ct); (err != nil) != testCase.expectErr {
+			t.Errorf("remove %q: expected error=%v, got %v", testCase.remove, testCase.expectErr, err)
+		}
+	}
+	if _, err := validator.ValidateCreate(ctx, &v1alpha1.BindingPolicy{}); err == nil {
+		t.Error("expected an object of the wrong kind to be rejected")
+	}
+}
@@ -83,12 +83,12 @@ func TestCRDHandling(t *testing.T) {
 	createCRD(t, ctx, "ManagedCluster", managedClusterCRDURL, serializer, apiextClient)
 	createCRD(t, ctx, "ManifestWork", manifestWorkCRDURL, serializer, apiextClient)
 	time.Sleep(5 * time.Second)
-	ctlr, err := binding.NewController(logger, config4json, config, "test-wds", nil)
+	ctlr, err := binding.NewController(logger, config4json, config, "test-wds", nil, nil)
 	if err != nil {
 		t.Fatalf("Failed to create controller: %s", err)
 	}
 	logger.Info("About to EnsureCRDs")
-	err = ctlr.EnsureCRDs(ctx)
+	err = ctlr.EnsureCRDs(ctx, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
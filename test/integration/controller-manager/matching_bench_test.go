@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The KubeStellar Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2/ktesting"
+
+	ksapi "github.com/kubestellar/kubestellar/api/control/v1alpha1"
+	"github.com/kubestellar/kubestellar/pkg/util"
+)
+
+// NumPoliciesEnvar is the name of the environment variable that can be used to specify
+// the number of BindingPolicy objects in BenchmarkMatching
+const NumPoliciesEnvar = "CONTROLLER_BENCH_NUM_POLICIES"
+
+// BenchmarkMatching measures how quickly the binding controller matches workload objects
+// to BindingPolicy objects when there are many of the latter.
+// Like TestMatching, this uses an in-process kube-apiserver and
+// YOU MUST HAVE THE ETCD BINARY ON YOUR `$PATH`.
+//
+// The setup generates a pool of workload objects (the number is taken from the
+// environment variable named by NumObjEnvar, default 100) and a set of BindingPolicy
+// objects (the number is taken from the environment variable named by NumPoliciesEnvar,
+// default 300), each with two DownsyncPolicyClauses derived from randomly chosen pool objects.
+// Each iteration creates all the pool objects and waits until every Binding lists
+// the expected objects, then deletes all the pool objects and waits again.
+func BenchmarkMatching(b *testing.B) {
+	rg := rand.New(rand.NewSource(42))
+	logger, ctx := ktesting.NewTestContext(b)
+	ctx, k8sClient, ksClient := startControllerTestEnv(b, ctx)
+	nObj := intFromEnv(b, NumObjEnvar, 100)
+	nPolicies := intFromEnv(b, NumPoliciesEnvar, 300)
+
+	namespaces := []*k8score.Namespace{}
+	nsORs := []mrObjRsc{}
+	for i := 1; i <= 3; i++ {
+		ns := generateNamespace(b, ctx, rg, fmt.Sprintf("ns%d", i), k8sClient)
+		namespaces = append(namespaces, ns)
+		nsORs = append(nsORs, mrObjRsc{ns, "namespaces", nil, nil, nil})
+	}
+	counts := counters{}
+	objs := make([]mrObjRsc, nObj)
+	for i := range objs {
+		objs[i] = generateObject(ctx, rg, &counts, namespaces, k8sClient)
+	}
+
+	policyTests := map[string][]ksapi.DownsyncPolicyClause{}
+	for i := 0; i < nPolicies; i++ {
+		tests := []ksapi.DownsyncPolicyClause{
+			{DownsyncObjectTest: extractTest(rg, objs[rg.Intn(nObj)])},
+			{DownsyncObjectTest: extractTest(rg, objs[rg.Intn(nObj)])},
+		}
+		bp := &ksapi.BindingPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("bench-%d", i)},
+			Spec:       ksapi.BindingPolicySpec{Downsync: tests},
+		}
+		if _, err := ksClient.BindingPolicies().Create(ctx, bp, metav1.CreateOptions{}); err != nil {
+			b.Fatalf("Failed to create BindingPolicy %s: %s", bp.Name, err)
+		}
+		policyTests[bp.Name] = tests
+	}
+	logger.Info("Created BindingPolicies", "count", nPolicies, "numObjects", nObj)
+
+	// expectations returns, for each BindingPolicy, the expected workload given the existing objects
+	expectations := func(existing []mrObjRsc) map[string]map[gvrnn]any {
+		ans := map[string]map[gvrnn]any{}
+		for name, tests := range policyTests {
+			expectation := map[gvrnn]any{}
+			for _, obj := range existing {
+				if test := obj.MatchesAny(b, tests); test != nil {
+					expectation[id2r(util.IdentifierForObject(obj.MRObject, obj.Resource))] = test
+				}
+			}
+			ans[name] = expectation
+		}
+		return ans
+	}
+	withObjs := expectations(append(append([]mrObjRsc{}, nsORs...), objs...))
+	withoutObjs := expectations(nsORs)
+	awaitBindings := func(expected map[string]map[gvrnn]any) {
+		err := wait.PollUntilContextTimeout(ctx, 100*time.Millisecond, 5*time.Minute, false, func(ctx context.Context) (bool, error) {
+			bindings, err := ksClient.Bindings().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				logger.Info("Failed to LIST Bindings", "err", err)
+				return false, nil
+			}
+			if len(bindings.Items) < len(expected) {
+				return false, nil
+			}
+			for _, binding := range bindings.Items {
+				if excess, missed := workloadIsExpected(binding.Spec.Workload, expected[binding.Name]); len(excess)+len(missed) > 0 {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			b.Fatalf("Bindings never got expected matches: %s", err)
+		}
+	}
+	awaitBindings(withoutObjs)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, obj := range objs {
+			if err := obj.create(); err != nil {
+				b.Fatalf("Failed to create object %#v: %s", obj, err)
+			}
+		}
+		awaitBindings(withObjs)
+		for _, obj := range objs {
+			if err := obj.delete(); err != nil {
+				b.Fatalf("Failed to delete object %#v: %s", obj, err)
+			}
+		}
+		awaitBindings(withoutObjs)
+	}
+}
+
+func intFromEnv(tb testing.TB, envar string, defaultValue int) int {
+	str := os.Getenv(envar)
+	if len(str) == 0 {
+		return defaultValue
+	}
+	val, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		tb.Fatalf("Failed to parse value of environment variable %s %q as an int64: %s", envar, str, err)
+	}
+	return int(val)
+}
@@ -97,69 +97,9 @@ func TestMatching(t *testing.T) {
 	rg.Uint64()
 	rg.Uint64()
 	rg.Uint64()
-	testWriter := framework.NewTBWriter(t)
 	logger, ctx := ktesting.NewTestContext(t)
-	logger.Info("Starting etcd server")
-	framework.StartEtcd(t, testWriter)
-	logger.Info("Starting TestController")
-	t.Log("Beginning TestController")
-	ctx, cancel := context.WithCancel(ctx)
-	testServer, err := kastesting.StartTestServer(t, kastesting.NewDefaultTestServerOptions(), []string{}, framework.SharedEtcd())
-	if err != nil {
-		t.Fatalf("Failed to kastesting.StartTestServer: %s", err)
-	}
-	fullTeardwon := func() {
-		cancel()
-		testServer.TearDownFn()
-	}
-	t.Cleanup(fullTeardwon)
-	config := testServer.ClientConfig
-	k8sClient, err := k8sclient.NewForConfig(config)
-	if err != nil {
-		t.Fatalf("Failed to create Kubernetes client: %s", err)
-	}
-	logger.Info("Started test server", "config", config)
-	configCopy := *config
-	config4json := &configCopy
-	config4json.ContentType = "application/json"
-	logger.Info("REST config for JSON marshaling", "config", config4json)
-	ksClient, err := ksclient.NewForConfig(config4json)
-	if err != nil {
-		t.Fatalf("Failed to create KubeStellar client: %s", err)
-	}
-	apiextClient, err := apiextensionsclientset.NewForConfig(config)
-	if err != nil {
-		t.Fatalf("Failed to create apiextensions client: %s", err)
-	}
-	scheme := runtime.NewScheme()
-	err = apiextensionsapi.AddToScheme(scheme)
-	if err != nil {
-		t.Fatalf("Failed to apiextensionsapi.AddToScheme(scheme): %s", err)
-	}
-	serializer := k8sjson.NewYAMLSerializer(k8sjson.DefaultMetaFactory, scheme, scheme)
-	createCRD(t, ctx, "ManagedCluster", managedClusterCRDURL, serializer, apiextClient)
-	createCRD(t, ctx, "ManifestWork", manifestWorkCRDURL, serializer, apiextClient)
-	time.Sleep(5 * time.Second)
-	ctlr, err := binding.NewController(logger, config4json, config, "test-wds", nil)
-	if err != nil {
-		t.Fatalf("Failed to create controller: %s", err)
-	}
-	logger.Info("About to EnsureCRDs")
-	err = ctlr.EnsureCRDs(ctx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	t.Log("CRDs ensured")
-	err = ctlr.AppendKSResources(ctx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	t.Log("Appended KS resources to discovered lists")
-	err = ctlr.Start(ctx, 4, make(chan interface{}, 1))
-	if err != nil {
-		t.Fatal(err)
-	}
-	time.Sleep(5 * time.Second)
+	ctx, k8sClient, ksClient := startControllerTestEnv(t, ctx)
+	var err error
 	namespaces := []*k8score.Namespace{}
 	nsORs := []mrObjRsc{}
 	for i := 1; i <= 3; i++ {
@@ -387,9 +327,78 @@ func TestMatching(t *testing.T) {
 	logger.Info("Success", "rounds", nRounds, "nObj", nObj)
 }
 
+// startControllerTestEnv starts an etcd server, a kube-apiserver and a binding controller.
+// Returns a context that is canceled at cleanup, and clients for the kube-apiserver.
+func startControllerTestEnv(tb testing.TB, ctx context.Context) (context.Context, k8sclient.Interface, ksclient.ControlV1alpha1Interface) {
+	logger := klog.FromContext(ctx)
+	testWriter := framework.NewTBWriter(tb)
+	logger.Info("Starting etcd server")
+	framework.StartEtcd(tb, testWriter)
+	logger.Info("Starting TestController")
+	tb.Log("Beginning TestController")
+	ctx, cancel := context.WithCancel(ctx)
+	testServer, err := kastesting.StartTestServer(tb, kastesting.NewDefaultTestServerOptions(), []string{}, framework.SharedEtcd())
+	if err != nil {
+		tb.Fatalf("Failed to kastesting.StartTestServer: %s", err)
+	}
+	fullTeardwon := func() {
+		cancel()
+		testServer.TearDownFn()
+	}
+	tb.Cleanup(fullTeardwon)
+	config := testServer.ClientConfig
+	k8sClient, err := k8sclient.NewForConfig(config)
+	if err != nil {
+		tb.Fatalf("Failed to create Kubernetes client: %s", err)
+	}
+	logger.Info("Started test server", "config", config)
+	configCopy := *config
+	config4json := &configCopy
+	config4json.ContentType = "application/json"
+	logger.Info("REST config for JSON marshaling", "config", config4json)
+	ksClient, err := ksclient.NewForConfig(config4json)
+	if err != nil {
+		tb.Fatalf("Failed to create KubeStellar client: %s", err)
+	}
+	apiextClient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		tb.Fatalf("Failed to create apiextensions client: %s", err)
+	}
+	scheme := runtime.NewScheme()
+	err = apiextensionsapi.AddToScheme(scheme)
+	if err != nil {
+		tb.Fatalf("Failed to apiextensionsapi.AddToScheme(scheme): %s", err)
+	}
+	serializer := k8sjson.NewYAMLSerializer(k8sjson.DefaultMetaFactory, scheme, scheme)
+	createCRD(tb, ctx, "ManagedCluster", managedClusterCRDURL, serializer, apiextClient)
+	createCRD(tb, ctx, "ManifestWork", manifestWorkCRDURL, serializer, apiextClient)
+	time.Sleep(5 * time.Second)
+	ctlr, err := binding.NewController(logger, config4json, config, "test-wds", nil, nil)
+	if err != nil {
+		tb.Fatalf("Failed to create controller: %s", err)
+	}
+	logger.Info("About to EnsureCRDs")
+	err = ctlr.EnsureCRDs(ctx, nil)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Log("CRDs ensured")
+	err = ctlr.AppendKSResources(ctx)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Log("Appended KS resources to discovered lists")
+	err = ctlr.Start(ctx, 4, make(chan interface{}, 1))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	time.Sleep(5 * time.Second)
+	return ctx, k8sClient, ksClient
+}
+
 var crdGVK = apiextensionsapi.SchemeGroupVersion.WithKind("CustomResourceDefinition")
 
-func createCRD(t *testing.T, ctx context.Context, kind, url string, serializer *k8sjson.Serializer, apiextClient apiextensionsclientset.Interface) error {
+func createCRD(t testing.TB, ctx context.Context, kind, url string, serializer *k8sjson.Serializer, apiextClient apiextensionsclientset.Interface) error {
 	crdYAML, err := urlGet(url)
 	if err != nil {
 		t.Fatalf("Failed to read %s CRD from %s: %s", kind, url, err)
@@ -459,7 +468,7 @@ type mrObjRsc struct {
 	delete    func() error
 }
 
-func (mor mrObjRsc) MatchesAny(t *testing.T, tests []ksapi.DownsyncPolicyClause) *ksapi.DownsyncObjectTest {
+func (mor mrObjRsc) MatchesAny(t testing.TB, tests []ksapi.DownsyncPolicyClause) *ksapi.DownsyncObjectTest {
 	for _, test := range tests {
 		gvk := mor.MRObject.GetObjectKind().GroupVersionKind()
 		if test.APIGroup != nil && gvk.Group != *test.APIGroup {
@@ -487,7 +496,7 @@ func (mor mrObjRsc) MatchesAny(t *testing.T, tests []ksapi.DownsyncPolicyClause)
 	return nil
 }
 
-func LabelsMatchAny(t *testing.T, labels map[string]string, selectors []metav1.LabelSelector) bool {
+func LabelsMatchAny(t testing.TB, labels map[string]string, selectors []metav1.LabelSelector) bool {
 	for _, ls := range selectors {
 		sel, err := metav1.LabelSelectorAsSelector(&ls)
 		if err != nil {
@@ -575,7 +584,7 @@ func generateObjectMeta(rg *rand.Rand, name string, namespace *k8score.Namespace
 	return ans
 }
 
-func generateNamespace(t *testing.T, ctx context.Context, rg *rand.Rand, name string, client k8sclient.Interface) *k8score.Namespace {
+func generateNamespace(t testing.TB, ctx context.Context, rg *rand.Rand, name string, client k8sclient.Interface) *k8score.Namespace {
 	ans := &k8score.Namespace{
 		TypeMeta:   metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
 		ObjectMeta: generateObjectMeta(rg, name, nil),